@@ -16,15 +16,22 @@ package api
 
 import (
 	"github.com/gin-gonic/gin"
+	"github.com/openimsdk/open-im-server/v3/pkg/apistruct"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgext"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
 	"github.com/openimsdk/protocol/conversation"
 	"github.com/openimsdk/tools/a2r"
+	"github.com/openimsdk/tools/apiresp"
+	"github.com/openimsdk/tools/log"
 )
 
-type ConversationApi rpcclient.Conversation
+type ConversationApi struct {
+	rpcclient.Conversation
+	msgExtClient msgext.MsgExtClient
+}
 
-func NewConversationApi(client rpcclient.Conversation) ConversationApi {
-	return ConversationApi(client)
+func NewConversationApi(client rpcclient.Conversation, msgClient *rpcclient.Message) ConversationApi {
+	return ConversationApi{Conversation: client, msgExtClient: msgClient.ExtClient}
 }
 
 func (o *ConversationApi) GetAllConversations(c *gin.Context) {
@@ -40,7 +47,28 @@ func (o *ConversationApi) GetConversation(c *gin.Context) {
 }
 
 func (o *ConversationApi) GetConversations(c *gin.Context) {
-	a2r.Call(conversation.ConversationClient.GetConversations, o.Client, c)
+	req, err := a2r.ParseRequest[conversation.GetConversationsReq](c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	resp, err := o.Client.GetConversations(c, req)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	pinnedResp, err := o.msgExtClient.GetConversationsPinnedMsgs(c, &msgext.GetConversationsPinnedMsgsReq{
+		UserID:          req.OwnerUserID,
+		ConversationIDs: req.ConversationIDs,
+	})
+	if err != nil {
+		log.ZWarn(c, "get conversations pinned msgs failed", err, "userID", req.OwnerUserID)
+		pinnedResp = &msgext.GetConversationsPinnedMsgsResp{}
+	}
+	apiresp.GinSuccess(c, &apistruct.GetConversationsResp{
+		GetConversationsResp: resp,
+		PinnedMsgs:           pinnedResp.PinnedMsgs,
+	})
 }
 
 func (o *ConversationApi) SetConversations(c *gin.Context) {
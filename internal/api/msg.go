@@ -21,6 +21,7 @@ import (
 	"github.com/openimsdk/open-im-server/v3/pkg/apistruct"
 	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgext"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
 	"github.com/openimsdk/protocol/constant"
 	"github.com/openimsdk/protocol/msg"
@@ -119,6 +120,34 @@ func (m *MessageApi) RevokeMsg(c *gin.Context) {
 	a2r.Call(msg.MsgClient.RevokeMsg, m.Client, c)
 }
 
+func (m *MessageApi) ModifyMsg(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.ModifyMsg, m.ExtClient, c)
+}
+
+func (m *MessageApi) GetMsgModifyHistory(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.GetMsgModifyHistory, m.ExtClient, c)
+}
+
+func (m *MessageApi) AddMessageReaction(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.AddMessageReaction, m.ExtClient, c)
+}
+
+func (m *MessageApi) RemoveMessageReaction(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.RemoveMessageReaction, m.ExtClient, c)
+}
+
+func (m *MessageApi) GetMessagesReaction(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.GetMessagesReaction, m.ExtClient, c)
+}
+
+func (m *MessageApi) GetThreadReplies(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.GetThreadReplies, m.ExtClient, c)
+}
+
+func (m *MessageApi) MarkThreadAsRead(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.MarkThreadAsRead, m.ExtClient, c)
+}
+
 func (m *MessageApi) MarkMsgsAsRead(c *gin.Context) {
 	a2r.Call(msg.MsgClient.MarkMsgsAsRead, m.Client, c)
 }
@@ -128,7 +157,7 @@ func (m *MessageApi) MarkConversationAsRead(c *gin.Context) {
 }
 
 func (m *MessageApi) GetConversationsHasReadAndMaxSeq(c *gin.Context) {
-	a2r.Call(msg.MsgClient.GetConversationsHasReadAndMaxSeq, m.Client, c)
+	a2r.Call(msgext.MsgExtClient.GetConversationsHasReadAndMaxSeqWithThread, m.ExtClient, c)
 }
 
 func (m *MessageApi) SetConversationHasReadSeq(c *gin.Context) {
@@ -350,6 +379,82 @@ func (m *MessageApi) BatchSendMsg(c *gin.Context) {
 	apiresp.GinSuccess(c, resp)
 }
 
+// ScheduleSendMsg stores a message to be sent by the crontask at the scheduled time.
+func (m *MessageApi) ScheduleSendMsg(c *gin.Context) {
+	req := apistruct.ScheduleSendMsgReq{}
+	if err := c.BindJSON(&req); err != nil {
+		apiresp.GinError(c, errs.ErrArgs.WithDetail(err.Error()).Wrap())
+		return
+	}
+	if !authverify.IsAppManagerUid(c, m.imAdminUserID) {
+		apiresp.GinError(c, errs.ErrNoPermission.WrapMsg("only app manager can send message"))
+		return
+	}
+	sendMsgReq, err := m.getSendMsgReq(c, req.SendMsg)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	sendMsgReq.MsgData.RecvID = req.RecvID
+	respPb, err := m.ExtClient.ScheduleSendMsg(c, &msgext.ScheduleSendMsgReq{
+		MsgData:  sendMsgReq.MsgData,
+		SendTime: req.ScheduledTime,
+	})
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	apiresp.GinSuccess(c, respPb)
+}
+
+func (m *MessageApi) CancelScheduledMsg(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.CancelScheduledMsg, m.ExtClient, c)
+}
+
+func (m *MessageApi) GetScheduledMsgs(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.GetScheduledMsgs, m.ExtClient, c)
+}
+
+func (m *MessageApi) AddSensitiveWords(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.AddSensitiveWords, m.ExtClient, c)
+}
+
+func (m *MessageApi) DeleteSensitiveWords(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.DeleteSensitiveWords, m.ExtClient, c)
+}
+
+func (m *MessageApi) SearchSensitiveWords(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.SearchSensitiveWords, m.ExtClient, c)
+}
+
+func (m *MessageApi) ReloadSensitiveWords(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.ReloadSensitiveWords, m.ExtClient, c)
+}
+
+func (m *MessageApi) SetGroupReadReceipt(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.SetGroupReadReceipt, m.ExtClient, c)
+}
+
+func (m *MessageApi) GetGroupReadReceipt(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.GetGroupReadReceipt, m.ExtClient, c)
+}
+
+func (m *MessageApi) GetGroupMsgReadMembers(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.GetGroupMsgReadMembers, m.ExtClient, c)
+}
+
+func (m *MessageApi) PinMsg(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.PinMsg, m.ExtClient, c)
+}
+
+func (m *MessageApi) UnpinMsg(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.UnpinMsg, m.ExtClient, c)
+}
+
+func (m *MessageApi) GetPinnedMsgs(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.GetPinnedMsgs, m.ExtClient, c)
+}
+
 func (m *MessageApi) CheckMsgIsSendSuccess(c *gin.Context) {
 	a2r.Call(msg.MsgClient.GetSendMsgStatus, m.Client, c)
 }
@@ -367,7 +472,7 @@ func (m *MessageApi) GetActiveGroup(c *gin.Context) {
 }
 
 func (m *MessageApi) SearchMsg(c *gin.Context) {
-	a2r.Call(msg.MsgClient.SearchMessage, m.Client, c)
+	a2r.Call(msgext.MsgExtClient.SearchMsg, m.ExtClient, c)
 }
 
 func (m *MessageApi) GetServerTime(c *gin.Context) {
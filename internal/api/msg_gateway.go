@@ -0,0 +1,163 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/discoveryregister/kubernetes"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/gateway"
+	"github.com/openimsdk/tools/a2r"
+	"github.com/openimsdk/tools/apiresp"
+	"github.com/openimsdk/tools/discovery"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"google.golang.org/grpc"
+)
+
+// MsgGatewayApi is the api of the msggateway nodes, the connections are listed and kicked on each of them.
+type MsgGatewayApi struct {
+	discov  discovery.SvcDiscoveryRegistry
+	rpcName string
+	// getNodeHosts returns the hosts of the nodes when the discovery cannot list the conns of each node.
+	getNodeHosts func(ctx context.Context) []string
+}
+
+func NewMsgGatewayApi(discov discovery.SvcDiscoveryRegistry, discoveryType string, rpcName string) MsgGatewayApi {
+	m := MsgGatewayApi{discov: discov, rpcName: rpcName}
+	if discoveryType == "k8s" {
+		// the k8s discovery returns the load balanced service conn, the pods are dialed by their headless hosts
+		m.getNodeHosts = func(ctx context.Context) []string {
+			return kubernetes.GetMsgGatewayHosts(ctx, rpcName)
+		}
+	}
+	return m
+}
+
+// getNodeConns returns a conn to each msggateway node.
+func (m *MsgGatewayApi) getNodeConns(ctx context.Context) ([]*grpc.ClientConn, error) {
+	if m.getNodeHosts == nil {
+		return m.discov.GetConns(ctx, m.rpcName)
+	}
+	hosts := m.getNodeHosts(ctx)
+	if len(hosts) == 0 {
+		return nil, errs.ErrInternalServer.WrapMsg("cannot enumerate the msggateway nodes", "rpcName", m.rpcName)
+	}
+	conns := make([]*grpc.ClientConn, 0, len(hosts))
+	for _, host := range hosts {
+		conn, err := m.discov.GetConn(ctx, host)
+		if err != nil {
+			return nil, err
+		}
+		conns = append(conns, conn)
+	}
+	return conns, nil
+}
+
+func (m *MsgGatewayApi) GetLoginRules(c *gin.Context) {
+	conn, err := m.discov.GetConn(c, m.rpcName)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	a2r.Call(gateway.GatewayAdminClient.GetLoginRules, gateway.NewGatewayAdminClient(conn), c)
+}
+
+// SetLoginRules saves the login rules by any node, the other nodes reload them.
+func (m *MsgGatewayApi) SetLoginRules(c *gin.Context) {
+	conn, err := m.discov.GetConn(c, m.rpcName)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	a2r.Call(gateway.GatewayAdminClient.SetLoginRules, gateway.NewGatewayAdminClient(conn), c)
+}
+
+// ListConns lists the connections of all the nodes, each node returns its connections up to the end of the page.
+// The nodes failing the listing are returned in nodeErrs instead of failing the request.
+func (m *MsgGatewayApi) ListConns(c *gin.Context) {
+	req, err := a2r.ParseRequest[gateway.ListConnsReq](c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	if err := req.Check(); err != nil {
+		apiresp.GinError(c, errs.ErrArgs.WrapMsg(err.Error()))
+		return
+	}
+	conns, err := m.getNodeConns(c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	resp := &gateway.ListConnsResp{}
+	for _, conn := range conns {
+		nodeResp, err := gateway.NewGatewayAdminClient(conn).ListConns(c, req)
+		if err != nil {
+			// the connections of the other nodes are listed, the failed nodes are returned with their errors
+			log.ZError(c, "ListConns rpc error", err, "node", conn.Target())
+			apiErr := apiresp.ParseError(err)
+			resp.NodeErrs = append(resp.NodeErrs, &gateway.NodeError{Node: conn.Target(), ErrCode: int32(apiErr.ErrCode), ErrMsg: apiErr.ErrMsg})
+			continue
+		}
+		for _, connInfo := range nodeResp.Conns {
+			connInfo.Node = conn.Target()
+		}
+		resp.Total += nodeResp.Total
+		resp.Conns = append(resp.Conns, nodeResp.Conns...)
+	}
+	gateway.SortConnInfos(resp.Conns)
+	start := int(req.PageNumber-1) * int(req.ShowNumber)
+	if start > len(resp.Conns) {
+		start = len(resp.Conns)
+	}
+	resp.Conns = resp.Conns[start:min(len(resp.Conns), req.End())]
+	apiresp.GinSuccess(c, resp)
+}
+
+// KickConn kicks the connection on the node it is connected to.
+func (m *MsgGatewayApi) KickConn(c *gin.Context) {
+	req, err := a2r.ParseRequest[gateway.KickConnReq](c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	conns, err := m.getNodeConns(c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	var nodeErrs []*gateway.NodeError
+	for _, conn := range conns {
+		resp, err := gateway.NewGatewayAdminClient(conn).KickConn(c, req)
+		if err != nil {
+			// the conn may be on the other nodes, the failed nodes are returned only if no node kicks it
+			log.ZError(c, "KickConn rpc error", err, "node", conn.Target())
+			apiErr := apiresp.ParseError(err)
+			nodeErrs = append(nodeErrs, &gateway.NodeError{Node: conn.Target(), ErrCode: int32(apiErr.ErrCode), ErrMsg: apiErr.ErrMsg})
+			continue
+		}
+		if resp.Kicked {
+			apiresp.GinSuccess(c, resp)
+			return
+		}
+	}
+	if len(nodeErrs) > 0 {
+		apiresp.GinError(c, errs.ErrInternalServer.WrapMsg("conn not found on the reachable nodes", "userID", req.UserID, "connID", req.ConnID, "nodeErrs", nodeErrs))
+		return
+	}
+	apiresp.GinError(c, errs.ErrRecordNotFound.WrapMsg("conn not found", "userID", req.UserID, "connID", req.ConnID))
+}
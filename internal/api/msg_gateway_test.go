@@ -0,0 +1,175 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/gateway"
+	"github.com/openimsdk/tools/discovery"
+	"github.com/openimsdk/tools/errs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+type adminNode struct {
+	gateway.UnimplementedGatewayAdminServer
+	conns []*gateway.ConnInfo
+	err   error
+}
+
+func (n *adminNode) ListConns(_ context.Context, req *gateway.ListConnsReq) (*gateway.ListConnsResp, error) {
+	return &gateway.ListConnsResp{Total: int64(len(n.conns)), Conns: n.conns}, nil
+}
+
+func (n *adminNode) KickConn(_ context.Context, req *gateway.KickConnReq) (*gateway.KickConnResp, error) {
+	if n.err != nil {
+		return nil, n.err
+	}
+	for _, conn := range n.conns {
+		if conn.UserID == req.UserID && conn.ConnID == req.ConnID {
+			return &gateway.KickConnResp{Kicked: true}, nil
+		}
+	}
+	return &gateway.KickConnResp{}, nil
+}
+
+// nodesDiscovery returns a conn to each node for GetConns, and the conn of the host for GetConn.
+type nodesDiscovery struct {
+	discovery.SvcDiscoveryRegistry
+	hosts []string
+	conns map[string]*grpc.ClientConn
+}
+
+func (d *nodesDiscovery) GetConns(context.Context, string, ...grpc.DialOption) ([]*grpc.ClientConn, error) {
+	conns := make([]*grpc.ClientConn, 0, len(d.hosts))
+	for _, host := range d.hosts {
+		conns = append(conns, d.conns[host])
+	}
+	return conns, nil
+}
+
+func (d *nodesDiscovery) GetConn(_ context.Context, host string, _ ...grpc.DialOption) (*grpc.ClientConn, error) {
+	return d.conns[host], nil
+}
+
+func newNodesDiscovery(t *testing.T, nodes map[string]*adminNode) *nodesDiscovery {
+	d := &nodesDiscovery{conns: make(map[string]*grpc.ClientConn)}
+	for host, node := range nodes {
+		lis := bufconn.Listen(1 << 20)
+		srv := grpc.NewServer()
+		gateway.RegisterGatewayAdminServer(srv, node)
+		go func() { _ = srv.Serve(lis) }()
+		t.Cleanup(srv.Stop)
+		conn, err := grpc.Dial("passthrough:///"+host,
+			grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return lis.Dial() }),
+			grpc.WithTransportCredentials(insecure.NewCredentials()))
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = conn.Close() })
+		d.hosts = append(d.hosts, host)
+		d.conns[host] = conn
+	}
+	return d
+}
+
+func callGin(t *testing.T, handler gin.HandlerFunc, req any) map[string]any {
+	body, err := json.Marshal(req)
+	require.NoError(t, err)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(body))
+	c.Request.Header.Set("Content-Type", "application/json")
+	handler(c)
+	var resp map[string]any
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	return resp
+}
+
+func TestMsgGatewayApiConnsOfAllNodes(t *testing.T) {
+	nodes := map[string]*adminNode{
+		"node0": {conns: []*gateway.ConnInfo{{UserID: "u1", ConnID: "a", ConnectTime: 1}}},
+		"node1": {conns: []*gateway.ConnInfo{{UserID: "u2", ConnID: "b", ConnectTime: 2}}},
+	}
+	d := newNodesDiscovery(t, nodes)
+
+	check := func(t *testing.T, m MsgGatewayApi) {
+		resp := callGin(t, m.ListConns, &gateway.ListConnsReq{PageNumber: 1, ShowNumber: 10})
+		require.EqualValues(t, 0, resp["errCode"], resp)
+		data := resp["data"].(map[string]any)
+		assert.EqualValues(t, 2, data["total"])
+		conns := data["conns"].([]any)
+		require.Len(t, conns, 2)
+		assert.Equal(t, "a", conns[0].(map[string]any)["connID"])
+		assert.Equal(t, "passthrough:///node0", conns[0].(map[string]any)["node"])
+		assert.Equal(t, "b", conns[1].(map[string]any)["connID"])
+		assert.Equal(t, "passthrough:///node1", conns[1].(map[string]any)["node"])
+
+		// the conn is kicked on the node it is connected to, whichever node is asked first
+		resp = callGin(t, m.KickConn, &gateway.KickConnReq{UserID: "u2", ConnID: "b"})
+		require.EqualValues(t, 0, resp["errCode"], resp)
+		assert.Equal(t, true, resp["data"].(map[string]any)["kicked"])
+		resp = callGin(t, m.KickConn, &gateway.KickConnReq{UserID: "u1", ConnID: "a"})
+		require.EqualValues(t, 0, resp["errCode"], resp)
+
+		resp = callGin(t, m.KickConn, &gateway.KickConnReq{UserID: "u3", ConnID: "c"})
+		assert.NotEqualValues(t, 0, resp["errCode"])
+	}
+
+	t.Run("discovery", func(t *testing.T) {
+		check(t, NewMsgGatewayApi(d, "etcd", "msggateway"))
+	})
+	t.Run("k8s", func(t *testing.T) {
+		// the k8s discovery returns one load balanced conn, the nodes are dialed by their hosts
+		m := NewMsgGatewayApi(&nodesDiscovery{hosts: d.hosts[:1], conns: d.conns}, "k8s", "msggateway")
+		m.getNodeHosts = func(context.Context) []string { return d.hosts }
+		check(t, m)
+	})
+	t.Run("k8s without nodes", func(t *testing.T) {
+		m := NewMsgGatewayApi(d, "k8s", "msggateway")
+		m.getNodeHosts = func(context.Context) []string { return nil }
+		resp := callGin(t, m.ListConns, &gateway.ListConnsReq{PageNumber: 1, ShowNumber: 10})
+		assert.NotEqualValues(t, 0, resp["errCode"])
+	})
+}
+
+func TestMsgGatewayApiKickConnWithFailedNode(t *testing.T) {
+	nodes := map[string]*adminNode{
+		"node0": {err: errs.ErrInternalServer.WrapMsg("node is down")},
+		"node1": {conns: []*gateway.ConnInfo{{UserID: "u1", ConnID: "a", ConnectTime: 1}}},
+	}
+	d := newNodesDiscovery(t, nodes)
+	// the failed node is asked first
+	d.hosts = []string{"node0", "node1"}
+	m := NewMsgGatewayApi(d, "etcd", "msggateway")
+
+	resp := callGin(t, m.KickConn, &gateway.KickConnReq{UserID: "u1", ConnID: "a"})
+	require.EqualValues(t, 0, resp["errCode"], resp)
+	assert.Equal(t, true, resp["data"].(map[string]any)["kicked"])
+
+	// no node kicks the conn, the error of the failed node is returned instead of not found
+	resp = callGin(t, m.KickConn, &gateway.KickConnReq{UserID: "u2", ConnID: "b"})
+	assert.EqualValues(t, errs.ServerInternalError, resp["errCode"], resp)
+	assert.Contains(t, resp["errDlt"], "node0")
+}
@@ -0,0 +1,36 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/pushext"
+	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
+	"github.com/openimsdk/tools/a2r"
+)
+
+type PushApi rpcclient.Push
+
+func NewPushApi(client rpcclient.Push) PushApi {
+	return PushApi(client)
+}
+
+func (o *PushApi) GetOfflinePushDeadLetters(c *gin.Context) {
+	a2r.Call(pushext.PushExtClient.GetOfflinePushDeadLetters, o.ExtClient, c)
+}
+
+func (o *PushApi) ReplayOfflinePushDeadLetters(c *gin.Context) {
+	a2r.Call(pushext.PushExtClient.ReplayOfflinePushDeadLetters, o.ExtClient, c)
+}
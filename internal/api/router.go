@@ -36,6 +36,7 @@ func newGinRouter(disCov discovery.SvcDiscoveryRegistry, config *Config) *gin.En
 	conversationRpc := rpcclient.NewConversation(disCov, config.Share.RpcRegisterName.Conversation)
 	authRpc := rpcclient.NewAuth(disCov, config.Share.RpcRegisterName.Auth)
 	thirdRpc := rpcclient.NewThird(disCov, config.Share.RpcRegisterName.Third, config.API.Prometheus.GrafanaURL)
+	pushRpc := rpcclient.NewPush(disCov, config.Share.RpcRegisterName.Push)
 
 	r.Use(gin.Recovery(), mw.CorsHandler(), mw.GinParseOperationID(), GinParseToken(authRpc))
 	u := NewUserApi(*userRpc)
@@ -46,6 +47,8 @@ func newGinRouter(disCov discovery.SvcDiscoveryRegistry, config *Config) *gin.En
 		userRouterGroup.POST("/update_user_info", u.UpdateUserInfo)
 		userRouterGroup.POST("/update_user_info_ex", u.UpdateUserInfoEx)
 		userRouterGroup.POST("/set_global_msg_recv_opt", u.SetGlobalRecvMessageOpt)
+		userRouterGroup.POST("/set_locale", u.SetUserLocale)
+		userRouterGroup.POST("/get_locales", u.GetUserLocales)
 		userRouterGroup.POST("/get_users_info", u.GetUsersPublicInfo)
 		userRouterGroup.POST("/get_all_users_uid", u.GetAllUsersID)
 		userRouterGroup.POST("/account_check", u.AccountCheck)
@@ -139,6 +142,9 @@ func newGinRouter(disCov discovery.SvcDiscoveryRegistry, config *Config) *gin.En
 		t := NewThirdApi(*thirdRpc)
 		thirdGroup.GET("/prometheus", t.GetPrometheus)
 		thirdGroup.POST("/fcm_update_token", t.FcmUpdateToken)
+		thirdGroup.POST("/update_device_token", t.UpdateDeviceToken)
+		thirdGroup.POST("/delete_device_token", t.DeleteDeviceToken)
+		thirdGroup.POST("/get_device_tokens", t.GetDeviceTokens)
 		thirdGroup.POST("/set_app_badge", t.SetAppBadge)
 
 		logs := thirdGroup.Group("/logs")
@@ -167,6 +173,13 @@ func newGinRouter(disCov discovery.SvcDiscoveryRegistry, config *Config) *gin.En
 		msgGroup.POST("/send_business_notification", m.SendBusinessNotification)
 		msgGroup.POST("/pull_msg_by_seq", m.PullMsgBySeqs)
 		msgGroup.POST("/revoke_msg", m.RevokeMsg)
+		msgGroup.POST("/modify_msg", m.ModifyMsg)
+		msgGroup.POST("/get_msg_modify_history", m.GetMsgModifyHistory)
+		msgGroup.POST("/add_message_reaction", m.AddMessageReaction)
+		msgGroup.POST("/remove_message_reaction", m.RemoveMessageReaction)
+		msgGroup.POST("/get_messages_reaction", m.GetMessagesReaction)
+		msgGroup.POST("/get_thread_replies", m.GetThreadReplies)
+		msgGroup.POST("/mark_thread_as_read", m.MarkThreadAsRead)
 		msgGroup.POST("/mark_msgs_as_read", m.MarkMsgsAsRead)
 		msgGroup.POST("/mark_conversation_as_read", m.MarkConversationAsRead)
 		msgGroup.POST("/get_conversations_has_read_and_max_seq", m.GetConversationsHasReadAndMaxSeq)
@@ -179,13 +192,26 @@ func newGinRouter(disCov discovery.SvcDiscoveryRegistry, config *Config) *gin.En
 		msgGroup.POST("/delete_msg_physical", m.DeleteMsgPhysical)
 
 		msgGroup.POST("/batch_send_msg", m.BatchSendMsg)
+		msgGroup.POST("/schedule_send", m.ScheduleSendMsg)
+		msgGroup.POST("/cancel_scheduled", m.CancelScheduledMsg)
+		msgGroup.POST("/list_scheduled", m.GetScheduledMsgs)
+		msgGroup.POST("/add_sensitive_words", m.AddSensitiveWords)
+		msgGroup.POST("/delete_sensitive_words", m.DeleteSensitiveWords)
+		msgGroup.POST("/search_sensitive_words", m.SearchSensitiveWords)
+		msgGroup.POST("/reload_sensitive_words", m.ReloadSensitiveWords)
+		msgGroup.POST("/set_group_read_receipt", m.SetGroupReadReceipt)
+		msgGroup.POST("/get_group_read_receipt", m.GetGroupReadReceipt)
+		msgGroup.POST("/get_group_msg_read_members", m.GetGroupMsgReadMembers)
+		msgGroup.POST("/pin_msg", m.PinMsg)
+		msgGroup.POST("/unpin_msg", m.UnpinMsg)
+		msgGroup.POST("/get_pinned_msgs", m.GetPinnedMsgs)
 		msgGroup.POST("/check_msg_is_send_success", m.CheckMsgIsSendSuccess)
 		msgGroup.POST("/get_server_time", m.GetServerTime)
 	}
 	// Conversation
 	conversationGroup := r.Group("/conversation")
 	{
-		c := NewConversationApi(*conversationRpc)
+		c := NewConversationApi(*conversationRpc, messageRpc)
 		conversationGroup.POST("/get_sorted_conversation_list", c.GetSortedConversationList)
 		conversationGroup.POST("/get_all_conversations", c.GetAllConversations)
 		conversationGroup.POST("/get_conversation", c.GetConversation)
@@ -194,6 +220,22 @@ func newGinRouter(disCov discovery.SvcDiscoveryRegistry, config *Config) *gin.En
 		conversationGroup.POST("/get_conversation_offline_push_user_ids", c.GetConversationOfflinePushUserIDs)
 	}
 
+	msgGatewayGroup := r.Group("/msg_gateway")
+	{
+		mg := NewMsgGatewayApi(disCov, config.Discovery.Enable, config.Share.RpcRegisterName.MessageGateway)
+		msgGatewayGroup.POST("/get_login_rules", mg.GetLoginRules)
+		msgGatewayGroup.POST("/set_login_rules", mg.SetLoginRules)
+		msgGatewayGroup.POST("/list_conns", mg.ListConns)
+		msgGatewayGroup.POST("/kick_conn", mg.KickConn)
+	}
+
+	pushGroup := r.Group("/push")
+	{
+		p := NewPushApi(*pushRpc)
+		pushGroup.POST("/get_dead_letters", p.GetOfflinePushDeadLetters)
+		pushGroup.POST("/replay_dead_letters", p.ReplayOfflinePushDeadLetters)
+	}
+
 	statisticsGroup := r.Group("/statistics")
 	{
 		statisticsGroup.POST("/user/register", u.UserRegisterCount)
@@ -24,6 +24,7 @@ import (
 	"strings"
 
 	"github.com/gin-gonic/gin"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/thirdext"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
 	"github.com/openimsdk/protocol/third"
 	"github.com/openimsdk/tools/a2r"
@@ -41,6 +42,18 @@ func (o *ThirdApi) FcmUpdateToken(c *gin.Context) {
 	a2r.Call(third.ThirdClient.FcmUpdateToken, o.Client, c)
 }
 
+func (o *ThirdApi) UpdateDeviceToken(c *gin.Context) {
+	a2r.Call(thirdext.ThirdExtClient.UpdateDeviceToken, o.ExtClient, c)
+}
+
+func (o *ThirdApi) DeleteDeviceToken(c *gin.Context) {
+	a2r.Call(thirdext.ThirdExtClient.DeleteDeviceToken, o.ExtClient, c)
+}
+
+func (o *ThirdApi) GetDeviceTokens(c *gin.Context) {
+	a2r.Call(thirdext.ThirdExtClient.GetDeviceTokens, o.ExtClient, c)
+}
+
 func (o *ThirdApi) SetAppBadge(c *gin.Context) {
 	a2r.Call(third.ThirdClient.SetAppBadge, o.Client, c)
 }
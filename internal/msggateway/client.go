@@ -15,12 +15,15 @@
 package msggateway
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"runtime/debug"
 	"sync"
 	"sync/atomic"
+	"time"
 
+	"github.com/openimsdk/open-im-server/v3/pkg/common/prommetrics"
 	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
 	"github.com/openimsdk/protocol/constant"
 	"github.com/openimsdk/protocol/sdkws"
@@ -37,6 +40,8 @@ var (
 	ErrNotSupportMessageProtocol = errs.New("not support message protocol")
 	ErrClientClosed              = errs.New("client actively close the connection")
 	ErrPanic                     = errs.New("panic error")
+	ErrMissedPong                = errs.New("conn missed pongs")
+	ErrSlowConsumer              = errs.New("conn outbound queue is full")
 )
 
 const (
@@ -65,6 +70,8 @@ type Client struct {
 	conn           LongConn
 	PlatformID     int    `json:"platformID"`
 	IsCompress     bool   `json:"isCompress"`
+	Compression    string `json:"compression"`
+	Encoding       string `json:"encoding"`
 	UserID         string `json:"userID"`
 	IsBackground   bool   `json:"isBackground"`
 	ctx            *UserConnContext
@@ -72,14 +79,44 @@ type Client struct {
 	closed         atomic.Bool
 	closedErr      error
 	token          string
+	encoder        Encoder
+	compressor     Compressor
+	// session is the resume session of the connection, nil if it is not connected with resumable=true.
+	session *resumeSession
+	// pushMu keeps the push frames written in the order of their msgIncr, it is taken before the lock of the session.
+	pushMu sync.Mutex
+	// resumable is false if the connection is closed on purpose, so its session is not kept.
+	resumable atomic.Bool
+	// readWait is the read deadline of the connection, extended by each message and pong.
+	readWait time.Duration
+	// lastActive is the unix nano of the last message or pong received.
+	lastActive atomic.Int64
+	// done is closed when the connection is closed.
+	done chan struct{}
+	// connectTime is when the connection is established.
+	connectTime time.Time
+	// bytesIn and bytesOut are the sizes of the messages read and written.
+	bytesIn  atomic.Int64
+	bytesOut atomic.Int64
+	// outbound is the queue of the frames written by writeLoop, nil if the frames are written by the senders.
+	outbound *outboundQueue
+	// reconnectAsked is set once the draining node has asked the client to reconnect to another node.
+	reconnectAsked atomic.Bool
 }
 
-// ResetClient updates the client's state with new connection and context information.
+// ResetClient sets the client's state with the new connection and context information,
+// a client is used for a single connection and is never reset for another one.
 func (c *Client) ResetClient(ctx *UserConnContext, conn LongConn, longConnServer LongConnServer) {
 	c.w = new(sync.Mutex)
 	c.conn = conn
 	c.PlatformID = stringutil.StringToInt(ctx.GetPlatformID())
-	c.IsCompress = ctx.GetCompression()
+	c.compressor, c.IsCompress = longConnServer.GetCompressor(ctx.GetCompression())
+	c.Compression = ""
+	if c.IsCompress {
+		c.Compression = ctx.GetCompression()
+	}
+	c.Encoding = ctx.GetEncoding()
+	c.encoder, _ = getEncoder(c.Encoding)
 	c.IsBackground = ctx.GetBackground()
 	c.UserID = ctx.GetUserID()
 	c.ctx = ctx
@@ -88,64 +125,111 @@ func (c *Client) ResetClient(ctx *UserConnContext, conn LongConn, longConnServer
 	c.closed.Store(false)
 	c.closedErr = nil
 	c.token = ctx.GetToken()
+	c.session = nil
+	c.resumable.Store(true)
+	c.readWait = pongWait
+	c.lastActive.Store(time.Now().UnixNano())
+	c.done = make(chan struct{})
+	c.connectTime = time.Now()
+	c.outbound = nil
+	c.reconnectAsked.Store(false)
+	c.bytesIn.Store(0)
+	c.bytesOut.Store(0)
 }
 
 func (c *Client) pingHandler(_ string) error {
-	if err := c.conn.SetReadDeadline(pongWait); err != nil {
+	c.lastActive.Store(time.Now().UnixNano())
+	if err := c.conn.SetReadDeadline(c.readWait); err != nil {
 		return err
 	}
 
 	return c.writePongMsg()
 }
 
+func (c *Client) pongHandler(_ string) error {
+	c.lastActive.Store(time.Now().UnixNano())
+	return c.conn.SetReadDeadline(c.readWait)
+}
+
+// keepAlive pings the peer every interval, the connection is closed if neither a message nor a pong
+// has been received for missedPongs intervals, e.g. it is half-open after a network partition.
+func (c *Client) keepAlive(done <-chan struct{}, interval time.Duration, missedPongs int) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			lastActive := time.Unix(0, c.lastActive.Load())
+			if time.Since(lastActive) > interval*time.Duration(missedPongs) {
+				log.ZInfo(c.ctx, "reap dead conn", "lastActive", lastActive, "missedPongs", missedPongs)
+				prommetrics.GateWayReapedConnCounter.Inc()
+				c.closeWithErr(ErrMissedPong)
+				return
+			}
+			if err := c.writePingMsg(); err != nil {
+				log.ZWarn(c.ctx, "writePingMsg", err)
+			}
+		}
+	}
+}
+
 // readMessage continuously reads messages from the connection.
 func (c *Client) readMessage() {
+	var closeErr error
 	defer func() {
 		if r := recover(); r != nil {
-			c.closedErr = ErrPanic
+			closeErr = ErrPanic
 			fmt.Println("socket have panic err:", r, string(debug.Stack()))
 		}
-		c.close()
+		c.closeWithErr(closeErr)
 	}()
 
 	c.conn.SetReadLimit(maxMessageSize)
-	_ = c.conn.SetReadDeadline(pongWait)
+	_ = c.conn.SetReadDeadline(c.readWait)
 	c.conn.SetPingHandler(c.pingHandler)
+	c.conn.SetPongHandler(c.pongHandler)
 
 	for {
 		log.ZDebug(c.ctx, "readMessage")
 		messageType, message, returnErr := c.conn.ReadMessage()
 		if returnErr != nil {
 			log.ZWarn(c.ctx, "readMessage", returnErr, "messageType", messageType)
-			c.closedErr = returnErr
+			closeErr = returnErr
 			return
 		}
+		c.lastActive.Store(time.Now().UnixNano())
+		c.bytesIn.Add(int64(len(message)))
 
 		log.ZDebug(c.ctx, "readMessage", "messageType", messageType)
 		if c.closed.Load() {
 			// The scenario where the connection has just been closed, but the coroutine has not exited
-			c.closedErr = ErrConnClosed
+			closeErr = ErrConnClosed
 			return
 		}
 
 		switch messageType {
-		case MessageBinary:
-			_ = c.conn.SetReadDeadline(pongWait)
+		case MessageBinary, MessageText:
+			// text frames are only used by the json encoding without compression
+			if messageType == MessageText && (c.Encoding != JsonEncodingProtocol || c.IsCompress) {
+				closeErr = ErrNotSupportMessageProtocol
+				return
+			}
+			_ = c.conn.SetReadDeadline(c.readWait)
 			parseDataErr := c.handleMessage(message)
 			if parseDataErr != nil {
-				c.closedErr = parseDataErr
+				closeErr = parseDataErr
 				return
 			}
-		case MessageText:
-			c.closedErr = ErrNotSupportMessageProtocol
-			return
 
 		case PingMessage:
 			err := c.writePongMsg()
 			log.ZError(c.ctx, "writePongMsg", err)
 
 		case CloseMessage:
-			c.closedErr = ErrClientClosed
+			closeErr = ErrClientClosed
+			c.resumable.Store(false)
 			return
 		default:
 		}
@@ -160,14 +244,14 @@ func (c *Client) handleMessage(message []byte) error {
 	defer reqPool.Put(binaryReq)
 	if c.IsCompress {
 		var err error
-		err = c.longConnServer.DecompressWithExternalPool(message, buf)
+		err = c.compressor.DecompressWithExternalPool(message, buf)
 		if err != nil {
 			return errs.Wrap(err)
 		}
 	} else {
 		buf.Write(message)
 	}
-	err := c.longConnServer.DecodeWithExternalPool(buf, binaryReq)
+	err := c.encoder.DecodeWithExternalPool(buf, binaryReq)
 	if err != nil {
 		return err
 	}
@@ -204,6 +288,8 @@ func (c *Client) handleMessage(message []byte) error {
 		resp, messageErr = c.longConnServer.UserLogout(ctx, binaryReq)
 	case WsSetBackgroundStatus:
 		resp, messageErr = c.setAppBackgroundStatus(ctx, binaryReq)
+	case WSSendEphemeralMsg:
+		resp, messageErr = c.longConnServer.SendEphemeralMsg(ctx, c, binaryReq)
 	default:
 		return fmt.Errorf(
 			"ReqIdentifier failed,sendID:%s,msgIncr:%s,reqIdentifier:%d",
@@ -228,14 +314,24 @@ func (c *Client) setAppBackgroundStatus(ctx context.Context, req *Req) ([]byte,
 }
 
 func (c *Client) close() {
+	c.closeWithErr(nil)
+}
+
+// closeWithErr closes the connection, err is the close reason if the connection is not closed yet.
+func (c *Client) closeWithErr(err error) {
 	if c.closed.Load() {
 		return
 	}
 
 	c.w.Lock()
 	defer c.w.Unlock()
+	if c.closed.Load() {
+		return
+	}
 
+	c.closedErr = err
 	c.closed.Store(true)
+	close(c.done)
 	c.conn.Close()
 	c.longConnServer.UnRegister(c)
 }
@@ -257,12 +353,43 @@ func (c *Client) replyMessage(ctx context.Context, binaryReq *Req, err error, re
 	}
 
 	if binaryReq.ReqIdentifier == WsLogoutMsg {
+		c.resumable.Store(false)
 		return errs.New("user logout", "operationID", binaryReq.OperationID).Wrap()
 	}
 	return nil
 }
 
 func (c *Client) PushMessage(ctx context.Context, msgData *sdkws.MsgData) error {
+	resp, err := newPushResp(ctx, msgData)
+	if err != nil {
+		return err
+	}
+	return c.pushResp(resp)
+}
+
+// pushResp writes the push frame, it is numbered and buffered if the connection is resumable.
+func (c *Client) pushResp(resp Resp) error {
+	if s := c.session; s != nil {
+		c.pushMu.Lock()
+		defer c.pushMu.Unlock()
+		// the push frames of a resumable connection are numbered and buffered to be replayed on resumption,
+		// the frame is written after the session is unlocked, as the write blocks without the outbound queue
+		s.mu.Lock()
+		if s.client == c {
+			resp = s.addPush(resp)
+			if s.replaying == c {
+				// written by the replay after the missed pushes
+				s.pending = append(s.pending, resp)
+				s.mu.Unlock()
+				return nil
+			}
+		}
+		s.mu.Unlock()
+	}
+	return c.writeBinaryMsg(resp)
+}
+
+func newPushResp(ctx context.Context, msgData *sdkws.MsgData) (Resp, error) {
 	var msg sdkws.PushMessages
 	conversationID := msgprocessor.GetConversationIDByMsg(msgData)
 	m := map[string]*sdkws.PullMsgs{conversationID: {Msgs: []*sdkws.MsgData{msgData}}}
@@ -274,14 +401,13 @@ func (c *Client) PushMessage(ctx context.Context, msgData *sdkws.MsgData) error
 	log.ZDebug(ctx, "PushMessage", "msg", &msg)
 	data, err := proto.Marshal(&msg)
 	if err != nil {
-		return err
+		return Resp{}, err
 	}
-	resp := Resp{
+	return Resp{
 		ReqIdentifier: WSPushMsg,
 		OperationID:   mcontext.GetOperationID(ctx),
 		Data:          data,
-	}
-	return c.writeBinaryMsg(resp)
+	}, nil
 }
 
 func (c *Client) KickOnlineMessage() error {
@@ -289,42 +415,155 @@ func (c *Client) KickOnlineMessage() error {
 		ReqIdentifier: WSKickOnlineMsg,
 	}
 	log.ZDebug(c.ctx, "KickOnlineMessage debug ")
-	err := c.writeBinaryMsg(resp)
-	c.close()
-	return err
+	c.resumable.Store(false)
+	return c.writeFinalMsg(resp)
+}
+
+// ReconnectMessage asks the client to reconnect to another node and closes the connection, the node is draining.
+func (c *Client) ReconnectMessage() error {
+	resp := Resp{
+		ReqIdentifier: WSReconnectMsg,
+	}
+	c.resumable.Store(false)
+	return c.writeFinalMsg(resp)
+}
+
+// writeFinalMsg writes the last frame and closes the connection, after the frames queued before it.
+func (c *Client) writeFinalMsg(resp Resp) error {
+	if c.outbound == nil {
+		err := c.writeBinaryMsg(resp)
+		c.close()
+		return err
+	}
+	buf := new(bytes.Buffer)
+	if err := c.encodeFrame(resp, buf); err != nil {
+		c.close()
+		return err
+	}
+	return c.sendFrame(outFrame{data: buf.Bytes(), critical: true, final: true})
 }
 
 func (c *Client) writeBinaryMsg(resp Resp) error {
 	if c.closed.Load() {
 		return nil
 	}
+	if c.outbound != nil {
+		// the frame is owned by the queue until it is written
+		buf := new(bytes.Buffer)
+		if err := c.encodeFrame(resp, buf); err != nil {
+			return err
+		}
+		return c.sendFrame(outFrame{data: buf.Bytes(), critical: isCriticalFrame(resp.ReqIdentifier)})
+	}
 	buf := bufferPool.Get()
 	defer bufferPool.Put(buf)
-	err := c.longConnServer.EncodeWithExternalPool(resp, buf)
-	if err != nil {
+	if err := c.encodeFrame(resp, buf); err != nil {
 		return err
 	}
+	return c.writeFrame(buf.Bytes())
+}
 
+// sendFrame queues the frame for writeLoop, or writes it if there is no queue.
+// The data of the frame must not be modified after it is sent.
+func (c *Client) sendFrame(frame outFrame) error {
+	if c.outbound == nil {
+		err := c.writeFrame(frame.data)
+		if frame.final {
+			c.close()
+		}
+		return err
+	}
+	if !c.outbound.push(frame) {
+		log.ZWarn(c.ctx, "disconnect slow consumer", nil, "queueSize", c.outbound.size, "policy", c.outbound.policy)
+		prommetrics.GateWaySlowConnCounter.Inc()
+		// close waits for the frame being written, the sender must not
+		go c.closeWithErr(ErrSlowConsumer)
+		return ErrSlowConsumer
+	}
+	return nil
+}
+
+// writeLoop writes the queued frames until the connection is closed.
+func (c *Client) writeLoop(done <-chan struct{}, q *outboundQueue) {
+	defer q.close()
+	for {
+		select {
+		case <-done:
+			return
+		case <-q.ready:
+		}
+		for _, frame := range q.pop() {
+			// the frames left are dropped once the connection is closed
+			select {
+			case <-done:
+				return
+			default:
+			}
+			if err := c.writeFrame(frame.data); err != nil {
+				log.ZWarn(c.ctx, "writeFrame", err)
+				c.close()
+				return
+			}
+			if frame.final {
+				c.close()
+				return
+			}
+		}
+	}
+}
+
+// encodeFrame encodes and compresses resp into buf with the encoder and compressor of the client,
+// the frame can be written to the clients of the same encoding and compression.
+func (c *Client) encodeFrame(resp Resp, buf *bytes.Buffer) error {
+	if !c.IsCompress {
+		return c.encoder.EncodeWithExternalPool(resp, buf)
+	}
+	encodeBuf := bufferPool.Get()
+	defer bufferPool.Put(encodeBuf)
+	if err := c.encoder.EncodeWithExternalPool(resp, encodeBuf); err != nil {
+		return err
+	}
+	return c.compressor.CompressWithExternalPool(encodeBuf.Bytes(), buf)
+}
+
+// writeFrame writes the frame encoded by encodeFrame.
+func (c *Client) writeFrame(frame []byte) error {
 	c.w.Lock()
 	defer c.w.Unlock()
+	if c.closed.Load() {
+		return nil
+	}
 
-	err = c.conn.SetWriteDeadline(writeWait)
+	err := c.conn.SetWriteDeadline(writeWait)
 	if err != nil {
 		return err
 	}
 
-	if c.IsCompress {
-		resultBuf := bufferPool.Get()
-		defer bufferPool.Put(resultBuf)
+	messageType := MessageBinary
+	if c.Encoding == JsonEncodingProtocol && !c.IsCompress {
+		messageType = MessageText
+	}
+	if err := c.conn.WriteMessage(messageType, frame); err != nil {
+		return err
+	}
+	c.bytesOut.Add(int64(len(frame)))
+	return nil
+}
 
-		compressErr := c.longConnServer.CompressWithExternalPool(buf.Bytes(), resultBuf)
-		if compressErr != nil {
-			return compressErr
-		}
-		return c.conn.WriteMessage(MessageBinary, resultBuf.Bytes())
+func (c *Client) writePingMsg() error {
+	if c.closed.Load() {
+		return nil
+	}
+
+	c.w.Lock()
+	defer c.w.Unlock()
+
+	err := c.conn.SetWriteDeadline(writeWait)
+	if err != nil {
+		return err
 	}
 
-	return c.conn.WriteMessage(MessageBinary, buf.Bytes())
+	return c.conn.WriteMessage(PingMessage, nil)
 }
 
 func (c *Client) writePongMsg() error {
@@ -0,0 +1,138 @@
+package msggateway
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+type pingConn struct {
+	LongConn
+	mu     sync.Mutex
+	pings  int
+	closed bool
+}
+
+func (c *pingConn) SetWriteDeadline(time.Duration) error { return nil }
+
+func (c *pingConn) WriteMessage(messageType int, _ []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if messageType == PingMessage {
+		c.pings++
+	}
+	return nil
+}
+
+func (c *pingConn) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.closed = true
+	return nil
+}
+
+func (c *pingConn) state() (int, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.pings, c.closed
+}
+
+type unregisterServer struct {
+	LongConnServer
+	mu      sync.Mutex
+	clients []*Client
+}
+
+func (s *unregisterServer) UnRegister(c *Client) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.clients = append(s.clients, c)
+}
+
+func newKeepAliveClient(conn LongConn, server LongConnServer) *Client {
+	c := &Client{
+		w:              new(sync.Mutex),
+		conn:           conn,
+		longConnServer: server,
+		ctx:            newTempContext(),
+		done:           make(chan struct{}),
+	}
+	c.lastActive.Store(time.Now().UnixNano())
+	return c
+}
+
+func TestKeepAlivePingsActiveConn(t *testing.T) {
+	conn := &pingConn{}
+	server := &unregisterServer{}
+	c := newKeepAliveClient(conn, server)
+	interval := 10 * time.Millisecond
+
+	stop := make(chan struct{})
+	go func() {
+		// the pongs keep the conn active.
+		ticker := time.NewTicker(interval / 2)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				c.lastActive.Store(time.Now().UnixNano())
+			}
+		}
+	}()
+	exited := make(chan struct{})
+	go func() {
+		c.keepAlive(c.done, interval, 2)
+		close(exited)
+	}()
+
+	time.Sleep(interval * 10)
+	close(stop)
+	pings, closed := conn.state()
+	if closed || c.closed.Load() {
+		t.Fatal("active conn reaped")
+	}
+	if pings == 0 {
+		t.Fatal("active conn not pinged")
+	}
+
+	c.close()
+	select {
+	case <-exited:
+	case <-time.After(time.Second):
+		t.Fatal("keepAlive not stopped after close")
+	}
+}
+
+func TestKeepAliveReapsMissedPongs(t *testing.T) {
+	conn := &pingConn{}
+	server := &unregisterServer{}
+	c := newKeepAliveClient(conn, server)
+	interval := 10 * time.Millisecond
+
+	exited := make(chan struct{})
+	go func() {
+		c.keepAlive(c.done, interval, 2)
+		close(exited)
+	}()
+	select {
+	case <-exited:
+	case <-time.After(time.Second):
+		t.Fatal("conn missing pongs not reaped")
+	}
+
+	pings, closed := conn.state()
+	if !closed || !c.closed.Load() {
+		t.Fatal("conn missing pongs not closed")
+	}
+	if c.closedErr != ErrMissedPong {
+		t.Fatalf("closedErr %v, want %v", c.closedErr, ErrMissedPong)
+	}
+	if pings > 2 {
+		t.Fatalf("pings %d, want at most 2 before reaping", pings)
+	}
+	if len(server.clients) != 1 || server.clients[0] != c {
+		t.Fatal("reaped conn not unregistered")
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"compress/gzip"
 	"io"
 
+	"github.com/klauspost/compress/zstd"
 	"github.com/openimsdk/tools/errs"
 )
 
@@ -146,3 +147,76 @@ func (g *GzipCompressor) DecompressWithExternalPool(compressedData []byte, rawDa
 	_ = reader.Close()
 	return nil
 }
+
+// zstdMaxDecodedSize limits the memory used to decompress a frame from the clients.
+const zstdMaxDecodedSize = 8 << 20
+
+// ZstdCompressor compresses each frame as a zstd frame, with the optional dictionary shared with the clients
+// it compresses the small IM payloads much better than gzip.
+// The encoder and decoder are safe for concurrent use, so no pool is needed.
+type ZstdCompressor struct {
+	encoder *zstd.Encoder
+	decoder *zstd.Decoder
+}
+
+// NewZstdCompressor creates a zstd compressor of the level (fastest, default, better or best),
+// dict is a dictionary trained by "zstd --train" on sample frames, it may be empty.
+func NewZstdCompressor(level string, dict []byte) (*ZstdCompressor, error) {
+	encoderOpts := []zstd.EOption{zstd.WithEncoderCRC(false), zstd.WithLowerEncoderMem(true)}
+	if level != "" {
+		ok, encoderLevel := zstd.EncoderLevelFromString(level)
+		if !ok {
+			return nil, errs.New("unknown zstd level", "level", level).Wrap()
+		}
+		encoderOpts = append(encoderOpts, zstd.WithEncoderLevel(encoderLevel))
+	}
+	decoderOpts := []zstd.DOption{zstd.WithDecoderMaxMemory(zstdMaxDecodedSize)}
+	if len(dict) > 0 {
+		encoderOpts = append(encoderOpts, zstd.WithEncoderDict(dict))
+		decoderOpts = append(decoderOpts, zstd.WithDecoderDicts(dict))
+	}
+	encoder, err := zstd.NewWriter(nil, encoderOpts...)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "NewZstdCompressor: creating zstd encoder failed")
+	}
+	decoder, err := zstd.NewReader(nil, decoderOpts...)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "NewZstdCompressor: creating zstd decoder failed")
+	}
+	return &ZstdCompressor{encoder: encoder, decoder: decoder}, nil
+}
+
+func (z *ZstdCompressor) Compress(rawData []byte) ([]byte, error) {
+	return z.encoder.EncodeAll(rawData, nil), nil
+}
+
+func (z *ZstdCompressor) CompressWithPool(rawData []byte) ([]byte, error) {
+	return z.Compress(rawData)
+}
+
+func (z *ZstdCompressor) CompressWithExternalPool(rawData []byte, compressedData *bytes.Buffer) error {
+	// EncodeAll appends to the free space of the buffer, Write only takes it over
+	compressedData.Write(z.encoder.EncodeAll(rawData, compressedData.AvailableBuffer()))
+	return nil
+}
+
+func (z *ZstdCompressor) DeCompress(compressedData []byte) ([]byte, error) {
+	decompressedData, err := z.decoder.DecodeAll(compressedData, nil)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "ZstdCompressor.DeCompress: decoding failed")
+	}
+	return decompressedData, nil
+}
+
+func (z *ZstdCompressor) DecompressWithPool(compressedData []byte) ([]byte, error) {
+	return z.DeCompress(compressedData)
+}
+
+func (z *ZstdCompressor) DecompressWithExternalPool(compressedData []byte, rawData *bytes.Buffer) error {
+	decompressedData, err := z.decoder.DecodeAll(compressedData, rawData.AvailableBuffer())
+	if err != nil {
+		return errs.WrapMsg(err, "ZstdCompressor.DecompressWithExternalPool: decoding failed")
+	}
+	rawData.Write(decompressedData)
+	return nil
+}
@@ -17,10 +17,14 @@ package msggateway
 import (
 	"bytes"
 	"crypto/rand"
+	"fmt"
 	"sync"
 	"testing"
 
+	"github.com/klauspost/compress/zstd"
+	"github.com/openimsdk/protocol/sdkws"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
 )
 
 var testBufferPool = NewPool[*bytes.Buffer](func() *bytes.Buffer { return new(bytes.Buffer) },
@@ -175,3 +179,132 @@ func BenchmarkDecompressWithExternalPool(b *testing.B) {
 		testBufferPool.Put(buf)
 	}
 }
+
+// mockPushFrames returns the gob frames of text msg pushes like the ones sent to the clients.
+func mockPushFrames(tb testing.TB, start int, n int) [][]byte {
+	encoder := NewGobEncoder()
+	frames := make([][]byte, 0, n)
+	for i := start; i < start+n; i++ {
+		msg := &sdkws.MsgData{
+			SendID:           fmt.Sprintf("%010d", 1000000+i),
+			RecvID:           fmt.Sprintf("%010d", 2000000+i),
+			ClientMsgID:      fmt.Sprintf("%032x", i*7919),
+			ServerMsgID:      fmt.Sprintf("%032x", i*104729),
+			SenderPlatformID: 1,
+			SenderNickname:   fmt.Sprintf("user%d", i),
+			SessionType:      1,
+			MsgFrom:          100,
+			ContentType:      101,
+			Content:          []byte(fmt.Sprintf(`{"content":"hello %d, see you tomorrow"}`, i)),
+			Seq:              int64(i + 1),
+			SendTime:         1700000000000 + int64(i),
+			CreateTime:       1700000000000 + int64(i),
+		}
+		data, err := proto.Marshal(&sdkws.PushMessages{Msgs: map[string]*sdkws.PullMsgs{
+			"si_" + msg.SendID + "_" + msg.RecvID: {Msgs: []*sdkws.MsgData{msg}},
+		}})
+		assert.Nil(tb, err)
+		frame, err := encoder.Encode(Resp{ReqIdentifier: WSPushMsg, OperationID: fmt.Sprintf("%d", i), Data: data})
+		assert.Nil(tb, err)
+		frames = append(frames, frame)
+	}
+	return frames
+}
+
+func mockZstdDict(tb testing.TB) []byte {
+	frames := mockPushFrames(tb, 0, 200)
+	dict, err := zstd.BuildDict(zstd.BuildDictOptions{
+		ID:       1,
+		Contents: frames,
+		History:  bytes.Join(frames[:50], nil),
+		Offsets:  [3]int{1, 4, 8},
+	})
+	assert.Nil(tb, err)
+	return dict
+}
+
+func TestZstdCompressDecompress(t *testing.T) {
+	dict := mockZstdDict(t)
+	for _, d := range [][]byte{nil, dict} {
+		compressor, err := NewZstdCompressor("default", d)
+		assert.Nil(t, err)
+		for _, src := range mockPushFrames(t, 1000, 100) {
+			buf := testBufferPool.Get()
+			assert.Nil(t, compressor.CompressWithExternalPool(src, buf))
+			res := testBufferPool.Get()
+			assert.Nil(t, compressor.DecompressWithExternalPool(buf.Bytes(), res))
+			assert.Equal(t, src, res.Bytes())
+			testBufferPool.Put(buf)
+			testBufferPool.Put(res)
+		}
+	}
+	_, err := NewZstdCompressor("unknown", nil)
+	assert.NotNil(t, err)
+}
+
+func TestZstdDictSmallerThanGzip(t *testing.T) {
+	gzipCompressor := NewGzipCompressor()
+	zstdCompressor, err := NewZstdCompressor("default", mockZstdDict(t))
+	assert.Nil(t, err)
+	src := mockPushFrames(t, 1000, 1)[0]
+	gzipData, err := gzipCompressor.Compress(src)
+	assert.Nil(t, err)
+	zstdData, err := zstdCompressor.Compress(src)
+	assert.Nil(t, err)
+	t.Logf("raw %d bytes, gzip %d bytes, zstd with dict %d bytes", len(src), len(gzipData), len(zstdData))
+	assert.Less(t, len(zstdData), len(gzipData))
+}
+
+// benchmarkFrameCompressor compresses the push frames and reports the compressed size per raw size.
+func benchmarkFrameCompressor(b *testing.B, compressor Compressor) {
+	frames := mockPushFrames(b, 1000, 100)
+	var rawSize, compressedSize int
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		src := frames[i%len(frames)]
+		buf := testBufferPool.Get()
+		err := compressor.CompressWithExternalPool(src, buf)
+		assert.Equal(b, nil, err)
+		rawSize += len(src)
+		compressedSize += buf.Len()
+		testBufferPool.Put(buf)
+	}
+	b.ReportMetric(float64(compressedSize)/float64(rawSize), "ratio")
+}
+
+func BenchmarkFrameCompressGzip(b *testing.B) {
+	benchmarkFrameCompressor(b, NewGzipCompressor())
+}
+
+func BenchmarkFrameCompressZstd(b *testing.B) {
+	compressor, err := NewZstdCompressor("default", nil)
+	assert.Nil(b, err)
+	benchmarkFrameCompressor(b, compressor)
+}
+
+func BenchmarkFrameCompressZstdDict(b *testing.B) {
+	compressor, err := NewZstdCompressor("default", mockZstdDict(b))
+	assert.Nil(b, err)
+	benchmarkFrameCompressor(b, compressor)
+}
+
+func BenchmarkFrameDecompressZstdDict(b *testing.B) {
+	compressor, err := NewZstdCompressor("default", mockZstdDict(b))
+	assert.Nil(b, err)
+	frames := mockPushFrames(b, 1000, 100)
+	compressed := make([][]byte, 0, len(frames))
+	for _, frame := range frames {
+		data, err := compressor.Compress(frame)
+		assert.Nil(b, err)
+		compressed = append(compressed, data)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf := testBufferPool.Get()
+		err := compressor.DecompressWithExternalPool(compressed[i%len(compressed)], buf)
+		assert.Equal(b, nil, err)
+		testBufferPool.Put(buf)
+	}
+}
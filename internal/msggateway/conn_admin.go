@@ -0,0 +1,106 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msggateway
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/gateway"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+func (s *Server) ListConns(ctx context.Context, req *gateway.ListConnsReq) (*gateway.ListConnsResp, error) {
+	if !authverify.IsAppManagerUid(ctx, s.config.Share.IMAdminUserID) {
+		return nil, errs.ErrNoPermission.WrapMsg("only app manager")
+	}
+	if err := req.Check(); err != nil {
+		return nil, errs.ErrArgs.WrapMsg(err.Error())
+	}
+	total, conns := s.LongConnServer.ListConnInfos(req.UserIDs, req.End())
+	return &gateway.ListConnsResp{Total: total, Conns: conns}, nil
+}
+
+func (s *Server) KickConn(ctx context.Context, req *gateway.KickConnReq) (*gateway.KickConnResp, error) {
+	if !authverify.IsAppManagerUid(ctx, s.config.Share.IMAdminUserID) {
+		return nil, errs.ErrNoPermission.WrapMsg("only app manager")
+	}
+	if err := req.Check(); err != nil {
+		return nil, errs.ErrArgs.WrapMsg(err.Error())
+	}
+	if req.ByLoginRule {
+		return &gateway.KickConnResp{Kicked: s.LongConnServer.KickLoginRuleConn(ctx, req.UserID, req.ConnID)}, nil
+	}
+	kicked := s.LongConnServer.KickConn(req.UserID, req.ConnID)
+	if kicked {
+		log.ZInfo(ctx, "conn kicked by admin", "userID", req.UserID, "connID", req.ConnID)
+	}
+	return &gateway.KickConnResp{Kicked: kicked}, nil
+}
+
+func (ws *WsServer) ListConnInfos(userIDs []string, end int) (int64, []*gateway.ConnInfo) {
+	var conns []*gateway.ConnInfo
+	if len(userIDs) == 0 {
+		ws.clients.Range(func(client *Client) bool {
+			if !client.closed.Load() {
+				conns = append(conns, client.connInfo())
+			}
+			return true
+		})
+	} else {
+		for _, userID := range datautil.Distinct(userIDs) {
+			conns = append(conns, ws.GetUserConnInfos(userID)...)
+		}
+	}
+	gateway.SortConnInfos(conns)
+	total := int64(len(conns))
+	if len(conns) > end {
+		conns = conns[:end]
+	}
+	return total, conns
+}
+
+func (ws *WsServer) KickConn(userID string, connID string) bool {
+	clients, _ := ws.clients.GetAll(userID)
+	for _, client := range clients {
+		if client.ctx.GetConnID() != connID || client.closed.Load() {
+			continue
+		}
+		// the client reconnects instead of logging out as it does for WSKickOnlineMsg,
+		// it is unregistered when the connection is closed, which counts the online users.
+		if err := client.ReconnectMessage(); err != nil {
+			log.ZWarn(client.ctx, "ReconnectMessage", err)
+		}
+		return true
+	}
+	return false
+}
+
+func (c *Client) connInfo() *gateway.ConnInfo {
+	return &gateway.ConnInfo{
+		ConnID:       c.ctx.GetConnID(),
+		UserID:       c.UserID,
+		PlatformID:   int32(c.PlatformID),
+		ConnectTime:  c.connectTime.UnixMilli(),
+		RemoteAddr:   c.ctx.GetRemoteAddr(),
+		IsBackground: c.IsBackground,
+		Compression:  c.Compression,
+		Encoding:     c.Encoding,
+		BytesIn:      c.bytesIn.Load(),
+		BytesOut:     c.bytesOut.Load(),
+	}
+}
@@ -0,0 +1,96 @@
+package msggateway
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/gateway"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func addAdminClient(ws *WsServer, userID string, connID string, connectTime int64) (*Client, *drainConn) {
+	conn := &drainConn{}
+	c := &Client{
+		w:              new(sync.Mutex),
+		conn:           conn,
+		longConnServer: &unregisterServer{},
+		UserID:         userID,
+		PlatformID:     constant.AndroidPlatformID,
+		Encoding:       JsonEncodingProtocol,
+		connectTime:    time.UnixMilli(connectTime),
+		ctx:            &UserConnContext{Req: newTempContext().Req, ConnID: connID, RemoteAddr: connID},
+		done:           make(chan struct{}),
+	}
+	c.encoder, _ = getEncoder(JsonEncodingProtocol)
+	ws.clients.Set(userID, c)
+	return c, conn
+}
+
+func connIDs(conns []*gateway.ConnInfo) []string {
+	ids := make([]string, 0, len(conns))
+	for _, conn := range conns {
+		ids = append(ids, conn.ConnID)
+	}
+	return ids
+}
+
+func TestListConnInfos(t *testing.T) {
+	ws := &WsServer{clients: newUserMap(8)}
+	addAdminClient(ws, "u1", "c", 3)
+	addAdminClient(ws, "u1", "a", 1)
+	addAdminClient(ws, "u2", "d", 3)
+	addAdminClient(ws, "u3", "b", 2)
+	closed, _ := addAdminClient(ws, "u3", "e", 0)
+	closed.closed.Store(true)
+
+	// the closed conns are not listed, the others are ordered by connectTime and connID
+	total, conns := ws.ListConnInfos(nil, 10)
+	assert.Equal(t, int64(4), total)
+	assert.Equal(t, []string{"a", "b", "c", "d"}, connIDs(conns))
+
+	// the node returns the conns up to the end of the page, and the total of all
+	total, conns = ws.ListConnInfos(nil, 2)
+	assert.Equal(t, int64(4), total)
+	assert.Equal(t, []string{"a", "b"}, connIDs(conns))
+
+	total, conns = ws.ListConnInfos([]string{"u1", "u3", "u1", "u4"}, 10)
+	assert.Equal(t, int64(3), total)
+	assert.Equal(t, []string{"a", "b", "c"}, connIDs(conns))
+	assert.Equal(t, "u3", conns[1].UserID)
+	assert.Equal(t, int64(2), conns[1].ConnectTime)
+}
+
+func TestKickConn(t *testing.T) {
+	ws := &WsServer{clients: newUserMap(8)}
+	kicked, kickedConn := addAdminClient(ws, "u1", "a", 1)
+	_, keptConn := addAdminClient(ws, "u1", "b", 2)
+
+	assert.False(t, ws.KickConn("u1", "unknown"))
+	assert.False(t, ws.KickConn("u2", "a"))
+
+	require.True(t, ws.KickConn("u1", "a"))
+	assert.True(t, kickedConn.isClosed())
+	assert.False(t, keptConn.isClosed())
+	assert.Equal(t, []string{"b"}, connIDs(ws.GetUserConnInfos("u1")))
+	// the kicked client is removed from the clients by the unregister path, which counts the online users
+	assert.Equal(t, []*Client{kicked}, kicked.longConnServer.(*unregisterServer).clients)
+	clients, _ := ws.clients.GetAll("u1")
+	assert.Len(t, clients, 2)
+	// the kicked client is asked to reconnect
+	require.Len(t, kickedConn.frames, 1)
+	assert.Contains(t, string(kickedConn.frames[0]), `"reqIdentifier":2006`)
+
+	assert.False(t, ws.KickConn("u1", "a"))
+}
+
+func TestListConnsReqCheck(t *testing.T) {
+	assert.NoError(t, (&gateway.ListConnsReq{PageNumber: 1, ShowNumber: gateway.MaxListConnsShowNumber}).Check())
+	assert.Error(t, (&gateway.ListConnsReq{PageNumber: 0, ShowNumber: 10}).Check())
+	assert.Error(t, (&gateway.ListConnsReq{PageNumber: 1, ShowNumber: gateway.MaxListConnsShowNumber + 1}).Check())
+	// the deep pages must be filtered by userIDs
+	assert.Error(t, (&gateway.ListConnsReq{PageNumber: 11, ShowNumber: gateway.MaxListConnsShowNumber}).Check())
+	assert.Equal(t, 30, (&gateway.ListConnsReq{PageNumber: 3, ShowNumber: 10}).End())
+}
@@ -25,8 +25,18 @@ const (
 	OperationID             = "operationID"
 	Compression             = "compression"
 	GzipCompressionProtocol = "gzip"
-	BackgroundStatus        = "isBackground"
-	SendResponse            = "isMsgResp"
+	ZstdCompressionProtocol = "zstd"
+	// Encoding negotiates the encoder of the frames, gob by default.
+	Encoding                 = "encoding"
+	GobEncodingProtocol      = "gob"
+	JsonEncodingProtocol     = "json"
+	ProtobufEncodingProtocol = "protobuf"
+	BackgroundStatus         = "isBackground"
+	SendResponse             = "isMsgResp"
+	// Resumable asks for a resume session, ResumeToken and LastPushIncr resume it on reconnection.
+	Resumable    = "resumable"
+	ResumeToken  = "resumeToken"
+	LastPushIncr = "lastPushIncr"
 )
 
 const (
@@ -39,10 +49,14 @@ const (
 	WSPullMsgBySeqList    = 1002
 	WSSendMsg             = 1003
 	WSSendSignalMsg       = 1004
+	WSSendEphemeralMsg    = 1005
 	WSPushMsg             = 2001
 	WSKickOnlineMsg       = 2002
 	WsLogoutMsg           = 2003
 	WsSetBackgroundStatus = 2004
+	WSResumeSession       = 2005
+	WSReconnectMsg        = 2006
+	WSPushEphemeralMsg    = 2007
 	WSDataError           = 3001
 )
 
@@ -55,4 +69,12 @@ const (
 
 	// Maximum message size allowed from peer.
 	maxMessageSize = 51200
+
+	// Default drain config if it is not configured.
+	defaultDrainTimeout       = 25 * time.Second
+	defaultDrainBatchSize     = 1000
+	defaultDrainBatchInterval = 200 * time.Millisecond
+
+	// Default number of the workers writing the batch pushes if it is not configured.
+	defaultPushWorkers = 100
 )
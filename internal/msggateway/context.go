@@ -136,17 +136,25 @@ func (c *UserConnContext) GetToken() string {
 	return c.Req.URL.Query().Get(Token)
 }
 
-func (c *UserConnContext) GetCompression() bool {
-	compression, exists := c.Query(Compression)
-	if exists && compression == GzipCompressionProtocol {
-		return true
-	} else {
-		compression, exists := c.GetHeader(Compression)
-		if exists && compression == GzipCompressionProtocol {
-			return true
-		}
+// GetCompression returns the compression of each message negotiated by the query parameter or header,
+// it is empty if the messages are not compressed by the gateway.
+func (c *UserConnContext) GetCompression() string {
+	if compression, exists := c.Query(Compression); exists {
+		return compression
 	}
-	return false
+	compression, _ := c.GetHeader(Compression)
+	return compression
+}
+
+// GetEncoding returns the encoder of the frames negotiated by the query parameter or header, gob by default.
+func (c *UserConnContext) GetEncoding() string {
+	if encoding, exists := c.Query(Encoding); exists {
+		return encoding
+	}
+	if encoding, exists := c.GetHeader(Encoding); exists {
+		return encoding
+	}
+	return GobEncodingProtocol
 }
 
 func (c *UserConnContext) ShouldSendResp() bool {
@@ -173,6 +181,28 @@ func (c *UserConnContext) GetBackground() bool {
 	}
 	return b
 }
+
+// GetResumable returns true if the connection asks for a resume session or resumes one.
+func (c *UserConnContext) GetResumable() bool {
+	if _, exists := c.Query(ResumeToken); exists {
+		return true
+	}
+	b, err := strconv.ParseBool(c.Req.URL.Query().Get(Resumable))
+	if err != nil {
+		return false
+	}
+	return b
+}
+
+func (c *UserConnContext) GetResumeToken() string {
+	return c.Req.URL.Query().Get(ResumeToken)
+}
+
+func (c *UserConnContext) GetLastPushIncr() int64 {
+	lastPushIncr, _ := strconv.ParseInt(c.Req.URL.Query().Get(LastPushIncr), 10, 64)
+	return lastPushIncr
+}
+
 func (c *UserConnContext) ParseEssentialArgs() error {
 	_, exists := c.Query(Token)
 	if !exists {
@@ -191,5 +221,8 @@ func (c *UserConnContext) ParseEssentialArgs() error {
 		return servererrs.ErrConnArgsErr.WrapMsg("platformID is not int")
 
 	}
+	if _, ok := getEncoder(c.GetEncoding()); !ok {
+		return servererrs.ErrConnArgsErr.WrapMsg("encoding is not supported")
+	}
 	return nil
 }
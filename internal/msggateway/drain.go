@@ -0,0 +1,106 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msggateway
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/gateway"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/mcontext"
+)
+
+func (s *Server) Drain(ctx context.Context, req *gateway.DrainReq) (*gateway.DrainResp, error) {
+	if !authverify.IsAppManagerUid(ctx, s.config.Share.IMAdminUserID) {
+		return nil, errs.ErrNoPermission.WrapMsg("only app manager")
+	}
+	connNum := s.LongConnServer.Drain(time.Duration(req.Timeout) * time.Second)
+	return &gateway.DrainResp{ConnNum: connNum}, nil
+}
+
+// drainBeforeStop drains the node on SIGTERM before the rpc server stops, so the online pushes
+// to the connections reach them until they reconnect to the other nodes.
+func (s *Server) drainBeforeStop() {
+	s.LongConnServer.Drain(0)
+	<-s.LongConnServer.Drained()
+}
+
+// Drained returns the channel closed once the node is drained.
+func (ws *WsServer) Drained() <-chan struct{} {
+	return ws.drained
+}
+
+// Drain starts draining the node if it is not draining, timeout 0 uses the configured one.
+// It returns the number of the connections when it is called.
+func (ws *WsServer) Drain(timeout time.Duration) int64 {
+	connNum := ws.onlineUserConnNum.Load()
+	if !ws.draining.CompareAndSwap(false, true) {
+		return connNum
+	}
+	if timeout <= 0 {
+		timeout = ws.drainTimeout
+	}
+	go ws.drain(timeout)
+	return connNum
+}
+
+// drain deregisters the node from discovery, and asks the connected clients to reconnect to the other nodes
+// batch by batch, so they do not reconnect at once. ws.drained is closed once they are gone or timeout.
+func (ws *WsServer) drain(timeout time.Duration) {
+	defer close(ws.drained)
+	ctx := mcontext.SetOperationID(context.Background(), fmt.Sprintf("drain_%d_%d", os.Getpid(), time.Now().UnixMilli()))
+	log.ZInfo(ctx, "msg gateway start draining", "timeout", timeout, "online user conn Num", ws.onlineUserConnNum.Load())
+	if ws.disCov != nil {
+		if err := ws.disCov.UnRegister(); err != nil {
+			log.ZWarn(ctx, "unregister from discovery failed", err)
+		}
+	}
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	ticker := time.NewTicker(ws.drainBatchInterval)
+	defer ticker.Stop()
+	for {
+		if ws.onlineUserConnNum.Load() <= 0 {
+			log.ZInfo(ctx, "msg gateway drained")
+			return
+		}
+		// the clients asked before may still be writing the queued frames, each client is asked once
+		var batch []*Client
+		ws.clients.Range(func(client *Client) bool {
+			if !client.closed.Load() && client.reconnectAsked.CompareAndSwap(false, true) {
+				batch = append(batch, client)
+			}
+			return len(batch) < ws.drainBatchSize
+		})
+		for _, client := range batch {
+			go func(client *Client) {
+				if err := client.ReconnectMessage(); err != nil {
+					log.ZWarn(client.ctx, "ReconnectMessage", err)
+				}
+			}(client)
+		}
+		select {
+		case <-ticker.C:
+		case <-timer.C:
+			log.ZWarn(ctx, "msg gateway drain timeout", nil, "online user conn Num", ws.onlineUserConnNum.Load())
+			return
+		}
+	}
+}
@@ -0,0 +1,201 @@
+package msggateway
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+type drainConn struct {
+	LongConn
+	mu     sync.Mutex
+	frames [][]byte
+	closed bool
+}
+
+func (c *drainConn) SetWriteDeadline(time.Duration) error { return nil }
+
+func (c *drainConn) WriteMessage(_ int, message []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.frames = append(c.frames, message)
+	return nil
+}
+
+func (c *drainConn) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.closed = true
+	return nil
+}
+
+func (c *drainConn) isClosed() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.closed
+}
+
+// drainServer unregisters the clients like WsServer.unregisterClient does for the conn num.
+type drainServer struct {
+	LongConnServer
+	ws *WsServer
+}
+
+func (s *drainServer) UnRegister(*Client) {
+	s.ws.onlineUserConnNum.Add(-1)
+}
+
+func newDrainWsServer(timeout time.Duration, batchSize int, batchInterval time.Duration) *WsServer {
+	return &WsServer{
+		clients:            newUserMap(8),
+		drained:            make(chan struct{}),
+		drainTimeout:       timeout,
+		drainBatchSize:     batchSize,
+		drainBatchInterval: batchInterval,
+	}
+}
+
+func addDrainClients(ws *WsServer, n int) []*drainConn {
+	server := &drainServer{ws: ws}
+	conns := make([]*drainConn, n)
+	for i := range conns {
+		conns[i] = &drainConn{}
+		c := &Client{
+			w:              new(sync.Mutex),
+			conn:           conns[i],
+			longConnServer: server,
+			UserID:         "user" + strconv.Itoa(i),
+			Encoding:       JsonEncodingProtocol,
+			ctx:            &UserConnContext{Req: &http.Request{URL: &url.URL{}}, RemoteAddr: "addr" + strconv.Itoa(i)},
+			done:           make(chan struct{}),
+		}
+		c.encoder, _ = getEncoder(JsonEncodingProtocol)
+		ws.clients.Set(c.UserID, c)
+		ws.onlineUserConnNum.Add(1)
+	}
+	return conns
+}
+
+func closedConns(conns []*drainConn) int {
+	var n int
+	for _, conn := range conns {
+		if conn.isClosed() {
+			n++
+		}
+	}
+	return n
+}
+
+func waitDrained(t *testing.T, ws *WsServer, timeout time.Duration) {
+	t.Helper()
+	select {
+	case <-ws.drained:
+	case <-time.After(timeout):
+		t.Fatal("node not drained")
+	}
+}
+
+func TestDrainBatches(t *testing.T) {
+	ws := newDrainWsServer(time.Minute, 2, 100*time.Millisecond)
+	conns := addDrainClients(ws, 5)
+
+	if connNum := ws.Drain(0); connNum != 5 {
+		t.Fatalf("Drain returned %d, want 5", connNum)
+	}
+	if !ws.draining.Load() {
+		t.Fatal("node not draining")
+	}
+	// the node drains once, the later calls only return the conn num
+	if connNum := ws.Drain(0); connNum > 5 {
+		t.Fatalf("second Drain returned %d", connNum)
+	}
+
+	time.Sleep(50 * time.Millisecond)
+	if n := closedConns(conns); n != 2 {
+		t.Fatalf("closed %d conns in the first batch, want 2", n)
+	}
+
+	waitDrained(t, ws, time.Second)
+	if n := closedConns(conns); n != len(conns) {
+		t.Fatalf("closed %d conns, want %d", n, len(conns))
+	}
+	if n := ws.onlineUserConnNum.Load(); n != 0 {
+		t.Fatalf("online conn num %d after drained, want 0", n)
+	}
+	for _, conn := range conns {
+		if len(conn.frames) != 1 {
+			t.Fatalf("conn got %d frames, want 1", len(conn.frames))
+		}
+		var resp Resp
+		if err := json.Unmarshal(conn.frames[0], &resp); err != nil {
+			t.Fatal(err)
+		}
+		if resp.ReqIdentifier != WSReconnectMsg {
+			t.Fatalf("reqIdentifier %d, want %d", resp.ReqIdentifier, WSReconnectMsg)
+		}
+	}
+}
+
+func TestDrainTimeout(t *testing.T) {
+	ws := newDrainWsServer(time.Minute, 2, 10*time.Millisecond)
+	// a conn that never goes away
+	ws.onlineUserConnNum.Add(1)
+
+	start := time.Now()
+	ws.Drain(50 * time.Millisecond)
+	waitDrained(t, ws, time.Second)
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+		t.Fatalf("drained after %v, before the timeout", elapsed)
+	}
+}
+
+func TestDrainAsksEachClientOnce(t *testing.T) {
+	ws := newDrainWsServer(100*time.Millisecond, 2, 10*time.Millisecond)
+	addDrainClients(ws, 5)
+	// the clients are slow consumers, the reconnect msgs are queued and the connections are not closed
+	var clients []*Client
+	ws.clients.Range(func(client *Client) bool {
+		client.outbound = newOutboundQueue(10, OverflowDisconnect)
+		clients = append(clients, client)
+		return true
+	})
+
+	ws.Drain(0)
+	waitDrained(t, ws, time.Second)
+	for _, client := range clients {
+		if !client.reconnectAsked.Load() {
+			t.Fatal("client not asked to reconnect")
+		}
+		if frames := client.outbound.pop(); len(frames) != 1 || !frames[0].final {
+			t.Fatalf("client queued %d frames, want the final reconnect msg", len(frames))
+		}
+	}
+}
+
+func TestDrainBeforeStop(t *testing.T) {
+	ws := newDrainWsServer(time.Minute, 2, 10*time.Millisecond)
+	conns := addDrainClients(ws, 3)
+	s := &Server{LongConnServer: ws}
+
+	// the rpc server stops only after the connections are drained
+	stopped := make(chan struct{})
+	go func() {
+		s.drainBeforeStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(time.Second):
+		t.Fatal("drainBeforeStop not returned")
+	}
+	if n := closedConns(conns); n != len(conns) {
+		t.Fatalf("closed %d conns before stop, want %d", n, len(conns))
+	}
+	if n := ws.onlineUserConnNum.Load(); n != 0 {
+		t.Fatalf("online conn num %d before stop, want 0", n)
+	}
+}
@@ -17,8 +17,15 @@ package msggateway
 import (
 	"bytes"
 	"encoding/gob"
+	"encoding/json"
 
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/gateway"
+	"github.com/openimsdk/protocol/msg"
+	"github.com/openimsdk/protocol/push"
+	"github.com/openimsdk/protocol/sdkws"
 	"github.com/openimsdk/tools/errs"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 )
 
 type Encoder interface {
@@ -69,3 +76,311 @@ func (g *GobEncoder) DecodeWithExternalPool(encodeData *bytes.Buffer, rawData an
 	}
 	return nil
 }
+
+var encoders = map[string]Encoder{
+	GobEncodingProtocol:      NewGobEncoder(),
+	JsonEncodingProtocol:     NewJsonEncoder(),
+	ProtobufEncodingProtocol: NewProtobufEncoder(),
+}
+
+// getEncoder returns the encoder of the frames negotiated by the encoding query parameter.
+func getEncoder(encoding string) (Encoder, bool) {
+	encoder, ok := encoders[encoding]
+	return encoder, ok
+}
+
+// ProtobufEncoder encodes the frames as the protobuf-binary of gateway.Req and gateway.Resp.
+type ProtobufEncoder struct{}
+
+func NewProtobufEncoder() *ProtobufEncoder {
+	return &ProtobufEncoder{}
+}
+
+func (p *ProtobufEncoder) Encode(rawData any) ([]byte, error) {
+	frame, err := toProtoFrame(rawData)
+	if err != nil {
+		return nil, err
+	}
+	data, err := proto.Marshal(frame)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "ProtobufEncoder.Encode failed", "action", "encode")
+	}
+	return data, nil
+}
+
+func (p *ProtobufEncoder) EncodeWithExternalPool(rawData any, encodeData *bytes.Buffer) error {
+	data, err := p.Encode(rawData)
+	if err != nil {
+		return err
+	}
+	_, err = encodeData.Write(data)
+	return err
+}
+
+func (p *ProtobufEncoder) Decode(encodeData []byte, rawData any) error {
+	switch v := rawData.(type) {
+	case *Req:
+		var frame gateway.Req
+		if err := proto.Unmarshal(encodeData, &frame); err != nil {
+			return errs.WrapMsg(err, "ProtobufEncoder.Decode failed", "action", "decode")
+		}
+		*v = Req{
+			ReqIdentifier: frame.ReqIdentifier,
+			Token:         frame.Token,
+			SendID:        frame.SendID,
+			OperationID:   frame.OperationID,
+			MsgIncr:       frame.MsgIncr,
+			Data:          frame.Data,
+		}
+	case *Resp:
+		var frame gateway.Resp
+		if err := proto.Unmarshal(encodeData, &frame); err != nil {
+			return errs.WrapMsg(err, "ProtobufEncoder.Decode failed", "action", "decode")
+		}
+		*v = Resp{
+			ReqIdentifier: frame.ReqIdentifier,
+			MsgIncr:       frame.MsgIncr,
+			OperationID:   frame.OperationID,
+			ErrCode:       int(frame.ErrCode),
+			ErrMsg:        frame.ErrMsg,
+			Data:          frame.Data,
+		}
+	default:
+		return errs.New("ProtobufEncoder.Decode: unsupported type").Wrap()
+	}
+	return nil
+}
+
+func (p *ProtobufEncoder) DecodeWithExternalPool(encodeData *bytes.Buffer, rawData any) error {
+	return p.Decode(encodeData.Bytes(), rawData)
+}
+
+func toProtoFrame(rawData any) (proto.Message, error) {
+	switch v := rawData.(type) {
+	case Req:
+		return toProtoFrame(&v)
+	case *Req:
+		return &gateway.Req{
+			ReqIdentifier: v.ReqIdentifier,
+			Token:         v.Token,
+			SendID:        v.SendID,
+			OperationID:   v.OperationID,
+			MsgIncr:       v.MsgIncr,
+			Data:          v.Data,
+		}, nil
+	case Resp:
+		return toProtoFrame(&v)
+	case *Resp:
+		return &gateway.Resp{
+			ReqIdentifier: v.ReqIdentifier,
+			MsgIncr:       v.MsgIncr,
+			OperationID:   v.OperationID,
+			ErrCode:       int32(v.ErrCode),
+			ErrMsg:        v.ErrMsg,
+			Data:          v.Data,
+		}, nil
+	default:
+		return nil, errs.New("ProtobufEncoder.Encode: unsupported type").Wrap()
+	}
+}
+
+// JsonEncoder encodes the frames as JSON text, the data of the frames is the protojson of the request
+// or response of the reqIdentifier instead of the protobuf-binary, so that the clients without the SDK
+// can talk to the gateway.
+type JsonEncoder struct {
+	marshal   protojson.MarshalOptions
+	unmarshal protojson.UnmarshalOptions
+}
+
+func NewJsonEncoder() *JsonEncoder {
+	return &JsonEncoder{unmarshal: protojson.UnmarshalOptions{DiscardUnknown: true}}
+}
+
+type jsonReq struct {
+	ReqIdentifier int32           `json:"reqIdentifier"`
+	Token         string          `json:"token"`
+	SendID        string          `json:"sendID"`
+	OperationID   string          `json:"operationID"`
+	MsgIncr       string          `json:"msgIncr"`
+	Data          json.RawMessage `json:"data,omitempty"`
+}
+
+type jsonResp struct {
+	ReqIdentifier int32           `json:"reqIdentifier"`
+	MsgIncr       string          `json:"msgIncr"`
+	OperationID   string          `json:"operationID"`
+	ErrCode       int             `json:"errCode"`
+	ErrMsg        string          `json:"errMsg"`
+	Data          json.RawMessage `json:"data,omitempty"`
+}
+
+func (j *JsonEncoder) Encode(rawData any) ([]byte, error) {
+	var frame any
+	switch v := rawData.(type) {
+	case Req:
+		return j.Encode(&v)
+	case *Req:
+		data, err := j.dataToJson(v.ReqIdentifier, false, v.Data)
+		if err != nil {
+			return nil, err
+		}
+		frame = &jsonReq{
+			ReqIdentifier: v.ReqIdentifier,
+			Token:         v.Token,
+			SendID:        v.SendID,
+			OperationID:   v.OperationID,
+			MsgIncr:       v.MsgIncr,
+			Data:          data,
+		}
+	case Resp:
+		return j.Encode(&v)
+	case *Resp:
+		data, err := j.dataToJson(v.ReqIdentifier, true, v.Data)
+		if err != nil {
+			return nil, err
+		}
+		frame = &jsonResp{
+			ReqIdentifier: v.ReqIdentifier,
+			MsgIncr:       v.MsgIncr,
+			OperationID:   v.OperationID,
+			ErrCode:       v.ErrCode,
+			ErrMsg:        v.ErrMsg,
+			Data:          data,
+		}
+	default:
+		return nil, errs.New("JsonEncoder.Encode: unsupported type").Wrap()
+	}
+	data, err := json.Marshal(frame)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "JsonEncoder.Encode failed", "action", "encode")
+	}
+	return data, nil
+}
+
+func (j *JsonEncoder) EncodeWithExternalPool(rawData any, encodeData *bytes.Buffer) error {
+	data, err := j.Encode(rawData)
+	if err != nil {
+		return err
+	}
+	_, err = encodeData.Write(data)
+	return err
+}
+
+func (j *JsonEncoder) Decode(encodeData []byte, rawData any) error {
+	switch v := rawData.(type) {
+	case *Req:
+		var frame jsonReq
+		if err := json.Unmarshal(encodeData, &frame); err != nil {
+			return errs.WrapMsg(err, "JsonEncoder.Decode failed", "action", "decode")
+		}
+		data, err := j.dataFromJson(frame.ReqIdentifier, false, frame.Data)
+		if err != nil {
+			return err
+		}
+		*v = Req{
+			ReqIdentifier: frame.ReqIdentifier,
+			Token:         frame.Token,
+			SendID:        frame.SendID,
+			OperationID:   frame.OperationID,
+			MsgIncr:       frame.MsgIncr,
+			Data:          data,
+		}
+	case *Resp:
+		var frame jsonResp
+		if err := json.Unmarshal(encodeData, &frame); err != nil {
+			return errs.WrapMsg(err, "JsonEncoder.Decode failed", "action", "decode")
+		}
+		data, err := j.dataFromJson(frame.ReqIdentifier, true, frame.Data)
+		if err != nil {
+			return err
+		}
+		*v = Resp{
+			ReqIdentifier: frame.ReqIdentifier,
+			MsgIncr:       frame.MsgIncr,
+			OperationID:   frame.OperationID,
+			ErrCode:       frame.ErrCode,
+			ErrMsg:        frame.ErrMsg,
+			Data:          data,
+		}
+	default:
+		return errs.New("JsonEncoder.Decode: unsupported type").Wrap()
+	}
+	return nil
+}
+
+func (j *JsonEncoder) DecodeWithExternalPool(encodeData *bytes.Buffer, rawData any) error {
+	return j.Decode(encodeData.Bytes(), rawData)
+}
+
+// dataToJson converts the protobuf-binary data of the frame to protojson.
+func (j *JsonEncoder) dataToJson(reqIdentifier int32, isResp bool, data []byte) (json.RawMessage, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	message, err := newFrameDataMessage(reqIdentifier, isResp)
+	if err != nil {
+		return nil, err
+	}
+	if err := proto.Unmarshal(data, message); err != nil {
+		return nil, errs.WrapMsg(err, "JsonEncoder.Encode: error unmarshaling data", "reqIdentifier", reqIdentifier)
+	}
+	res, err := j.marshal.Marshal(message)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "JsonEncoder.Encode: error marshaling data", "reqIdentifier", reqIdentifier)
+	}
+	return res, nil
+}
+
+// dataFromJson converts the protojson data of the frame to protobuf-binary.
+func (j *JsonEncoder) dataFromJson(reqIdentifier int32, isResp bool, data json.RawMessage) ([]byte, error) {
+	if len(data) == 0 || string(data) == "null" {
+		return nil, nil
+	}
+	message, err := newFrameDataMessage(reqIdentifier, isResp)
+	if err != nil {
+		return nil, err
+	}
+	if err := j.unmarshal.Unmarshal(data, message); err != nil {
+		return nil, errs.WrapMsg(err, "JsonEncoder.Decode: error unmarshaling data", "reqIdentifier", reqIdentifier)
+	}
+	res, err := proto.Marshal(message)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "JsonEncoder.Decode: error marshaling data", "reqIdentifier", reqIdentifier)
+	}
+	return res, nil
+}
+
+// frameDataTypes are the messages carried in the data of the frames of each reqIdentifier,
+// nil if the frame carries no data.
+var frameDataTypes = map[int32]struct {
+	req  proto.Message
+	resp proto.Message
+}{
+	WSGetNewestSeq:        {req: &sdkws.GetMaxSeqReq{}, resp: &sdkws.GetMaxSeqResp{}},
+	WSPullMsgBySeqList:    {req: &sdkws.PullMessageBySeqsReq{}, resp: &sdkws.PullMessageBySeqsResp{}},
+	WSSendMsg:             {req: &sdkws.MsgData{}, resp: &msg.SendMsgResp{}},
+	WSSendSignalMsg:       {req: &sdkws.MsgData{}, resp: &msg.SendMsgResp{}},
+	WSPushMsg:             {resp: &sdkws.PushMessages{}},
+	WSKickOnlineMsg:       {},
+	WsLogoutMsg:           {req: &push.DelUserPushTokenReq{}, resp: &push.DelUserPushTokenResp{}},
+	WsSetBackgroundStatus: {req: &sdkws.SetAppBackgroundStatusReq{}},
+	WSResumeSession:       {resp: &gateway.ResumeSessionTips{}},
+	WSReconnectMsg:        {},
+	WSSendEphemeralMsg:    {req: &gateway.EphemeralMsg{}},
+	WSPushEphemeralMsg:    {resp: &gateway.EphemeralMsg{}},
+}
+
+func newFrameDataMessage(reqIdentifier int32, isResp bool) (proto.Message, error) {
+	types, ok := frameDataTypes[reqIdentifier]
+	if !ok {
+		return nil, errs.New("unknown reqIdentifier", "reqIdentifier", reqIdentifier).Wrap()
+	}
+	message := types.req
+	if isResp {
+		message = types.resp
+	}
+	if message == nil {
+		return nil, errs.New("the frame carries no data", "reqIdentifier", reqIdentifier, "isResp", isResp).Wrap()
+	}
+	return message.ProtoReflect().New().Interface(), nil
+}
@@ -2,7 +2,9 @@ package msggateway
 
 import (
 	"bytes"
+	"github.com/openimsdk/protocol/sdkws"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
 	"testing"
 )
 
@@ -78,3 +80,41 @@ func BenchmarkEncodeWithExternalPool(b *testing.B) {
 		encodeBufferPool.Put(buf)
 	}
 }
+
+func TestJsonEncoder_EncodeDecode(t *testing.T) {
+	encoder := NewJsonEncoder()
+	msgData, err := proto.Marshal(&sdkws.MsgData{SendID: "a", RecvID: "b", Content: []byte("hello"), Seq: 10})
+	assert.Nil(t, err)
+	req := Req{ReqIdentifier: WSSendMsg, SendID: "a", OperationID: "op", MsgIncr: "1", Data: msgData}
+	des, err := encoder.Encode(req)
+	assert.Nil(t, err)
+	assert.Contains(t, string(des), `"recvID":"b"`)
+	var decodeReq Req
+	assert.Nil(t, encoder.Decode(des, &decodeReq))
+	var decodeMsg sdkws.MsgData
+	assert.Nil(t, proto.Unmarshal(decodeReq.Data, &decodeMsg))
+	assert.Equal(t, "hello", string(decodeMsg.Content))
+	assert.Equal(t, int64(10), decodeMsg.Seq)
+
+	// the frames without data, e.g. the kick frame
+	des, err = encoder.Encode(Resp{ReqIdentifier: WSKickOnlineMsg})
+	assert.Nil(t, err)
+	var decodeResp Resp
+	assert.Nil(t, encoder.Decode(des, &decodeResp))
+	assert.Equal(t, int32(WSKickOnlineMsg), decodeResp.ReqIdentifier)
+
+	// unknown reqIdentifier with data
+	_, err = encoder.Encode(Resp{ReqIdentifier: 9999, Data: msgData})
+	assert.NotNil(t, err)
+}
+
+func TestProtobufEncoder_EncodeDecode(t *testing.T) {
+	encoder := NewProtobufEncoder()
+	src := Resp{ReqIdentifier: WSPushMsg, OperationID: "op", ErrCode: 1, ErrMsg: "err", Data: []byte{1, 2, 3}}
+	buf := encodeBufferPool.Get()
+	defer encodeBufferPool.Put(buf)
+	assert.Nil(t, encoder.EncodeWithExternalPool(src, buf))
+	var dest Resp
+	assert.Nil(t, encoder.DecodeWithExternalPool(buf, &dest))
+	assert.EqualValues(t, src, dest)
+}
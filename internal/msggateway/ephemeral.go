@@ -0,0 +1,200 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msggateway
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/redispubsub"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/gateway"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/utils/datautil"
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// ephemeralChannel is the redis channel routing the ephemeral msgs between the nodes.
+	ephemeralChannel = "openim:msggateway:ephemeral"
+	// maxEphemeralGroupMembers is the max number of the members of a group sending ephemeral msgs.
+	maxEphemeralGroupMembers = 1000
+)
+
+// SubscribeEphemeralMsgs subscribes the ephemeral msgs published by the other nodes.
+func (ws *WsServer) SubscribeEphemeralMsgs(ctx context.Context, rdb redis.UniversalClient) error {
+	ws.rdb = rdb
+	ws.ephemeralPublisher = redispubsub.NewPublisher(rdb, ephemeralChannel)
+	return redispubsub.NewSubscriber(rdb, ephemeralChannel).OnMessage(ctx, func(payload string) {
+		ws.onEphemeralMsg(ctx, payload)
+	})
+}
+
+// onEphemeralMsg pushes the ephemeral msg published by another node, the msgs published by the node are pushed when sent.
+func (ws *WsServer) onEphemeralMsg(ctx context.Context, payload string) {
+	var envelope gateway.EphemeralEnvelope
+	if err := proto.Unmarshal([]byte(payload), &envelope); err != nil {
+		log.ZWarn(ctx, "unmarshal ephemeral msg failed", err)
+		return
+	}
+	if envelope.NodeID == ws.nodeID || envelope.Msg == nil {
+		return
+	}
+	ctx = mcontext.SetOperationID(ctx, fmt.Sprintf("ephemeral_%d_%d", os.Getpid(), time.Now().UnixMilli()))
+	ws.pushEphemeralMsg(ctx, envelope.RecvIDs, envelope.Msg)
+}
+
+func (ws *WsServer) SendEphemeralMsg(ctx context.Context, client *Client, req *Req) ([]byte, error) {
+	var msg gateway.EphemeralMsg
+	if err := proto.Unmarshal(req.Data, &msg); err != nil {
+		return nil, errs.WrapMsg(err, "SendEphemeralMsg: error unmarshaling request", "action", "unmarshal", "dataType", "EphemeralMsg")
+	}
+	if err := msg.Check(); err != nil {
+		return nil, errs.ErrArgs.WrapMsg(err.Error())
+	}
+	msg.SendID = client.UserID
+	msg.SendTime = time.Now().UnixMilli()
+	if err := ws.checkEphemeralRateLimit(ctx, msg.SendID); err != nil {
+		return nil, err
+	}
+	recvIDs, err := ws.ephemeralRecvIDs(ctx, &msg)
+	if err != nil {
+		return nil, err
+	}
+	if len(recvIDs) == 0 {
+		return nil, nil
+	}
+	ws.pushEphemeralMsg(ctx, recvIDs, &msg)
+	data, err := proto.Marshal(&gateway.EphemeralEnvelope{NodeID: ws.nodeID, RecvIDs: recvIDs, Msg: &msg})
+	if err != nil {
+		return nil, errs.WrapMsg(err, "SendEphemeralMsg: error marshaling envelope", "action", "marshal", "dataType", "EphemeralEnvelope")
+	}
+	if err := ws.ephemeralPublisher.Publish(string(data)); err != nil {
+		return nil, errs.WrapMsg(err, "publish ephemeral msg failed")
+	}
+	return nil, nil
+}
+
+// checkEphemeralRateLimit limits how fast userID sends ephemeral msgs, the app managers are not limited.
+func (ws *WsServer) checkEphemeralRateLimit(ctx context.Context, userID string) error {
+	bucket := ws.msgGatewayConfig.MsgGateway.EphemeralMsg.RateLimit
+	if bucket.Rate <= 0 || ws.msgCache == nil || datautil.Contain(userID, ws.msgGatewayConfig.Share.IMAdminUserID...) {
+		return nil
+	}
+	ok, err := ws.msgCache.TakeEphemeralMsgToken(ctx, userID, bucket.Rate, max(bucket.Burst, 1))
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return servererrs.ErrMsgRateLimited.WrapMsg("send ephemeral msg too frequently", "sendID", userID)
+	}
+	return nil
+}
+
+// ephemeralRecvIDs returns the peer of a single chat, or the other members of a group.
+// The peer of a single chat is checked against the blacklist and friendVerify as the msgs.
+func (ws *WsServer) ephemeralRecvIDs(ctx context.Context, msg *gateway.EphemeralMsg) ([]string, error) {
+	if msg.SessionType == constant.SingleChatType {
+		if msg.RecvID == msg.SendID {
+			return nil, nil
+		}
+		if datautil.Contain(msg.SendID, ws.msgGatewayConfig.Share.IMAdminUserID...) {
+			return []string{msg.RecvID}, nil
+		}
+		black, err := ws.friendCache.IsBlack(ctx, msg.SendID, msg.RecvID)
+		if err != nil {
+			return nil, err
+		}
+		if black {
+			return nil, servererrs.ErrBlockedByPeer.Wrap()
+		}
+		if ws.msgGatewayConfig.MsgConfig.FriendVerify {
+			friend, err := ws.friendCache.IsFriend(ctx, msg.SendID, msg.RecvID)
+			if err != nil {
+				return nil, err
+			}
+			if !friend {
+				return nil, servererrs.ErrNotPeersFriend.Wrap()
+			}
+		}
+		return []string{msg.RecvID}, nil
+	}
+	memberIDs, err := ws.groupCache.GetGroupMemberIDs(ctx, msg.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	if len(memberIDs) > maxEphemeralGroupMembers {
+		return nil, errs.ErrArgs.WrapMsg("too many members of the group for ephemeral msgs", "max", maxEphemeralGroupMembers)
+	}
+	recvIDs := make([]string, 0, len(memberIDs))
+	var isMember bool
+	for _, memberID := range memberIDs {
+		if memberID == msg.SendID {
+			isMember = true
+			continue
+		}
+		recvIDs = append(recvIDs, memberID)
+	}
+	if !isMember {
+		return nil, servererrs.ErrNotInGroupYet.WrapMsg("user not in group", "groupID", msg.GroupID, "userID", msg.SendID)
+	}
+	return recvIDs, nil
+}
+
+// pushEphemeralMsg writes the msg to the foreground connections of the receivers on the node,
+// it is not buffered for the resume sessions and is lost if the write fails.
+func (ws *WsServer) pushEphemeralMsg(ctx context.Context, recvIDs []string, msg *gateway.EphemeralMsg) {
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		log.ZWarn(ctx, "marshal ephemeral msg failed", err)
+		return
+	}
+	frames := newPushFrames(Resp{
+		ReqIdentifier: WSPushEphemeralMsg,
+		OperationID:   mcontext.GetOperationID(ctx),
+		Data:          data,
+	})
+	for _, recvID := range recvIDs {
+		clients, ok := ws.clients.GetAll(recvID)
+		if !ok {
+			continue
+		}
+		for _, client := range clients {
+			if client == nil || client.IsBackground {
+				continue
+			}
+			client := client
+			ws.pushWorkers.submit(func() {
+				if client.closed.Load() {
+					// the connection has been closed since the push was submitted
+					return
+				}
+				frame, err := frames.frame(client)
+				if err == nil {
+					err = client.sendFrame(outFrame{data: frame})
+				}
+				if err != nil {
+					log.ZDebug(ctx, "push ephemeral msg failed", "userID", client.UserID, "platformID", client.PlatformID, "err", err)
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package msggateway
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redismock/v9"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/redispubsub"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/gateway"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+func newEphemeralWsServer(nodeID string) *WsServer {
+	return &WsServer{
+		nodeID:           nodeID,
+		clients:          newUserMap(8),
+		pushWorkers:      newPushWorkers(2),
+		msgGatewayConfig: &Config{Share: config.Share{IMAdminUserID: []string{"admin"}}},
+	}
+}
+
+func addEphemeralClient(ws *WsServer, userID string, platformID int, background bool) *recordConn {
+	conn := &recordConn{}
+	c := &Client{
+		w:            new(sync.Mutex),
+		conn:         conn,
+		UserID:       userID,
+		PlatformID:   platformID,
+		IsBackground: background,
+		Encoding:     JsonEncodingProtocol,
+		ctx:          newTempContext(),
+	}
+	c.encoder, _ = getEncoder(JsonEncodingProtocol)
+	ws.clients.Set(userID, c)
+	return conn
+}
+
+func (c *recordConn) frameNum() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.frames)
+}
+
+func decodeEphemeralPush(t *testing.T, frame []byte) *gateway.EphemeralMsg {
+	t.Helper()
+	var resp jsonResp
+	require.NoError(t, json.Unmarshal(frame, &resp))
+	require.Equal(t, int32(WSPushEphemeralMsg), resp.ReqIdentifier)
+	var msg gateway.EphemeralMsg
+	require.NoError(t, protojson.Unmarshal(resp.Data, &msg))
+	return &msg
+}
+
+func TestEphemeralRecvIDsSingleChat(t *testing.T) {
+	ws := newEphemeralWsServer("node1")
+	ctx := context.Background()
+
+	// no one is notified of typing to oneself
+	recvIDs, err := ws.ephemeralRecvIDs(ctx, &gateway.EphemeralMsg{SessionType: constant.SingleChatType, SendID: "u1", RecvID: "u1"})
+	require.NoError(t, err)
+	assert.Empty(t, recvIDs)
+
+	// the app managers skip the blacklist and friend checks
+	recvIDs, err = ws.ephemeralRecvIDs(ctx, &gateway.EphemeralMsg{SessionType: constant.SingleChatType, SendID: "admin", RecvID: "u2"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"u2"}, recvIDs)
+}
+
+func TestPushEphemeralMsgForegroundOnly(t *testing.T) {
+	ws := newEphemeralWsServer("node1")
+	foreground := addEphemeralClient(ws, "u2", constant.AndroidPlatformID, false)
+	background := addEphemeralClient(ws, "u2", constant.IOSPlatformID, true)
+	other := addEphemeralClient(ws, "u3", constant.AndroidPlatformID, false)
+
+	msg := &gateway.EphemeralMsg{SessionType: constant.SingleChatType, SendID: "u1", RecvID: "u2", Type: gateway.EphemeralTyping}
+	ws.pushEphemeralMsg(context.Background(), []string{"u2", "u4"}, msg)
+
+	require.Eventually(t, func() bool { return foreground.frameNum() == 1 }, time.Second, 5*time.Millisecond)
+	assert.True(t, proto.Equal(msg, decodeEphemeralPush(t, foreground.frames[0])))
+	time.Sleep(20 * time.Millisecond)
+	assert.Zero(t, background.frameNum())
+	assert.Zero(t, other.frameNum())
+}
+
+func TestSendEphemeralMsgPublishes(t *testing.T) {
+	rdb, mock := redismock.NewClientMock()
+	ws := newEphemeralWsServer("node1")
+	ws.ephemeralPublisher = redispubsub.NewPublisher(rdb, ephemeralChannel)
+	recv := addEphemeralClient(ws, "u2", constant.AndroidPlatformID, false)
+
+	data, err := proto.Marshal(&gateway.EphemeralMsg{SessionType: constant.SingleChatType, RecvID: "u2", Type: gateway.EphemeralTyping})
+	require.NoError(t, err)
+	var published gateway.EphemeralEnvelope
+	mock.CustomMatch(func(_, actual []any) error {
+		if len(actual) != 3 || actual[0] != "publish" || actual[1] != ephemeralChannel {
+			return errors.New("not a publish to the ephemeral channel")
+		}
+		return proto.Unmarshal([]byte(actual[2].(string)), &published)
+	}).ExpectPublish(ephemeralChannel, "").SetVal(1)
+
+	sender := &Client{UserID: "admin", ctx: newTempContext()}
+	_, err = ws.SendEphemeralMsg(context.Background(), sender, &Req{Data: data})
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	// the other nodes push the msg to the receivers connected to them
+	assert.Equal(t, "node1", published.NodeID)
+	assert.Equal(t, []string{"u2"}, published.RecvIDs)
+	assert.Equal(t, "admin", published.Msg.GetSendID())
+	assert.NotZero(t, published.Msg.GetSendTime())
+
+	// and the node pushes it to the receivers connected to it
+	require.Eventually(t, func() bool { return recv.frameNum() == 1 }, time.Second, 5*time.Millisecond)
+	assert.True(t, proto.Equal(published.Msg, decodeEphemeralPush(t, recv.frames[0])))
+}
+
+func TestOnEphemeralMsg(t *testing.T) {
+	ws := newEphemeralWsServer("node2")
+	recv := addEphemeralClient(ws, "u2", constant.AndroidPlatformID, false)
+	msg := &gateway.EphemeralMsg{SessionType: constant.SingleChatType, SendID: "u1", RecvID: "u2", Type: gateway.EphemeralTyping}
+	publish := func(nodeID string) {
+		data, err := proto.Marshal(&gateway.EphemeralEnvelope{NodeID: nodeID, RecvIDs: []string{"u2"}, Msg: msg})
+		require.NoError(t, err)
+		ws.onEphemeralMsg(context.Background(), string(data))
+	}
+
+	// the msgs published by the node were pushed when sent
+	publish("node2")
+	ws.onEphemeralMsg(context.Background(), "invalid")
+	time.Sleep(20 * time.Millisecond)
+	assert.Zero(t, recv.frameNum())
+
+	publish("node1")
+	require.Eventually(t, func() bool { return recv.frameNum() == 1 }, time.Second, 5*time.Millisecond)
+	assert.True(t, proto.Equal(msg, decodeEphemeralPush(t, recv.frames[0])))
+}
@@ -17,8 +17,8 @@ package msggateway
 import (
 	"context"
 	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
-	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/startrpc"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/gateway"
 	"github.com/openimsdk/protocol/constant"
 	"github.com/openimsdk/protocol/msggateway"
 	"github.com/openimsdk/tools/discovery"
@@ -31,17 +31,19 @@ import (
 func (s *Server) InitServer(ctx context.Context, config *Config, disCov discovery.SvcDiscoveryRegistry, server *grpc.Server) error {
 	s.LongConnServer.SetDiscoveryRegistry(disCov, config)
 	msggateway.RegisterMsgGatewayServer(server, s)
+	gateway.RegisterGatewayAdminServer(server, s)
 	return nil
 }
 
 func (s *Server) Start(ctx context.Context, index int, conf *Config) error {
-	return startrpc.Start(ctx, &conf.Discovery, &conf.MsgGateway.Prometheus, conf.MsgGateway.ListenIP,
+	return startrpc.StartWithBeforeStop(ctx, &conf.Discovery, &conf.MsgGateway.Prometheus, conf.MsgGateway.ListenIP,
 		conf.MsgGateway.RPC.RegisterIP,
 		conf.MsgGateway.RPC.Ports, index,
 		conf.Share.RpcRegisterName.MessageGateway,
 		&conf.Share,
 		conf,
 		s.InitServer,
+		s.drainBeforeStop,
 	)
 }
 
@@ -50,6 +52,7 @@ type Server struct {
 	LongConnServer LongConnServer
 	config         *Config
 	pushTerminal   map[int]struct{}
+	pushWorkers    *pushWorkers
 }
 
 func (s *Server) SetLongConnServer(LongConnServer LongConnServer) {
@@ -63,6 +66,11 @@ func NewServer(rpcPort int, longConnServer LongConnServer, conf *Config) *Server
 		pushTerminal:   make(map[int]struct{}),
 		config:         conf,
 	}
+	workers := conf.MsgGateway.LongConnSvr.PushWorkers
+	if workers <= 0 {
+		workers = defaultPushWorkers
+	}
+	s.pushWorkers = newPushWorkers(workers)
 	s.pushTerminal[constant.IOSPlatformID] = struct{}{}
 	s.pushTerminal[constant.AndroidPlatformID] = struct{}{}
 	return s
@@ -112,60 +120,10 @@ func (s *Server) GetUsersOnlineStatus(
 	return &resp, nil
 }
 
-func (s *Server) OnlineBatchPushOneMsg(ctx context.Context, req *msggateway.OnlineBatchPushOneMsgReq) (*msggateway.OnlineBatchPushOneMsgResp, error) {
-	// todo implement
-	return nil, nil
-}
-
+// SuperGroupOnlineBatchPushOneMsg is kept for the push nodes calling it, it is the same as OnlineBatchPushOneMsg.
 func (s *Server) SuperGroupOnlineBatchPushOneMsg(ctx context.Context, req *msggateway.OnlineBatchPushOneMsgReq,
 ) (*msggateway.OnlineBatchPushOneMsgResp, error) {
-	var singleUserResults []*msggateway.SingleMsgToUserResults
-	for _, v := range req.PushToUserIDs {
-		var resp []*msggateway.SingleMsgToUserPlatform
-		results := &msggateway.SingleMsgToUserResults{
-			UserID: v,
-		}
-		clients, ok := s.LongConnServer.GetUserAllCons(v)
-		if !ok {
-			log.ZDebug(ctx, "push user not online", "userID", v)
-			results.Resp = resp
-			singleUserResults = append(singleUserResults, results)
-			continue
-		}
-
-		log.ZDebug(ctx, "push user online", "clients", clients, "userID", v)
-		for _, client := range clients {
-			if client == nil {
-				continue
-			}
-
-			userPlatform := &msggateway.SingleMsgToUserPlatform{
-				RecvPlatFormID: int32(client.PlatformID),
-			}
-			if !client.IsBackground ||
-				(client.IsBackground && client.PlatformID != constant.IOSPlatformID) {
-				err := client.PushMessage(ctx, req.MsgData)
-				if err != nil {
-					userPlatform.ResultCode = int64(servererrs.ErrPushMsgErr.Code())
-					resp = append(resp, userPlatform)
-				} else {
-					if _, ok := s.pushTerminal[client.PlatformID]; ok {
-						results.OnlinePush = true
-						resp = append(resp, userPlatform)
-					}
-				}
-			} else {
-				userPlatform.ResultCode = int64(servererrs.ErrIOSBackgroundPushErr.Code())
-				resp = append(resp, userPlatform)
-			}
-		}
-		results.Resp = resp
-		singleUserResults = append(singleUserResults, results)
-	}
-
-	return &msggateway.OnlineBatchPushOneMsgResp{
-		SinglePushResult: singleUserResults,
-	}, nil
+	return s.OnlineBatchPushOneMsg(ctx, req)
 }
 
 func (s *Server) KickUserOffline(
@@ -192,6 +150,12 @@ func (s *Server) KickUserOffline(
 }
 
 func (s *Server) MultiTerminalLoginCheck(ctx context.Context, req *msggateway.MultiTerminalLoginCheckReq) (*msggateway.MultiTerminalLoginCheckResp, error) {
+	// the user is online on another node, the dropped connection must not set it offline on expiry
+	s.LongConnServer.DropDetachedSessions(req.UserID, int(req.PlatformID))
+	if s.LongConnServer.GetLoginRules().GetEnable() {
+		// the login rules are enforced by the node the user connected to, which kicks the connections on this node
+		return &msggateway.MultiTerminalLoginCheckResp{}, nil
+	}
 	if oldClients, userOK, clientOK := s.LongConnServer.GetUserPlatformCons(req.UserID, int(req.PlatformID)); userOK {
 		tempUserCtx := newTempContext()
 		tempUserCtx.SetToken(req.Token)
@@ -17,6 +17,8 @@ package msggateway
 import (
 	"context"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/redis"
+	"github.com/openimsdk/tools/db/redisutil"
 	"github.com/openimsdk/tools/utils/datautil"
 	"time"
 
@@ -24,10 +26,13 @@ import (
 )
 
 type Config struct {
-	MsgGateway     config.MsgGateway
-	Share          config.Share
-	WebhooksConfig config.Webhooks
-	Discovery      config.Discovery
+	MsgGateway       config.MsgGateway
+	MsgConfig        config.Msg
+	Share            config.Share
+	RedisConfig      config.Redis
+	WebhooksConfig   config.Webhooks
+	LocalCacheConfig config.LocalCache
+	Discovery        config.Discovery
 }
 
 // Start run ws server.
@@ -42,18 +47,50 @@ func Start(ctx context.Context, index int, conf *Config) error {
 	if err != nil {
 		return err
 	}
-	longServer, err := NewWsServer(
-		conf,
+	opts := []Option{
 		WithPort(wsPort),
 		WithMaxConnNum(int64(conf.MsgGateway.LongConnSvr.WebsocketMaxConnNum)),
-		WithHandshakeTimeout(time.Duration(conf.MsgGateway.LongConnSvr.WebsocketTimeout)*time.Second),
+		WithHandshakeTimeout(time.Duration(conf.MsgGateway.LongConnSvr.WebsocketTimeout) * time.Second),
 		WithMessageMaxMsgLength(conf.MsgGateway.LongConnSvr.WebsocketMaxMsgLen),
-	)
+		WithDrain(time.Duration(conf.MsgGateway.LongConnSvr.Drain.Timeout)*time.Second, conf.MsgGateway.LongConnSvr.Drain.BatchSize,
+			time.Duration(conf.MsgGateway.LongConnSvr.Drain.BatchInterval)*time.Millisecond),
+	}
+	if deflate := conf.MsgGateway.LongConnSvr.Compression.PermessageDeflate; deflate.Enable {
+		opts = append(opts, WithPermessageDeflate(deflate.Level, deflate.Threshold))
+	}
+	if resume := conf.MsgGateway.LongConnSvr.Resume; resume.Enable && resume.Window > 0 && resume.BufferSize > 0 {
+		opts = append(opts, WithResume(time.Duration(resume.Window)*time.Second, resume.BufferSize))
+	}
+	if keepAlive := conf.MsgGateway.LongConnSvr.KeepAlive; keepAlive.Enable && keepAlive.PingInterval > 0 && keepAlive.MissedPongs > 0 {
+		opts = append(opts, WithKeepAlive(time.Duration(keepAlive.PingInterval)*time.Second, keepAlive.MissedPongs))
+	}
+	if outbound := conf.MsgGateway.LongConnSvr.OutboundQueue; outbound.Size > 0 {
+		opts = append(opts, WithOutboundQueue(outbound.Size, outbound.OverflowPolicy))
+	}
+	longServer, err := NewWsServer(conf, opts...)
+	if err != nil {
+		return err
+	}
+	rdb, err := redisutil.NewRedisClient(ctx, conf.RedisConfig.Build())
 	if err != nil {
 		return err
 	}
+	// the gateway only marks the tokens, their expiry is set by the auth rpc
+	longServer.tokenCache = redis.NewTokenCacheModel(rdb, 0)
+	longServer.msgCache = redis.NewMsgCache(rdb)
 
 	hubServer := NewServer(rpcPort, longServer, conf)
+	// the ephemeral msgs are written by the workers of the online pushes
+	longServer.pushWorkers = hubServer.pushWorkers
+	if err := longServer.SubscribeEphemeralMsgs(ctx, rdb); err != nil {
+		return err
+	}
+	if err := longServer.SubscribeResumeTakeovers(ctx, rdb); err != nil {
+		return err
+	}
+	if err := longServer.WatchLoginRules(ctx, rdb, redis.NewLoginRuleCache(rdb)); err != nil {
+		return err
+	}
 	netDone := make(chan error)
 	go func() {
 		err = hubServer.Start(ctx, index, conf)
@@ -0,0 +1,338 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msggateway
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/redispubsub"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/gateway"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/redis/go-redis/v9"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// loginRulesChannel is the redis channel telling the nodes to reload the login rules.
+	loginRulesChannel = "openim:msggateway:login_rules"
+	// loginRulesReloadInterval reloads the login rules in case a change is missed by the subscriber.
+	loginRulesReloadInterval = time.Minute
+)
+
+func (s *Server) GetUserConns(ctx context.Context, req *gateway.GetUserConnsReq) (*gateway.GetUserConnsResp, error) {
+	if !authverify.IsAppManagerUid(ctx, s.config.Share.IMAdminUserID) {
+		return nil, errs.ErrNoPermission.WrapMsg("only app manager")
+	}
+	return &gateway.GetUserConnsResp{Conns: s.LongConnServer.GetUserConnInfos(req.UserID)}, nil
+}
+
+func (s *Server) GetLoginRules(ctx context.Context, _ *gateway.GetLoginRulesReq) (*gateway.GetLoginRulesResp, error) {
+	if !authverify.IsAppManagerUid(ctx, s.config.Share.IMAdminUserID) {
+		return nil, errs.ErrNoPermission.WrapMsg("only app manager")
+	}
+	return &gateway.GetLoginRulesResp{LoginRules: s.LongConnServer.GetLoginRules()}, nil
+}
+
+func (s *Server) SetLoginRules(ctx context.Context, req *gateway.SetLoginRulesReq) (*gateway.SetLoginRulesResp, error) {
+	if !authverify.IsAppManagerUid(ctx, s.config.Share.IMAdminUserID) {
+		return nil, errs.ErrNoPermission.WrapMsg("only app manager")
+	}
+	if err := req.Check(); err != nil {
+		return nil, errs.ErrArgs.WrapMsg(err.Error())
+	}
+	if err := s.LongConnServer.SetLoginRules(ctx, req.LoginRules); err != nil {
+		return nil, err
+	}
+	log.ZInfo(ctx, "login rules changed", "loginRules", req.LoginRules)
+	return &gateway.SetLoginRulesResp{}, nil
+}
+
+// newLoginRules returns the login rules of the config.
+func newLoginRules(conf *Config) (*gateway.LoginRules, error) {
+	multiLoginRules := conf.MsgGateway.MultiLoginRules
+	rules := &gateway.LoginRules{Enable: multiLoginRules.Enable}
+	for _, rule := range multiLoginRules.Rules {
+		rules.Rules = append(rules.Rules, &gateway.LoginRule{
+			PlatformClass: rule.PlatformClass,
+			MaxConns:      int32(rule.MaxConns),
+			OnExceed:      rule.OnExceed,
+		})
+	}
+	if err := rules.Check(); err != nil {
+		return nil, errs.WrapMsg(err, "invalid multiLoginRules")
+	}
+	return rules, nil
+}
+
+func (ws *WsServer) GetLoginRules() *gateway.LoginRules {
+	return proto.Clone(ws.loginRules.Load()).(*gateway.LoginRules)
+}
+
+// SetLoginRules saves the login rules in redis and tells the other nodes to reload them.
+func (ws *WsServer) SetLoginRules(ctx context.Context, rules *gateway.LoginRules) error {
+	if ws.loginRuleCache == nil {
+		return errs.ErrInternalServer.WrapMsg("login rules are not watched")
+	}
+	data, err := protojson.Marshal(rules)
+	if err != nil {
+		return errs.WrapMsg(err, "marshal login rules failed")
+	}
+	if err := ws.loginRuleCache.SetLoginRules(ctx, string(data)); err != nil {
+		return err
+	}
+	ws.loginRules.Store(proto.Clone(rules).(*gateway.LoginRules))
+	if err := ws.loginRulesPublisher.Publish(loginRulesChannel); err != nil {
+		log.ZWarn(ctx, "publish login rules changed failed, the other nodes reload them later", err)
+	}
+	return nil
+}
+
+// WatchLoginRules loads the login rules saved in redis, which replace the rules of the config,
+// and reloads them when they are changed by any node.
+func (ws *WsServer) WatchLoginRules(ctx context.Context, rdb redis.UniversalClient, loginRuleCache cache.LoginRuleCache) error {
+	ws.loginRuleCache = loginRuleCache
+	ws.loginRulesPublisher = redispubsub.NewPublisher(rdb, loginRulesChannel)
+	if err := ws.loadLoginRules(ctx); err != nil {
+		return err
+	}
+	if err := redispubsub.NewSubscriber(rdb, loginRulesChannel).OnMessage(ctx, func(string) {
+		ctx := mcontext.SetOperationID(ctx, fmt.Sprintf("login_rules_%d_%d", os.Getpid(), time.Now().UnixMilli()))
+		if err := ws.loadLoginRules(ctx); err != nil {
+			log.ZError(ctx, "load login rules failed", err)
+		}
+	}); err != nil {
+		return err
+	}
+	go func() {
+		ticker := time.NewTicker(loginRulesReloadInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case now := <-ticker.C:
+				ctx := mcontext.SetOperationID(ctx, fmt.Sprintf("login_rules_%d_%d", os.Getpid(), now.UnixMilli()))
+				if err := ws.loadLoginRules(ctx); err != nil {
+					log.ZError(ctx, "load login rules failed", err)
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+// loadLoginRules loads the login rules saved in redis, the rules of the config are kept if none are saved.
+func (ws *WsServer) loadLoginRules(ctx context.Context) error {
+	data, err := ws.loginRuleCache.GetLoginRules(ctx)
+	if err != nil {
+		return err
+	}
+	if data == "" {
+		return nil
+	}
+	var rules gateway.LoginRules
+	if err := protojson.Unmarshal([]byte(data), &rules); err != nil {
+		return errs.WrapMsg(err, "unmarshal login rules failed")
+	}
+	if err := rules.Check(); err != nil {
+		return errs.WrapMsg(err, "invalid login rules")
+	}
+	if !proto.Equal(&rules, ws.loginRules.Load()) {
+		ws.loginRules.Store(&rules)
+		log.ZInfo(ctx, "login rules loaded", "loginRules", &rules)
+	}
+	return nil
+}
+
+func (ws *WsServer) GetUserConnInfos(userID string) []*gateway.ConnInfo {
+	clients, _ := ws.clients.GetAll(userID)
+	conns := make([]*gateway.ConnInfo, 0, len(clients))
+	for _, client := range clients {
+		if client.closed.Load() {
+			continue
+		}
+		conns = append(conns, client.connInfo())
+	}
+	return conns
+}
+
+// platformClass returns the class of the platform in the login rules,
+// it is the platform name for the platforms without a class.
+func platformClass(platformID int) string {
+	if class := constant.PlatformIDToClass(platformID); class != "" {
+		return class
+	}
+	return constant.PlatformIDToName(platformID)
+}
+
+// loginRule returns the rule limiting the connections of the platform, nil if unlimited.
+func (ws *WsServer) loginRule(platformID int) *gateway.LoginRule {
+	rules := ws.loginRules.Load()
+	if !rules.GetEnable() {
+		return nil
+	}
+	class := platformClass(platformID)
+	for _, rule := range rules.Rules {
+		if rule.PlatformClass == class && rule.MaxConns > 0 {
+			return rule
+		}
+	}
+	return nil
+}
+
+// getUserClassConns returns the connections of userID of the platform class on all the nodes,
+// and the nodes of the ones on the other nodes by connID.
+func (ws *WsServer) getUserClassConns(ctx context.Context, userID string, class string) ([]*gateway.ConnInfo, map[string]*grpc.ClientConn) {
+	conns := ws.GetUserConnInfos(userID)
+	nodes := make(map[string]*grpc.ClientConn)
+	if ws.msgGatewayConfig.Discovery.Enable != "k8s" {
+		rpcConns, err := ws.disCov.GetConns(ctx, ws.msgGatewayConfig.Share.RpcRegisterName.MessageGateway)
+		if err != nil {
+			log.ZWarn(ctx, "get msg gateway conns failed", err)
+		}
+		ctx = mcontext.SetOpUserID(ctx, ws.msgGatewayConfig.Share.IMAdminUserID[0])
+		var mu sync.Mutex
+		wg := errgroup.Group{}
+		wg.SetLimit(concurrentRequest)
+		for _, v := range rpcConns {
+			v := v
+			if v.Target() == ws.disCov.GetSelfConnTarget() {
+				continue
+			}
+			wg.Go(func() error {
+				resp, err := gateway.NewGatewayAdminClient(v).GetUserConns(ctx, &gateway.GetUserConnsReq{UserID: userID})
+				if err != nil {
+					log.ZWarn(ctx, "GetUserConns err", err, "node", v.Target())
+					return nil
+				}
+				mu.Lock()
+				conns = append(conns, resp.Conns...)
+				for _, conn := range resp.Conns {
+					nodes[conn.ConnID] = v
+				}
+				mu.Unlock()
+				return nil
+			})
+		}
+		_ = wg.Wait()
+	}
+	classConns := make([]*gateway.ConnInfo, 0, len(conns))
+	for _, conn := range conns {
+		if platformClass(int(conn.PlatformID)) == class {
+			classConns = append(classConns, conn)
+		}
+	}
+	return classConns, nodes
+}
+
+// loginRuleVictims returns the connections exceeding the rule, the oldest ones for kickOldest
+// and the newest ones for rejectNewest.
+func loginRuleVictims(rule *gateway.LoginRule, conns []*gateway.ConnInfo) []*gateway.ConnInfo {
+	excess := len(conns) - int(rule.MaxConns)
+	if excess <= 0 {
+		return nil
+	}
+	sort.Slice(conns, func(i, j int) bool {
+		if conns[i].ConnectTime == conns[j].ConnectTime {
+			return conns[i].ConnID < conns[j].ConnID
+		}
+		return conns[i].ConnectTime < conns[j].ConnectTime
+	})
+	if rule.OnExceed == gateway.RejectNewest {
+		return conns[len(conns)-excess:]
+	}
+	return conns[:excess]
+}
+
+// enforceLoginRule kicks the connections of userID exceeding the rule of the platform on all the nodes.
+// It is enforced only by the node the user connected to, which kicks the ones on the other nodes by KickConn.
+func (ws *WsServer) enforceLoginRule(ctx context.Context, userID string, platformID int) {
+	rule := ws.loginRule(platformID)
+	if rule == nil {
+		return
+	}
+	conns, nodes := ws.getUserClassConns(ctx, userID, rule.PlatformClass)
+	victims := loginRuleVictims(rule, conns)
+	if len(victims) == 0 {
+		return
+	}
+	adminCtx := ctx
+	if len(ws.msgGatewayConfig.Share.IMAdminUserID) > 0 {
+		adminCtx = mcontext.SetOpUserID(ctx, ws.msgGatewayConfig.Share.IMAdminUserID[0])
+	}
+	for _, victim := range victims {
+		log.ZInfo(ctx, "kick conn by login rule", "userID", userID, "platformID", victim.PlatformID,
+			"connID", victim.ConnID, "rule", rule)
+		node, ok := nodes[victim.ConnID]
+		if !ok {
+			ws.KickLoginRuleConn(ctx, userID, victim.ConnID)
+			continue
+		}
+		req := &gateway.KickConnReq{UserID: userID, ConnID: victim.ConnID, ByLoginRule: true}
+		if _, err := gateway.NewGatewayAdminClient(node).KickConn(adminCtx, req); err != nil {
+			log.ZWarn(ctx, "KickConn err", err, "node", node.Target(), "connID", victim.ConnID)
+		}
+	}
+}
+
+// KickLoginRuleConn logs out the connection connID of userID exceeding the login rules and invalidates its token,
+// it returns false if it is not on the node.
+func (ws *WsServer) KickLoginRuleConn(ctx context.Context, userID string, connID string) bool {
+	clients, _ := ws.clients.GetAll(userID)
+	for _, client := range clients {
+		if client.ctx.GetConnID() != connID {
+			continue
+		}
+		if err := ws.KickUserConn(client); err != nil {
+			log.ZWarn(ctx, "KickOnlineMessage", err)
+		}
+		ws.invalidateConnToken(ctx, client)
+		return true
+	}
+	return false
+}
+
+// invalidateConnToken marks the token of the kicked connection, so the client can not connect with it again.
+// The other tokens of the platform are kept, they may belong to the connections within the rule.
+func (ws *WsServer) invalidateConnToken(ctx context.Context, client *Client) {
+	if ws.tokenCache == nil {
+		return
+	}
+	tokens, err := ws.tokenCache.GetTokensWithoutError(ctx, client.UserID, client.PlatformID)
+	if err != nil {
+		log.ZWarn(ctx, "GetTokensWithoutError failed", err, "userID", client.UserID, "platformID", client.PlatformID)
+		return
+	}
+	// the token has expired if it is not in the map, setting it would create the map without its expiry
+	if _, ok := tokens[client.token]; !ok {
+		return
+	}
+	if err := ws.tokenCache.SetTokenFlag(ctx, client.UserID, client.PlatformID, client.token, constant.KickedToken); err != nil {
+		log.ZWarn(ctx, "SetTokenFlag failed", err, "userID", client.UserID, "platformID", client.PlatformID)
+	}
+}
@@ -0,0 +1,215 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msggateway
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+
+	"github.com/go-redis/redismock/v9"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/redispubsub"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/gateway"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/msggateway"
+	"github.com/openimsdk/tools/discovery"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+)
+
+type memLoginRuleCache struct {
+	rules string
+}
+
+func (c *memLoginRuleCache) GetLoginRules(context.Context) (string, error) {
+	return c.rules, nil
+}
+
+func (c *memLoginRuleCache) SetLoginRules(_ context.Context, rules string) error {
+	c.rules = rules
+	return nil
+}
+
+var _ cache.LoginRuleCache = (*memLoginRuleCache)(nil)
+
+func TestLoginRulesShared(t *testing.T) {
+	ctx := context.Background()
+	rdb, mock := redismock.NewClientMock()
+	loginRuleCache := &memLoginRuleCache{}
+	configRules := &gateway.LoginRules{Enable: true, Rules: []*gateway.LoginRule{{PlatformClass: "Mobile", MaxConns: 2, OnExceed: gateway.KickOldest}}}
+	newNode := func() *WsServer {
+		ws := &WsServer{loginRuleCache: loginRuleCache, loginRulesPublisher: redispubsub.NewPublisher(rdb, loginRulesChannel)}
+		ws.loginRules.Store(configRules)
+		return ws
+	}
+	node1, node2 := newNode(), newNode()
+
+	// the rules of the config are kept until some are saved
+	require.NoError(t, node1.loadLoginRules(ctx))
+	assert.True(t, proto.Equal(configRules, node1.GetLoginRules()))
+
+	rules := &gateway.LoginRules{Enable: true, Rules: []*gateway.LoginRule{{PlatformClass: "Web", MaxConns: 1, OnExceed: gateway.RejectNewest}}}
+	mock.ExpectPublish(loginRulesChannel, loginRulesChannel).SetVal(1)
+	require.NoError(t, node1.SetLoginRules(ctx, rules))
+	assert.True(t, proto.Equal(rules, node1.GetLoginRules()))
+	assert.NoError(t, mock.ExpectationsWereMet())
+
+	// the other nodes and the restarted ones load the saved rules
+	require.NoError(t, node2.loadLoginRules(ctx))
+	assert.True(t, proto.Equal(rules, node2.GetLoginRules()))
+	assert.Equal(t, "Web", node2.loginRule(constant.WebPlatformID).GetPlatformClass())
+	node3 := newNode()
+	require.NoError(t, node3.loadLoginRules(ctx))
+	assert.True(t, proto.Equal(rules, node3.GetLoginRules()))
+
+	// invalid saved rules are not loaded
+	loginRuleCache.rules = `{"enable":true,"rules":[{"platformClass":"Web","maxConns":1,"onExceed":"unknown"}]}`
+	assert.Error(t, node2.loadLoginRules(ctx))
+	assert.True(t, proto.Equal(rules, node2.GetLoginRules()))
+}
+
+func TestLoginRuleVictims(t *testing.T) {
+	conns := func() []*gateway.ConnInfo {
+		return []*gateway.ConnInfo{
+			{ConnID: "c", ConnectTime: 3},
+			{ConnID: "a", ConnectTime: 1},
+			{ConnID: "d", ConnectTime: 3},
+			{ConnID: "b", ConnectTime: 2},
+		}
+	}
+	connIDs := func(conns []*gateway.ConnInfo) []string {
+		var ids []string
+		for _, conn := range conns {
+			ids = append(ids, conn.ConnID)
+		}
+		return ids
+	}
+
+	victims := loginRuleVictims(&gateway.LoginRule{MaxConns: 2, OnExceed: gateway.KickOldest}, conns())
+	assert.Equal(t, []string{"a", "b"}, connIDs(victims))
+
+	victims = loginRuleVictims(&gateway.LoginRule{MaxConns: 3, OnExceed: gateway.RejectNewest}, conns())
+	assert.Equal(t, []string{"d"}, connIDs(victims))
+
+	victims = loginRuleVictims(&gateway.LoginRule{MaxConns: 4, OnExceed: gateway.KickOldest}, conns())
+	assert.Empty(t, victims)
+}
+
+// ruleNode is another node holding conns of the user, it records the calls of the node enforcing the rules.
+type ruleNode struct {
+	gateway.UnimplementedGatewayAdminServer
+	conns []*gateway.ConnInfo
+
+	mu             sync.Mutex
+	getConnsCalls  int
+	kickedRequests []*gateway.KickConnReq
+}
+
+func (n *ruleNode) GetUserConns(context.Context, *gateway.GetUserConnsReq) (*gateway.GetUserConnsResp, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.getConnsCalls++
+	return &gateway.GetUserConnsResp{Conns: n.conns}, nil
+}
+
+func (n *ruleNode) KickConn(_ context.Context, req *gateway.KickConnReq) (*gateway.KickConnResp, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.kickedRequests = append(n.kickedRequests, req)
+	return &gateway.KickConnResp{Kicked: true}, nil
+}
+
+type ruleDiscovery struct {
+	discovery.SvcDiscoveryRegistry
+	conns []*grpc.ClientConn
+}
+
+func (d *ruleDiscovery) GetConns(context.Context, string, ...grpc.DialOption) ([]*grpc.ClientConn, error) {
+	return d.conns, nil
+}
+
+func (d *ruleDiscovery) GetSelfConnTarget() string {
+	return "self"
+}
+
+func newRuleNodeConn(t *testing.T, node *ruleNode) *grpc.ClientConn {
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	gateway.RegisterGatewayAdminServer(srv, node)
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+	conn, err := grpc.Dial("passthrough:///node",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return lis.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return conn
+}
+
+func TestEnforceLoginRuleOnce(t *testing.T) {
+	newNode := func(t *testing.T, remote *ruleNode) *WsServer {
+		conf := &Config{}
+		conf.Share.IMAdminUserID = []string{"admin"}
+		ws := &WsServer{
+			clients:          newUserMap(8),
+			msgGatewayConfig: conf,
+			disCov:           &ruleDiscovery{conns: []*grpc.ClientConn{newRuleNodeConn(t, remote)}},
+		}
+		ws.loginRules.Store(&gateway.LoginRules{Enable: true, Rules: []*gateway.LoginRule{
+			{PlatformClass: platformClass(constant.AndroidPlatformID), MaxConns: 2, OnExceed: gateway.KickOldest},
+		}})
+		addAdminClient(ws, "u1", "a", 2)
+		addAdminClient(ws, "u1", "b", 3)
+		return ws
+	}
+
+	t.Run("remote victim", func(t *testing.T) {
+		remote := &ruleNode{conns: []*gateway.ConnInfo{{UserID: "u1", ConnID: "r", PlatformID: constant.AndroidPlatformID, ConnectTime: 1}}}
+		ws := newNode(t, remote)
+		ws.enforceLoginRule(context.Background(), "u1", constant.AndroidPlatformID)
+		// the other node is listed once and told the conn to kick
+		assert.Equal(t, 1, remote.getConnsCalls)
+		require.Len(t, remote.kickedRequests, 1)
+		assert.Equal(t, "r", remote.kickedRequests[0].ConnID)
+		assert.True(t, remote.kickedRequests[0].ByLoginRule)
+		clients, _ := ws.clients.GetAll("u1")
+		assert.Len(t, clients, 2)
+	})
+	t.Run("local victim", func(t *testing.T) {
+		remote := &ruleNode{conns: []*gateway.ConnInfo{{UserID: "u1", ConnID: "r", PlatformID: constant.AndroidPlatformID, ConnectTime: 4}}}
+		ws := newNode(t, remote)
+		ws.enforceLoginRule(context.Background(), "u1", constant.AndroidPlatformID)
+		assert.Empty(t, remote.kickedRequests)
+		clients, _ := ws.clients.GetAll("u1")
+		require.Len(t, clients, 1)
+		assert.Equal(t, "b", clients[0].ctx.GetConnID())
+	})
+	t.Run("login check of another node", func(t *testing.T) {
+		ws := newNode(t, &ruleNode{})
+		ws.kickHandlerChan = make(chan *kickHandler, 1)
+		s := &Server{LongConnServer: ws}
+		_, err := s.MultiTerminalLoginCheck(context.Background(), &msggateway.MultiTerminalLoginCheckReq{
+			UserID: "u1", PlatformID: constant.AndroidPlatformID, Token: "token"})
+		require.NoError(t, err)
+		// the node the user connected to enforces the rules
+		assert.Empty(t, ws.kickHandlerChan)
+	})
+}
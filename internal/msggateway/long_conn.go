@@ -49,15 +49,26 @@ type LongConn interface {
 	// GenerateLongConn Check the connection of the current and when it was sent are the same
 	GenerateLongConn(w http.ResponseWriter, r *http.Request) error
 }
+
+// permessageDeflate is the config of the standard websocket permessage-deflate extension.
+type permessageDeflate struct {
+	// deflate level from 1 (best speed) to 9 (best compression)
+	level int
+	// the messages smaller than threshold bytes are sent uncompressed
+	threshold int
+}
+
 type GWebSocket struct {
 	protocolType     int
 	conn             *websocket.Conn
 	handshakeTimeout time.Duration
 	writeBufferSize  int
+	// deflate is nil if permessage-deflate is disabled.
+	deflate *permessageDeflate
 }
 
-func newGWebSocket(protocolType int, handshakeTimeout time.Duration, wbs int) *GWebSocket {
-	return &GWebSocket{protocolType: protocolType, handshakeTimeout: handshakeTimeout, writeBufferSize: wbs}
+func newGWebSocket(protocolType int, handshakeTimeout time.Duration, wbs int, deflate *permessageDeflate) *GWebSocket {
+	return &GWebSocket{protocolType: protocolType, handshakeTimeout: handshakeTimeout, writeBufferSize: wbs, deflate: deflate}
 }
 
 func (d *GWebSocket) Close() error {
@@ -68,6 +79,8 @@ func (d *GWebSocket) GenerateLongConn(w http.ResponseWriter, r *http.Request) er
 	upgrader := &websocket.Upgrader{
 		HandshakeTimeout: d.handshakeTimeout,
 		CheckOrigin:      func(r *http.Request) bool { return true },
+		// the extension is only used when the client offers it in the handshake
+		EnableCompression: d.deflate != nil,
 	}
 	if d.writeBufferSize > 0 { // default is 4kb.
 		upgrader.WriteBufferSize = d.writeBufferSize
@@ -78,12 +91,22 @@ func (d *GWebSocket) GenerateLongConn(w http.ResponseWriter, r *http.Request) er
 		// The upgrader.Upgrade method usually returns enough error messages to diagnose problems that may occur during the upgrade
 		return errs.WrapMsg(err, "GenerateLongConn: WebSocket upgrade failed")
 	}
+	if d.deflate != nil {
+		if err := conn.SetCompressionLevel(d.deflate.level); err != nil {
+			_ = conn.Close()
+			return errs.WrapMsg(err, "GenerateLongConn: invalid deflate level", "level", d.deflate.level)
+		}
+	}
 	d.conn = conn
 	return nil
 }
 
 func (d *GWebSocket) WriteMessage(messageType int, message []byte) error {
 	// d.setSendConn(d.conn)
+	if d.deflate != nil {
+		// it has no effect if the client does not negotiate permessage-deflate
+		d.conn.EnableWriteCompression(len(message) >= d.deflate.threshold)
+	}
 	return d.conn.WriteMessage(messageType, message)
 }
 
@@ -21,20 +21,27 @@ import (
 	pbAuth "github.com/openimsdk/protocol/auth"
 	"github.com/openimsdk/tools/mcontext"
 	"net/http"
+	"os"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/prommetrics"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/redispubsub"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/gateway"
+	"github.com/openimsdk/open-im-server/v3/pkg/rpccache"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
 	"github.com/openimsdk/protocol/constant"
 	"github.com/openimsdk/protocol/msggateway"
+	"github.com/openimsdk/protocol/sdkws"
 	"github.com/openimsdk/tools/discovery"
 	"github.com/openimsdk/tools/errs"
 	"github.com/openimsdk/tools/log"
 	"github.com/openimsdk/tools/utils/stringutil"
+	"github.com/redis/go-redis/v9"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -48,6 +55,30 @@ type LongConnServer interface {
 	KickUserConn(client *Client) error
 	UnRegister(c *Client)
 	SetKickHandlerInfo(i *kickHandler)
+	// GetCompressor returns the compressor of each message negotiated by the compression query parameter,
+	// it returns false if compression is empty or not supported.
+	GetCompressor(compression string) (Compressor, bool)
+	// PushToDetachedSessions buffers the push for the resume sessions of userID whose connections dropped.
+	PushToDetachedSessions(ctx context.Context, userID string, msgData *sdkws.MsgData)
+	// DropDetachedSessions drops the resume sessions of userID on platformID whose connections dropped,
+	// because the user connected to another node.
+	DropDetachedSessions(userID string, platformID int)
+	// Drain starts draining the node, it returns the number of the connections.
+	Drain(timeout time.Duration) int64
+	// Drained returns the channel closed once the node is drained.
+	Drained() <-chan struct{}
+	GetUserConnInfos(userID string) []*gateway.ConnInfo
+	// ListConnInfos returns the number of the connections of userIDs, or all if userIDs is empty,
+	// and the first end of them ordered by connectTime and connID.
+	ListConnInfos(userIDs []string, end int) (int64, []*gateway.ConnInfo)
+	// KickConn kicks the connection connID of userID, it returns false if it is not on the node.
+	KickConn(userID string, connID string) bool
+	// KickLoginRuleConn logs out the connection connID of userID exceeding the login rules, it returns false if it is not on the node.
+	KickLoginRuleConn(ctx context.Context, userID string, connID string) bool
+	GetLoginRules() *gateway.LoginRules
+	SetLoginRules(ctx context.Context, rules *gateway.LoginRules) error
+	// SendEphemeralMsg delivers the ephemeral msg of the client to the online connections of the receivers on all the nodes.
+	SendEphemeralMsg(ctx context.Context, client *Client, req *Req) ([]byte, error)
 	Compressor
 	Encoder
 	MessageHandler
@@ -61,15 +92,40 @@ type WsServer struct {
 	unregisterChan    chan *Client
 	kickHandlerChan   chan *kickHandler
 	clients           *UserMap
-	clientPool        *Pool[*Client]
 	onlineUserNum     atomic.Int64
 	onlineUserConnNum atomic.Int64
 	handshakeTimeout  time.Duration
 	writeBufferSize   int
-	validate          *validator.Validate
-	userClient        *rpcclient.UserRpcClient
-	authClient        *rpcclient.Auth
-	disCov            discovery.SvcDiscoveryRegistry
+	permessageDeflate *permessageDeflate
+	compressors       map[string]Compressor
+	sessions          *sessionManager
+	pingInterval      time.Duration
+	missedPongs       int
+	outboundQueueSize int
+	overflowPolicy    string
+	// draining is true once the node starts draining, drained is closed when it is done.
+	draining            atomic.Bool
+	drained             chan struct{}
+	drainTimeout        time.Duration
+	drainBatchSize      int
+	drainBatchInterval  time.Duration
+	loginRules          atomic.Pointer[gateway.LoginRules]
+	loginRuleCache      cache.LoginRuleCache
+	loginRulesPublisher *redispubsub.Publisher
+	// nodeID identifies the node in the ephemeral msgs it publishes and the resume tokens it issues.
+	nodeID             string
+	rdb                redis.UniversalClient
+	ephemeralPublisher *redispubsub.Publisher
+	resumePublisher    *redispubsub.Publisher
+	groupCache         *rpccache.GroupLocalCache
+	validate           *validator.Validate
+	userClient         *rpcclient.UserRpcClient
+	authClient         *rpcclient.Auth
+	tokenCache         cache.TokenModel
+	msgCache           cache.MsgCache
+	friendCache        *rpccache.FriendLocalCache
+	pushWorkers        *pushWorkers
+	disCov             discovery.SvcDiscoveryRegistry
 	Compressor
 	Encoder
 	MessageHandler
@@ -88,6 +144,10 @@ func (ws *WsServer) SetDiscoveryRegistry(disCov discovery.SvcDiscoveryRegistry,
 	ws.authClient = rpcclient.NewAuth(disCov, config.Share.RpcRegisterName.Auth)
 	ws.userClient = &u
 	ws.disCov = disCov
+	ws.groupCache = rpccache.NewGroupLocalCache(rpcclient.NewGroupRpcClient(disCov, config.Share.RpcRegisterName.Group),
+		&config.LocalCacheConfig, ws.rdb)
+	ws.friendCache = rpccache.NewFriendLocalCache(rpcclient.NewFriendRpcClient(disCov, config.Share.RpcRegisterName.Friend),
+		&config.LocalCacheConfig, ws.rdb)
 }
 
 func (ws *WsServer) SetUserOnlineStatus(ctx context.Context, client *Client, status int32) {
@@ -111,6 +171,11 @@ func (ws *WsServer) Validate(_ any) error {
 	return nil
 }
 
+func (ws *WsServer) GetCompressor(compression string) (Compressor, bool) {
+	compressor, ok := ws.compressors[compression]
+	return compressor, ok
+}
+
 func (ws *WsServer) GetUserAllCons(userID string) ([]*Client, bool) {
 	return ws.clients.GetAll(userID)
 }
@@ -124,25 +189,69 @@ func NewWsServer(msgGatewayConfig *Config, opts ...Option) (*WsServer, error) {
 	for _, o := range opts {
 		o(&config)
 	}
+	if config.drainTimeout <= 0 {
+		config.drainTimeout = defaultDrainTimeout
+	}
+	if config.drainBatchSize <= 0 {
+		config.drainBatchSize = defaultDrainBatchSize
+	}
+	if config.drainBatchInterval <= 0 {
+		config.drainBatchInterval = defaultDrainBatchInterval
+	}
+	if config.outboundQueueSize > 0 && config.overflowPolicy != OverflowDropOldest && config.overflowPolicy != OverflowDisconnect {
+		return nil, errs.New("invalid outbound queue overflow policy", "overflowPolicy", config.overflowPolicy).Wrap()
+	}
+	loginRules, err := newLoginRules(msgGatewayConfig)
+	if err != nil {
+		return nil, err
+	}
 	v := validator.New()
-	return &WsServer{
-		msgGatewayConfig: msgGatewayConfig,
-		port:             config.port,
-		wsMaxConnNum:     config.maxConnNum,
-		writeBufferSize:  config.writeBufferSize,
-		handshakeTimeout: config.handshakeTimeout,
-		clientPool: NewPool[*Client](func() *Client {
-			return new(Client)
-		}, nil),
-		registerChan:    make(chan *Client, 1000),
-		unregisterChan:  make(chan *Client, 1000),
-		kickHandlerChan: make(chan *kickHandler, 1000),
-		validate:        v,
-		clients:         newUserMap(100),
-		Compressor:      NewGzipCompressor(),
-		Encoder:         NewGobEncoder(),
-		webhookClient:   webhook.NewWebhookClient(msgGatewayConfig.WebhooksConfig.URL),
-	}, nil
+	compressors := map[string]Compressor{GzipCompressionProtocol: NewGzipCompressor()}
+	if zstdConfig := msgGatewayConfig.MsgGateway.LongConnSvr.Compression.Zstd; zstdConfig.Enable {
+		var dict []byte
+		if zstdConfig.DictPath != "" {
+			var err error
+			if dict, err = os.ReadFile(zstdConfig.DictPath); err != nil {
+				return nil, errs.WrapMsg(err, "read zstd dictionary failed", "dictPath", zstdConfig.DictPath)
+			}
+		}
+		zstdCompressor, err := NewZstdCompressor(zstdConfig.Level, dict)
+		if err != nil {
+			return nil, err
+		}
+		compressors[ZstdCompressionProtocol] = zstdCompressor
+	}
+	ws := &WsServer{
+		msgGatewayConfig:   msgGatewayConfig,
+		port:               config.port,
+		wsMaxConnNum:       config.maxConnNum,
+		writeBufferSize:    config.writeBufferSize,
+		handshakeTimeout:   config.handshakeTimeout,
+		permessageDeflate:  config.permessageDeflate,
+		compressors:        compressors,
+		pingInterval:       config.pingInterval,
+		missedPongs:        config.missedPongs,
+		outboundQueueSize:  config.outboundQueueSize,
+		overflowPolicy:     config.overflowPolicy,
+		drained:            make(chan struct{}),
+		nodeID:             newResumeToken(),
+		drainTimeout:       config.drainTimeout,
+		drainBatchSize:     config.drainBatchSize,
+		drainBatchInterval: config.drainBatchInterval,
+		registerChan:       make(chan *Client, 1000),
+		unregisterChan:     make(chan *Client, 1000),
+		kickHandlerChan:    make(chan *kickHandler, 1000),
+		validate:           v,
+		clients:            newUserMap(100),
+		Compressor:         NewGzipCompressor(),
+		Encoder:            NewGobEncoder(),
+		webhookClient:      webhook.NewWebhookClient(msgGatewayConfig.WebhooksConfig.URL),
+	}
+	ws.loginRules.Store(loginRules)
+	if config.resumeWindow > 0 {
+		ws.sessions = newSessionManager(ws.nodeID, config.resumeWindow, config.resumeBufferSize, ws.sessionExpired)
+	}
+	return ws, nil
 }
 
 func (ws *WsServer) Run(done chan error) error {
@@ -177,19 +286,31 @@ func (ws *WsServer) Run(done chan error) error {
 			netErr = errs.WrapMsg(err, "ws start err", server.Addr)
 		}
 	}()
-	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
-	defer cancel()
-	var err error
-	select {
-	case err = <-done:
-		sErr := server.Shutdown(ctx)
-		if sErr != nil {
-			return errs.WrapMsg(sErr, "shutdown err")
+	shutdown := func() error {
+		ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		defer cancel()
+		if err := server.Shutdown(ctx); err != nil {
+			return errs.WrapMsg(err, "shutdown err")
 		}
 		close(shutdownDone)
+		return nil
+	}
+	select {
+	case err := <-done:
+		if err == nil {
+			// the rpc server is stopped by SIGTERM after draining the connections, see drainBeforeStop
+			ws.Drain(0)
+			<-ws.drained
+		}
+		if sErr := shutdown(); sErr != nil {
+			return sErr
+		}
 		if err != nil {
 			return err
 		}
+	case <-ws.drained:
+		// drained by the Drain rpc
+		return shutdown()
 	case <-netDone:
 	}
 	return netErr
@@ -244,26 +365,28 @@ func (ws *WsServer) registerClient(client *Client) {
 		oldClients []*Client
 	)
 	oldClients, userOK, clientOK = ws.clients.Get(client.UserID, client.PlatformID)
+	ws.clients.Set(client.UserID, client)
+	ws.onlineUserConnNum.Add(1)
 	if !userOK {
-		ws.clients.Set(client.UserID, client)
 		log.ZDebug(client.ctx, "user not exist", "userID", client.UserID, "platformID", client.PlatformID)
 		prommetrics.OnlineUserGauge.Add(1)
 		ws.onlineUserNum.Add(1)
-		ws.onlineUserConnNum.Add(1)
 	} else {
-		ws.multiTerminalLoginChecker(clientOK, oldClients, client)
 		log.ZDebug(client.ctx, "user exist", "userID", client.UserID, "platformID", client.PlatformID)
 		if clientOK {
-			ws.clients.Set(client.UserID, client)
 			// There is already a connection to the platform
 			log.ZInfo(client.ctx, "repeat login", "userID", client.UserID, "platformID",
 				client.PlatformID, "old remote addr", getRemoteAdders(oldClients))
-			ws.onlineUserConnNum.Add(1)
-		} else {
-			ws.clients.Set(client.UserID, client)
-			ws.onlineUserConnNum.Add(1)
 		}
 	}
+	ws.multiTerminalLoginChecker(clientOK, oldClients, client)
+
+	if ws.sessions != nil && ws.attachSession(client) {
+		// the user has never gone offline
+		log.ZInfo(client.ctx, "user resumed", "online user Num", ws.onlineUserNum.Load(),
+			"online user conn Num", ws.onlineUserConnNum.Load())
+		return
+	}
 
 	wg := sync.WaitGroup{}
 	log.ZDebug(client.ctx, "ws.msgGatewayConfig.Discovery.Enable", ws.msgGatewayConfig.Discovery.Enable)
@@ -312,6 +435,11 @@ func (ws *WsServer) KickUserConn(client *Client) error {
 }
 
 func (ws *WsServer) multiTerminalLoginChecker(clientOK bool, oldClients []*Client, newClient *Client) {
+	if ws.loginRules.Load().GetEnable() {
+		// the rules count the connections on all the nodes, they are enforced out of the event loop
+		go ws.enforceLoginRule(newClient.ctx, newClient.UserID, newClient.PlatformID)
+		return
+	}
 	switch ws.msgGatewayConfig.MsgGateway.MultiLoginPolicy {
 	case constant.DefalutNotKick:
 	case constant.PCAndOther:
@@ -342,13 +470,17 @@ func (ws *WsServer) multiTerminalLoginChecker(clientOK bool, oldClients []*Clien
 }
 
 func (ws *WsServer) unregisterClient(client *Client) {
-	defer ws.clientPool.Put(client)
 	isDeleteUser := ws.clients.delete(client.UserID, client.ctx.GetRemoteAddr())
 	if isDeleteUser {
 		ws.onlineUserNum.Add(-1)
 		prommetrics.OnlineUserGauge.Dec()
 	}
 	ws.onlineUserConnNum.Add(-1)
+	if s := client.session; s != nil && ws.sessions.detach(s, client, client.resumable.Load()) {
+		log.ZInfo(client.ctx, "user detached", "close reason", client.closedErr, "online user Num",
+			ws.onlineUserNum.Load(), "online user conn Num", ws.onlineUserConnNum.Load())
+		return
+	}
 	ws.SetUserOnlineStatus(client.ctx, client, constant.Offline)
 	log.ZInfo(client.ctx, "user offline", "close reason", client.closedErr, "online user Num",
 		ws.onlineUserNum.Load(), "online user conn Num",
@@ -369,10 +501,31 @@ func (ws *WsServer) validateRespWithRequest(ctx *UserConnContext, resp *pbAuth.P
 	return nil
 }
 
+// respondHandshakeError sends err via WebSocket if the connection asks for the response, otherwise via HTTP.
+func (ws *WsServer) respondHandshakeError(connContext *UserConnContext, w http.ResponseWriter, r *http.Request,
+	deflate *permessageDeflate, err error) {
+	if connContext.ShouldSendResp() {
+		// Create a WebSocket connection object and attempt to send the error message via WebSocket
+		wsLongConn := newGWebSocket(WebSocket, ws.handshakeTimeout, ws.writeBufferSize, deflate)
+		if err := wsLongConn.RespondWithError(err, w, r); err == nil {
+			// If the error message is successfully sent via WebSocket, stop processing
+			return
+		}
+	}
+	// If sending via WebSocket is not required or fails, return the error via HTTP
+	httpError(connContext, err)
+}
+
 func (ws *WsServer) wsHandler(w http.ResponseWriter, r *http.Request) {
 	// Create a new connection context
 	connContext := newContext(w, r)
 
+	// The draining node does not accept connections, the clients must connect to the other nodes
+	if ws.draining.Load() {
+		httpError(connContext, servererrs.ErrGatewayDraining.WrapMsg("msg gateway is draining"))
+		return
+	}
+
 	// Check if the current number of online user connections exceeds the maximum limit
 	if ws.onlineUserConnNum.Load() >= ws.wsMaxConnNum {
 		// If it exceeds the maximum connection number, return an error via HTTP and stop processing
@@ -388,22 +541,17 @@ func (ws *WsServer) wsHandler(w http.ResponseWriter, r *http.Request) {
 		httpError(connContext, err)
 		return
 	}
+	// the messages of an unknown compression are not compressed, as the clients which do not send it
+	// permessage-deflate is useless for the messages compressed by the compressor
+	deflate := ws.permessageDeflate
+	if _, ok := ws.GetCompressor(connContext.GetCompression()); ok {
+		deflate = nil
+	}
 
 	// Call the authentication client to parse the Token obtained from the context
 	resp, err := ws.authClient.ParseToken(connContext, connContext.GetToken())
 	if err != nil {
-		// If there's an error parsing the Token, decide whether to send the error message via WebSocket based on the context flag
-		shouldSendError := connContext.ShouldSendResp()
-		if shouldSendError {
-			// Create a WebSocket connection object and attempt to send the error message via WebSocket
-			wsLongConn := newGWebSocket(WebSocket, ws.handshakeTimeout, ws.writeBufferSize)
-			if err := wsLongConn.RespondWithError(err, w, r); err == nil {
-				// If the error message is successfully sent via WebSocket, stop processing
-				return
-			}
-		}
-		// If sending via WebSocket is not required or fails, return the error via HTTP and stop processing
-		httpError(connContext, err)
+		ws.respondHandshakeError(connContext, w, r, deflate, err)
 		return
 	}
 
@@ -415,8 +563,20 @@ func (ws *WsServer) wsHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Reject the connection exceeding the rejectNewest login rule of its platform class. The limit is best-effort,
+	// the connections of the user handshaking at the same time are counted without each other. Only the platform
+	// classes with a rejectNewest rule pay for counting the connections on the other nodes.
+	platformID := stringutil.StringToInt(connContext.GetPlatformID())
+	if rule := ws.loginRule(platformID); rule != nil && rule.OnExceed == gateway.RejectNewest {
+		if conns, _ := ws.getUserClassConns(connContext, connContext.GetUserID(), rule.PlatformClass); len(conns) >= int(rule.MaxConns) {
+			ws.respondHandshakeError(connContext, w, r, deflate, servererrs.ErrConnTerminalLimit.WrapMsg(
+				"too many connections of the platform class", "platformClass", rule.PlatformClass, "maxConns", rule.MaxConns))
+			return
+		}
+	}
+
 	// Create a WebSocket long connection object
-	wsLongConn := newGWebSocket(WebSocket, ws.handshakeTimeout, ws.writeBufferSize)
+	wsLongConn := newGWebSocket(WebSocket, ws.handshakeTimeout, ws.writeBufferSize, deflate)
 	if err := wsLongConn.GenerateLongConn(w, r); err != nil {
 		//If the creation of the long connection fails, the error is handled internally during the handshake process.
 		log.ZWarn(connContext, "long connection fails", err)
@@ -433,11 +593,23 @@ func (ws *WsServer) wsHandler(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	// Retrieve a client object from the client pool, reset its state, and associate it with the current WebSocket long connection
-	client := ws.clientPool.Get()
+	// Create a client associated with the current WebSocket long connection. The clients are not pooled, the push workers,
+	// writeLoop and keepAlive may still refer to a client after it is unregistered, so it must not be reused for another connection.
+	client := new(Client)
 	client.ResetClient(connContext, wsLongConn, ws)
+	if ws.pingInterval > 0 {
+		// the read deadline must not close the connection before the keepalive
+		client.readWait = max(pongWait, ws.pingInterval*time.Duration(ws.missedPongs+2))
+	}
+	if ws.outboundQueueSize > 0 {
+		client.outbound = newOutboundQueue(ws.outboundQueueSize, ws.overflowPolicy)
+		go client.writeLoop(client.done, client.outbound)
+	}
 
 	// Register the client with the server and start message processing
 	ws.registerChan <- client
 	go client.readMessage()
+	if ws.pingInterval > 0 {
+		go client.keepAlive(client.done, ws.pingInterval, ws.missedPongs)
+	}
 }
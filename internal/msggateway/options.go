@@ -29,6 +29,26 @@ type (
 		messageMaxMsgLength int
 		// Websocket write buffer, default: 4096, 4kb.
 		writeBufferSize int
+		// Websocket permessage-deflate, nil if it is disabled.
+		permessageDeflate *permessageDeflate
+		// Resume window of the sessions of the dropped connections, 0 if resumption is disabled.
+		resumeWindow time.Duration
+		// Number of the last pushes buffered by each resume session.
+		resumeBufferSize int
+		// Interval of the pings sent by the server, 0 if the server does not ping.
+		pingInterval time.Duration
+		// Number of the ping intervals without a pong or message before the connection is closed.
+		missedPongs int
+		// Max time to wait for the connections to close when draining.
+		drainTimeout time.Duration
+		// Number of the connections asked to reconnect elsewhere in each batch when draining.
+		drainBatchSize int
+		// Interval of the batches when draining.
+		drainBatchInterval time.Duration
+		// Max number of the frames queued for each connection, 0 if the frames are written by the senders.
+		outboundQueueSize int
+		// Policy when the outbound queue of a connection is full.
+		overflowPolicy string
 	}
 )
 
@@ -61,3 +81,38 @@ func WithWriteBufferSize(size int) Option {
 		opt.writeBufferSize = size
 	}
 }
+
+func WithPermessageDeflate(level int, threshold int) Option {
+	return func(opt *configs) {
+		opt.permessageDeflate = &permessageDeflate{level: level, threshold: threshold}
+	}
+}
+
+func WithResume(window time.Duration, bufferSize int) Option {
+	return func(opt *configs) {
+		opt.resumeWindow = window
+		opt.resumeBufferSize = bufferSize
+	}
+}
+
+func WithKeepAlive(pingInterval time.Duration, missedPongs int) Option {
+	return func(opt *configs) {
+		opt.pingInterval = pingInterval
+		opt.missedPongs = missedPongs
+	}
+}
+
+func WithDrain(timeout time.Duration, batchSize int, batchInterval time.Duration) Option {
+	return func(opt *configs) {
+		opt.drainTimeout = timeout
+		opt.drainBatchSize = batchSize
+		opt.drainBatchInterval = batchInterval
+	}
+}
+
+func WithOutboundQueue(size int, overflowPolicy string) Option {
+	return func(opt *configs) {
+		opt.outboundQueueSize = size
+		opt.overflowPolicy = overflowPolicy
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msggateway
+
+import (
+	"sync"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/prommetrics"
+)
+
+// Overflow policies of the outbound queue.
+const (
+	// OverflowDropOldest drops the oldest non-critical frame, the connection is disconnected if all are critical.
+	OverflowDropOldest = "dropOldest"
+	// OverflowDisconnect disconnects the slow consumer.
+	OverflowDisconnect = "disconnect"
+)
+
+type outFrame struct {
+	data []byte
+	// critical frames are never dropped, e.g. the replies.
+	critical bool
+	// final closes the connection once the frame is written, e.g. the kick.
+	final bool
+}
+
+// isCriticalFrame returns false for the push frames, the missed pushes can be pulled by seqs
+// and the ephemeral msgs are meant to be lost.
+func isCriticalFrame(reqIdentifier int32) bool {
+	return reqIdentifier != WSPushMsg && reqIdentifier != WSPushEphemeralMsg
+}
+
+// outboundQueue is the bounded queue of the frames waiting for the writer goroutine of a connection,
+// so a slow reader does not block the goroutines pushing to it.
+type outboundQueue struct {
+	size   int
+	policy string
+	// ready is signaled when frames are queued.
+	ready  chan struct{}
+	mu     sync.Mutex
+	frames []outFrame
+	// final is true once a final frame is queued, closed once the writer exits.
+	final  bool
+	closed bool
+}
+
+func newOutboundQueue(size int, policy string) *outboundQueue {
+	return &outboundQueue{size: size, policy: policy, ready: make(chan struct{}, 1)}
+}
+
+// push queues the frame, it returns false if the queue is full and the connection must be disconnected.
+func (q *outboundQueue) push(frame outFrame) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.final || q.closed {
+		return true
+	}
+	if len(q.frames) >= q.size && (q.policy != OverflowDropOldest || !q.dropOldest()) {
+		return false
+	}
+	q.frames = append(q.frames, frame)
+	q.final = frame.final
+	prommetrics.GateWayOutboundQueueGauge.Inc()
+	select {
+	case q.ready <- struct{}{}:
+	default:
+	}
+	return true
+}
+
+func (q *outboundQueue) dropOldest() bool {
+	for i, frame := range q.frames {
+		if !frame.critical {
+			q.frames = append(q.frames[:i], q.frames[i+1:]...)
+			prommetrics.GateWayOutboundQueueGauge.Dec()
+			prommetrics.GateWayOutboundDroppedCounter.Inc()
+			return true
+		}
+	}
+	return false
+}
+
+// pop takes all the queued frames.
+func (q *outboundQueue) pop() []outFrame {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	frames := q.frames
+	q.frames = nil
+	prommetrics.GateWayOutboundQueueGauge.Sub(float64(len(frames)))
+	return frames
+}
+
+// close drops the frames left when the connection is closed.
+func (q *outboundQueue) close() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.closed = true
+	prommetrics.GateWayOutboundQueueGauge.Sub(float64(len(q.frames)))
+	q.frames = nil
+}
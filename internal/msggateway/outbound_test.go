@@ -0,0 +1,91 @@
+package msggateway
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestOutboundQueueOverflow(t *testing.T) {
+	q := newOutboundQueue(2, OverflowDropOldest)
+	q.push(outFrame{data: []byte("reply"), critical: true})
+	q.push(outFrame{data: []byte("push1")})
+	if !q.push(outFrame{data: []byte("push2")}) {
+		t.Fatal("dropOldest must drop push1")
+	}
+	frames := q.pop()
+	if len(frames) != 2 || string(frames[0].data) != "reply" || string(frames[1].data) != "push2" {
+		t.Fatalf("frames %v, want reply and push2", frames)
+	}
+
+	q.push(outFrame{data: []byte("reply1"), critical: true})
+	q.push(outFrame{data: []byte("reply2"), critical: true})
+	if q.push(outFrame{data: []byte("push")}) {
+		t.Fatal("the queue full of critical frames must overflow")
+	}
+
+	q = newOutboundQueue(1, OverflowDisconnect)
+	q.push(outFrame{data: []byte("push1")})
+	if q.push(outFrame{data: []byte("push2")}) {
+		t.Fatal("disconnect must not drop frames")
+	}
+}
+
+type blockingConn struct {
+	drainConn
+	block chan struct{}
+}
+
+func (c *blockingConn) WriteMessage(messageType int, message []byte) error {
+	<-c.block
+	return c.drainConn.WriteMessage(messageType, message)
+}
+
+func TestWriteLoopStopsOnClose(t *testing.T) {
+	conn := &blockingConn{block: make(chan struct{})}
+	c := &Client{
+		w:              new(sync.Mutex),
+		conn:           conn,
+		longConnServer: &unregisterServer{},
+		ctx:            newTempContext(),
+		done:           make(chan struct{}),
+		outbound:       newOutboundQueue(10, OverflowDisconnect),
+	}
+	exited := make(chan struct{})
+	go func() {
+		c.writeLoop(c.done, c.outbound)
+		close(exited)
+	}()
+	for _, data := range []string{"push1", "push2", "push3"} {
+		if err := c.sendFrame(outFrame{data: []byte(data)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// the connection is closed while push1 is being written
+	closed := make(chan struct{})
+	go func() {
+		c.close()
+		close(closed)
+	}()
+	time.Sleep(10 * time.Millisecond)
+	close(conn.block)
+	<-closed
+	conn.mu.Lock()
+	written := len(conn.frames)
+	conn.mu.Unlock()
+	select {
+	case <-exited:
+	case <-time.After(time.Second):
+		t.Fatal("writeLoop not stopped after close")
+	}
+	if len(conn.frames) != written {
+		t.Fatalf("written %d frames after close", len(conn.frames)-written)
+	}
+	if err := c.sendFrame(outFrame{data: []byte("push4")}); err != nil {
+		t.Fatal(err)
+	}
+	if n := len(c.outbound.pop()); n != 0 {
+		t.Fatalf("%d frames queued after close, want 0", n)
+	}
+}
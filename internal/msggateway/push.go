@@ -0,0 +1,151 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msggateway
+
+import (
+	"bytes"
+	"context"
+	"sync"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/msggateway"
+	"github.com/openimsdk/tools/log"
+)
+
+// pushWorkers is the bounded pool of the node writing the frames of the batch pushes.
+type pushWorkers struct {
+	tasks chan func()
+}
+
+func newPushWorkers(n int) *pushWorkers {
+	p := &pushWorkers{tasks: make(chan func(), n)}
+	for i := 0; i < n; i++ {
+		go func() {
+			for task := range p.tasks {
+				task()
+			}
+		}()
+	}
+	return p
+}
+
+// submit blocks until a worker is free if all the workers are busy.
+func (p *pushWorkers) submit(task func()) {
+	p.tasks <- task
+}
+
+// pushFrames encodes the push once for each encoding and compression of the connections.
+type pushFrames struct {
+	resp   Resp
+	mu     sync.Mutex
+	frames map[string][]byte
+}
+
+func newPushFrames(resp Resp) *pushFrames {
+	return &pushFrames{resp: resp, frames: make(map[string][]byte)}
+}
+
+func (f *pushFrames) frame(client *Client) ([]byte, error) {
+	key := client.Encoding + "/" + client.Compression
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if frame, ok := f.frames[key]; ok {
+		return frame, nil
+	}
+	buf := new(bytes.Buffer)
+	if err := client.encodeFrame(f.resp, buf); err != nil {
+		return nil, err
+	}
+	f.frames[key] = buf.Bytes()
+	return buf.Bytes(), nil
+}
+
+// push writes the frame to client. The push workers run the pushes after they are submitted, the connection closed meanwhile
+// fails the push instead of counting it as online, so the push service pushes it offline.
+func (f *pushFrames) push(client *Client) error {
+	if client.closed.Load() {
+		return ErrConnClosed
+	}
+	if client.session != nil {
+		// the pushes of a resumable connection are numbered by its session, so its frame is its own
+		return client.pushResp(f.resp)
+	}
+	frame, err := f.frame(client)
+	if err != nil {
+		return err
+	}
+	return client.sendFrame(outFrame{data: frame})
+}
+
+// OnlineBatchPushOneMsg pushes the msg to the connections of the users on the node,
+// the frame is encoded once and written to the connections concurrently by the push workers.
+func (s *Server) OnlineBatchPushOneMsg(ctx context.Context, req *msggateway.OnlineBatchPushOneMsgReq) (*msggateway.OnlineBatchPushOneMsgResp, error) {
+	resp, err := newPushResp(ctx, req.MsgData)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+		frames  = newPushFrames(resp)
+		results = make([]*msggateway.SingleMsgToUserResults, 0, len(req.PushToUserIDs))
+	)
+	for _, userID := range req.PushToUserIDs {
+		result := &msggateway.SingleMsgToUserResults{UserID: userID}
+		results = append(results, result)
+		s.LongConnServer.PushToDetachedSessions(ctx, userID, req.MsgData)
+		clients, ok := s.LongConnServer.GetUserAllCons(userID)
+		if !ok {
+			log.ZDebug(ctx, "push user not online", "userID", userID)
+			continue
+		}
+		log.ZDebug(ctx, "push user online", "clients", clients, "userID", userID)
+		for _, client := range clients {
+			if client == nil {
+				continue
+			}
+			if client.IsBackground && client.PlatformID == constant.IOSPlatformID {
+				mu.Lock()
+				result.Resp = append(result.Resp, &msggateway.SingleMsgToUserPlatform{
+					RecvPlatFormID: int32(client.PlatformID),
+					ResultCode:     int64(servererrs.ErrIOSBackgroundPushErr.Code()),
+				})
+				mu.Unlock()
+				continue
+			}
+			client, result := client, result
+			wg.Add(1)
+			s.pushWorkers.submit(func() {
+				defer wg.Done()
+				userPlatform := &msggateway.SingleMsgToUserPlatform{RecvPlatFormID: int32(client.PlatformID)}
+				if err := frames.push(client); err != nil {
+					log.ZWarn(ctx, "online push failed", err, "userID", client.UserID, "platformID", client.PlatformID)
+					userPlatform.ResultCode = int64(servererrs.ErrPushMsgErr.Code())
+				} else if _, ok := s.pushTerminal[client.PlatformID]; !ok {
+					return
+				}
+				mu.Lock()
+				defer mu.Unlock()
+				if userPlatform.ResultCode == 0 {
+					result.OnlinePush = true
+				}
+				result.Resp = append(result.Resp, userPlatform)
+			})
+		}
+	}
+	wg.Wait()
+	return &msggateway.OnlineBatchPushOneMsgResp{SinglePushResult: results}, nil
+}
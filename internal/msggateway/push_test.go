@@ -0,0 +1,58 @@
+package msggateway
+
+import (
+	"bytes"
+	"sync"
+	"testing"
+	"time"
+)
+
+type recordConn struct {
+	LongConn
+	mu     sync.Mutex
+	frames [][]byte
+}
+
+func (c *recordConn) SetWriteDeadline(time.Duration) error { return nil }
+
+func (c *recordConn) WriteMessage(_ int, message []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.frames = append(c.frames, message)
+	return nil
+}
+
+func TestPushFramesEncodeOnce(t *testing.T) {
+	frames := newPushFrames(Resp{ReqIdentifier: WSPushMsg, MsgIncr: "1"})
+	conns := []*recordConn{{}, {}, {}}
+	for _, conn := range conns {
+		client := &Client{w: new(sync.Mutex), conn: conn, Encoding: JsonEncodingProtocol}
+		client.encoder, _ = getEncoder(JsonEncodingProtocol)
+		if err := frames.push(client); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(frames.frames) != 1 {
+		t.Fatalf("frames encoded %d times, want 1", len(frames.frames))
+	}
+	for _, conn := range conns {
+		if len(conn.frames) != 1 || !bytes.Equal(conn.frames[0], conns[0].frames[0]) {
+			t.Fatalf("conn frames %q, want %q", conn.frames, conns[0].frames)
+		}
+	}
+}
+
+func TestPushFramesSkipClosedConn(t *testing.T) {
+	frames := newPushFrames(Resp{ReqIdentifier: WSPushMsg, MsgIncr: "1"})
+	conn := &recordConn{}
+	client := &Client{w: new(sync.Mutex), conn: conn, Encoding: JsonEncodingProtocol}
+	client.encoder, _ = getEncoder(JsonEncodingProtocol)
+	// the connection is closed after the push is submitted to the workers
+	client.closed.Store(true)
+	if err := frames.push(client); err != ErrConnClosed {
+		t.Fatalf("push to a closed conn returned %v, want %v", err, ErrConnClosed)
+	}
+	if len(conn.frames) != 0 || len(frames.frames) != 0 {
+		t.Fatal("closed conn written")
+	}
+}
@@ -0,0 +1,440 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msggateway
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/redispubsub"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/gateway"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/protobuf/proto"
+)
+
+// resumeChannel is the redis channel telling the node of a resume token that its session was taken over by another node.
+const resumeChannel = "openim:msggateway:resume"
+
+// resumeTakeover is published by the node reached by a reconnection presenting the resume token of another node.
+type resumeTakeover struct {
+	Token      string `json:"token"`
+	UserID     string `json:"userID"`
+	PlatformID int    `json:"platformID"`
+}
+
+// resumeSession is the state of a resumable connection, it outlives the connection for the resume window
+// so that a reconnection presenting its token skips the offline status and gets the pushes it missed.
+type resumeSession struct {
+	token      string
+	userID     string
+	platformID int
+	// timer expires the session, it is guarded by the mutex of the sessionManager and non-nil while detached.
+	timer *time.Timer
+	// detachSeq tells the expiry of the current detachment from the stale ones.
+	detachSeq int
+	// takenOver is set when the user reconnects to another node with the token while the connection is still attached,
+	// the session is removed without going offline when the connection closes. It is guarded by the mutex of the sessionManager.
+	takenOver bool
+
+	mu sync.Mutex
+	// client is the attached connection, nil while detached.
+	client *Client
+	// ctx is the context of the last attached connection.
+	ctx *UserConnContext
+	// pushIncr is the msgIncr of the last push frame, pushes is the ring buffer of the last push frames,
+	// the frame of msgIncr i is at pushes[i%len(pushes)].
+	pushIncr int64
+	pushes   []Resp
+	// replaying is the client being replayed to, its pushes meanwhile are kept in pending and written after the replay.
+	replaying *Client
+	pending   []Resp
+}
+
+// addPush numbers the push frame with the next msgIncr and keeps it in the buffer, s.mu must be held.
+func (s *resumeSession) addPush(resp Resp) Resp {
+	s.pushIncr++
+	resp.MsgIncr = strconv.FormatInt(s.pushIncr, 10)
+	s.pushes[s.pushIncr%int64(len(s.pushes))] = resp
+	return resp
+}
+
+// missedPushes returns the buffered push frames after lastPushIncr, complete is false if some of them
+// have been overwritten in the buffer, s.mu must be held.
+func (s *resumeSession) missedPushes(lastPushIncr int64) (pushes []Resp, complete bool) {
+	first := max(lastPushIncr+1, s.pushIncr-int64(len(s.pushes))+1, 1)
+	for incr := first; incr <= s.pushIncr; incr++ {
+		pushes = append(pushes, s.pushes[incr%int64(len(s.pushes))])
+	}
+	return pushes, first <= lastPushIncr+1
+}
+
+// sessionManager keeps the resume sessions of the connections of this node.
+// The tokens start with the nodeID, so that the node reached by a reconnection can tell the node of the session.
+type sessionManager struct {
+	nodeID     string
+	window     time.Duration
+	bufferSize int
+	// onExpire is called when a detached session is not resumed within the window.
+	onExpire func(s *resumeSession)
+
+	mu       sync.Mutex
+	sessions map[string]*resumeSession
+	users    map[string][]*resumeSession
+}
+
+func newSessionManager(nodeID string, window time.Duration, bufferSize int, onExpire func(s *resumeSession)) *sessionManager {
+	return &sessionManager{
+		nodeID:     nodeID,
+		window:     window,
+		bufferSize: bufferSize,
+		onExpire:   onExpire,
+		sessions:   make(map[string]*resumeSession),
+		users:      make(map[string][]*resumeSession),
+	}
+}
+
+// create creates a session attached to nothing yet.
+func (m *sessionManager) create(userID string, platformID int) *resumeSession {
+	s := &resumeSession{
+		token:      m.nodeID + "." + newResumeToken(),
+		userID:     userID,
+		platformID: platformID,
+		pushes:     make([]Resp, m.bufferSize),
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.sessions[s.token] = s
+	m.users[userID] = append(m.users[userID], s)
+	return s
+}
+
+// take returns the session of token if it belongs to userID and platformID, and stops its expiry.
+func (m *sessionManager) take(token string, userID string, platformID int) *resumeSession {
+	if token == "" {
+		return nil
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	s, ok := m.sessions[token]
+	if !ok || s.userID != userID || s.platformID != platformID {
+		return nil
+	}
+	if s.timer != nil {
+		s.timer.Stop()
+		s.timer = nil
+	}
+	return s
+}
+
+// detach detaches client from its session when the connection is closed. If resumable, the session is kept for
+// the window, otherwise it is removed. It returns false if the client goes offline now.
+func (m *sessionManager) detach(s *resumeSession, client *Client, resumable bool) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.client != client {
+		// the session has been resumed by another connection
+		return true
+	}
+	s.client = nil
+	if s.takenOver {
+		// the user is online on another node
+		m.remove(s)
+		return true
+	}
+	if !resumable {
+		m.remove(s)
+		return false
+	}
+	s.detachSeq++
+	seq := s.detachSeq
+	s.timer = time.AfterFunc(m.window, func() { m.expire(s, seq) })
+	return true
+}
+
+func (m *sessionManager) expire(s *resumeSession, seq int) {
+	m.mu.Lock()
+	if s.timer == nil || s.detachSeq != seq {
+		m.mu.Unlock()
+		return
+	}
+	m.remove(s)
+	m.mu.Unlock()
+	m.onExpire(s)
+}
+
+// detachedSessions returns the detached sessions of userID.
+func (m *sessionManager) detachedSessions(userID string) []*resumeSession {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var sessions []*resumeSession
+	for _, s := range m.users[userID] {
+		if s.timer != nil {
+			sessions = append(sessions, s)
+		}
+	}
+	return sessions
+}
+
+// dropDetached removes the detached sessions of userID on platformID without expiring them,
+// it is used when the user connects again without resuming, so it must not go offline.
+func (m *sessionManager) dropDetached(userID string, platformID int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, s := range m.users[userID] {
+		if s.timer != nil && s.platformID == platformID {
+			m.remove(s)
+		}
+	}
+}
+
+// isRemote reports whether token is issued by another node.
+func (m *sessionManager) isRemote(token string) bool {
+	nodeID, _, ok := strings.Cut(token, ".")
+	return ok && nodeID != m.nodeID
+}
+
+// takeOver removes the session of token without expiring it, as the user has reconnected to another node with it.
+// If its connection is still attached, the session is removed when the connection closes.
+func (m *sessionManager) takeOver(token string, userID string, platformID int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	s, ok := m.sessions[token]
+	if !ok || s.userID != userID || s.platformID != platformID {
+		return
+	}
+	if s.timer != nil {
+		m.remove(s)
+		return
+	}
+	s.takenOver = true
+}
+
+// remove removes the session, m.mu must be held.
+func (m *sessionManager) remove(s *resumeSession) {
+	if s.timer != nil {
+		s.timer.Stop()
+		s.timer = nil
+	}
+	delete(m.sessions, s.token)
+	sessions := m.users[s.userID]
+	for i, session := range sessions {
+		if session == s {
+			sessions = append(sessions[:i:i], sessions[i+1:]...)
+			break
+		}
+	}
+	if len(sessions) == 0 {
+		delete(m.users, s.userID)
+	} else {
+		m.users[s.userID] = sessions
+	}
+}
+
+// attachSession attaches the client connected with resumable=true to its session, the session of resumeToken
+// is resumed and the missed pushes are replayed, otherwise a new session is created. It returns true if resumed.
+func (ws *WsServer) attachSession(client *Client) bool {
+	if !client.ctx.GetResumable() {
+		ws.sessions.dropDetached(client.UserID, client.PlatformID)
+		return false
+	}
+	token := client.ctx.GetResumeToken()
+	s := ws.sessions.take(token, client.UserID, client.PlatformID)
+	resumed := s != nil
+	if !resumed {
+		if ws.sessions.isRemote(token) {
+			// the missed pushes are on the node of the token, the client syncs them as a new connection
+			go ws.publishResumeTakeover(client.ctx, token, client.UserID, client.PlatformID)
+		}
+		ws.sessions.dropDetached(client.UserID, client.PlatformID)
+		s = ws.sessions.create(client.UserID, client.PlatformID)
+	}
+	client.session = s
+
+	// the pushes to the client wait for the replay, so they are in order
+	s.mu.Lock()
+	s.client = client
+	s.ctx = client.ctx
+	tips := &gateway.ResumeSessionTips{
+		ResumeToken:  s.token,
+		ResumeWindow: int64(ws.sessions.window / time.Second),
+		Resumed:      resumed,
+		Complete:     true,
+	}
+	var pushes []Resp
+	if resumed {
+		pushes, tips.Complete = s.missedPushes(client.ctx.GetLastPushIncr())
+		tips.ReplayedPushes = int32(len(pushes))
+	}
+	s.replaying = client
+	s.pending = nil
+	s.mu.Unlock()
+	// the writes may block, they are out of the event loop
+	go ws.replaySession(client, s, tips, pushes)
+	return resumed
+}
+
+// isReplaying reports whether the replay to client goes on, it stops once the connection is closed or another connection
+// has attached to the session. The pushes not replayed are kept in the buffer of the session for the next resumption.
+func (s *resumeSession) isReplaying(client *Client) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.replaying != client {
+		return false
+	}
+	if client.closed.Load() {
+		s.replaying = nil
+		s.pending = nil
+		return false
+	}
+	return true
+}
+
+// replaySession writes the tips and the missed pushes of the session to client,
+// then the pushes kept in pending meanwhile until there are none left.
+func (ws *WsServer) replaySession(client *Client, s *resumeSession, tips *gateway.ResumeSessionTips, pushes []Resp) {
+	if !s.isReplaying(client) {
+		return
+	}
+	data, err := proto.Marshal(tips)
+	if err != nil {
+		log.ZWarn(client.ctx, "marshal resume session tips failed", err)
+	} else if err := client.writeBinaryMsg(Resp{ReqIdentifier: WSResumeSession, OperationID: client.ctx.GetOperationID(), Data: data}); err != nil {
+		log.ZWarn(client.ctx, "write resume session tips failed", err)
+	}
+	log.ZDebug(client.ctx, "session attached", "resumed", tips.Resumed, "replayedPushes", len(pushes), "complete", tips.Complete)
+	for {
+		for _, resp := range pushes {
+			if !s.isReplaying(client) {
+				log.ZDebug(client.ctx, "replay stopped", "msgIncr", resp.MsgIncr)
+				return
+			}
+			if err := client.writeBinaryMsg(resp); err != nil {
+				log.ZWarn(client.ctx, "replay push failed", err, "msgIncr", resp.MsgIncr)
+			}
+		}
+		s.mu.Lock()
+		if s.replaying != client {
+			// another connection has attached to the session
+			s.mu.Unlock()
+			return
+		}
+		if len(s.pending) == 0 {
+			s.replaying = nil
+			s.mu.Unlock()
+			return
+		}
+		pushes, s.pending = s.pending, nil
+		s.mu.Unlock()
+	}
+}
+
+// sessionExpired sets the user of the session offline, which has been deferred when the connection dropped.
+func (ws *WsServer) sessionExpired(s *resumeSession) {
+	s.mu.Lock()
+	client := &Client{UserID: s.userID, PlatformID: s.platformID, ctx: s.ctx}
+	s.mu.Unlock()
+	ws.SetUserOnlineStatus(client.ctx, client, constant.Offline)
+	log.ZInfo(client.ctx, "user offline", "close reason", "resume window expired")
+}
+
+func (ws *WsServer) PushToDetachedSessions(ctx context.Context, userID string, msgData *sdkws.MsgData) {
+	if ws.sessions == nil {
+		return
+	}
+	sessions := ws.sessions.detachedSessions(userID)
+	if len(sessions) == 0 {
+		return
+	}
+	resp, err := newPushResp(ctx, msgData)
+	if err != nil {
+		log.ZWarn(ctx, "new push resp failed", err, "userID", userID)
+		return
+	}
+	for _, s := range sessions {
+		s.mu.Lock()
+		if s.client == nil {
+			s.addPush(resp)
+		}
+		s.mu.Unlock()
+	}
+}
+
+func (ws *WsServer) DropDetachedSessions(userID string, platformID int) {
+	if ws.sessions != nil {
+		ws.sessions.dropDetached(userID, platformID)
+	}
+}
+
+// SubscribeResumeTakeovers subscribes the takeovers of the resume sessions of this node published by the other nodes.
+func (ws *WsServer) SubscribeResumeTakeovers(ctx context.Context, rdb redis.UniversalClient) error {
+	if ws.sessions == nil {
+		return nil
+	}
+	ws.resumePublisher = redispubsub.NewPublisher(rdb, resumeChannel)
+	return redispubsub.NewSubscriber(rdb, resumeChannel).OnMessage(ctx, func(payload string) {
+		ctx := mcontext.SetOperationID(ctx, fmt.Sprintf("resume_%d_%d", os.Getpid(), time.Now().UnixMilli()))
+		ws.onResumeTakeover(ctx, payload)
+	})
+}
+
+func (ws *WsServer) onResumeTakeover(ctx context.Context, payload string) {
+	var takeover resumeTakeover
+	if err := json.Unmarshal([]byte(payload), &takeover); err != nil {
+		log.ZWarn(ctx, "unmarshal resume takeover failed", err)
+		return
+	}
+	if ws.sessions.isRemote(takeover.Token) {
+		return
+	}
+	ws.sessions.takeOver(takeover.Token, takeover.UserID, takeover.PlatformID)
+	log.ZDebug(ctx, "resume session taken over by another node", "userID", takeover.UserID, "platformID", takeover.PlatformID)
+}
+
+// publishResumeTakeover tells the node of token that the user has reconnected to this node,
+// so that the session there does not set the user offline when it expires.
+func (ws *WsServer) publishResumeTakeover(ctx context.Context, token string, userID string, platformID int) {
+	if ws.resumePublisher == nil {
+		return
+	}
+	data, err := json.Marshal(&resumeTakeover{Token: token, UserID: userID, PlatformID: platformID})
+	if err != nil {
+		log.ZWarn(ctx, "marshal resume takeover failed", errs.Wrap(err))
+		return
+	}
+	if err := ws.resumePublisher.Publish(string(data)); err != nil {
+		log.ZWarn(ctx, "publish resume takeover failed", err, "userID", userID, "platformID", platformID)
+	}
+}
+
+func newResumeToken() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
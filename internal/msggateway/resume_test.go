@@ -0,0 +1,230 @@
+package msggateway
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/gateway"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResumeSessionMissedPushes(t *testing.T) {
+	m := newSessionManager("node", time.Minute, 4, func(*resumeSession) {})
+	s := m.create("user", 1)
+	for i := 0; i < 6; i++ {
+		resp := s.addPush(Resp{ReqIdentifier: WSPushMsg})
+		assert.Equal(t, strconv.Itoa(i+1), resp.MsgIncr)
+	}
+
+	pushes, complete := s.missedPushes(4)
+	assert.True(t, complete)
+	assert.Equal(t, []string{"5", "6"}, pushMsgIncrs(pushes))
+
+	// 1 and 2 have been overwritten in the buffer
+	pushes, complete = s.missedPushes(0)
+	assert.False(t, complete)
+	assert.Equal(t, []string{"3", "4", "5", "6"}, pushMsgIncrs(pushes))
+
+	pushes, complete = s.missedPushes(6)
+	assert.True(t, complete)
+	assert.Empty(t, pushes)
+}
+
+func TestSessionManagerDetach(t *testing.T) {
+	expired := make(chan *resumeSession, 1)
+	m := newSessionManager("node", 50*time.Millisecond, 4, func(s *resumeSession) { expired <- s })
+	client := new(Client)
+	s := m.create("user", 1)
+	s.client = client
+
+	assert.True(t, m.detach(s, client, true))
+	assert.Nil(t, m.take(s.token, "user", 2))
+	assert.Equal(t, s, m.take(s.token, "user", 1))
+	select {
+	case <-expired:
+		t.Fatal("the taken session expired")
+	case <-time.After(100 * time.Millisecond):
+	}
+	s.client = client
+
+	assert.True(t, m.detach(s, client, true))
+	assert.Equal(t, []*resumeSession{s}, m.detachedSessions("user"))
+	select {
+	case e := <-expired:
+		assert.Equal(t, s, e)
+	case <-time.After(time.Second):
+		t.Fatal("the detached session did not expire")
+	}
+	assert.Nil(t, m.take(s.token, "user", 1))
+
+	s = m.create("user", 1)
+	s.client = client
+	assert.False(t, m.detach(s, client, false))
+	assert.Nil(t, m.take(s.token, "user", 1))
+}
+
+func TestSessionManagerTakeOver(t *testing.T) {
+	expired := make(chan *resumeSession, 2)
+	m := newSessionManager("node1", 50*time.Millisecond, 4, func(s *resumeSession) { expired <- s })
+	ws := &WsServer{sessions: m}
+	client := new(Client)
+
+	s := m.create("user", 1)
+	assert.False(t, m.isRemote(s.token))
+	assert.True(t, m.isRemote("node2."+newResumeToken()))
+	assert.False(t, m.isRemote(""))
+
+	// the detached session is removed at once
+	s.client = client
+	assert.True(t, m.detach(s, client, true))
+	ws.onResumeTakeover(context.Background(), fmt.Sprintf(`{"token":%q,"userID":"user","platformID":2}`, s.token))
+	assert.Equal(t, []*resumeSession{s}, m.detachedSessions("user"))
+	ws.onResumeTakeover(context.Background(), fmt.Sprintf(`{"token":%q,"userID":"user","platformID":1}`, s.token))
+	assert.Empty(t, m.detachedSessions("user"))
+	assert.Nil(t, m.take(s.token, "user", 1))
+
+	// the attached session is removed when its connection closes, the user does not go offline
+	s = m.create("user", 1)
+	s.client = client
+	ws.onResumeTakeover(context.Background(), fmt.Sprintf(`{"token":%q,"userID":"user","platformID":1}`, s.token))
+	assert.True(t, m.detach(s, client, true))
+	assert.Empty(t, m.detachedSessions("user"))
+	assert.Nil(t, m.take(s.token, "user", 1))
+
+	// the takeovers of the sessions of the other nodes are ignored
+	s = m.create("user", 1)
+	s.client = client
+	assert.True(t, m.detach(s, client, true))
+	token := strings.Replace(s.token, "node1.", "node2.", 1)
+	ws.onResumeTakeover(context.Background(), fmt.Sprintf(`{"token":%q,"userID":"user","platformID":1}`, token))
+	assert.Equal(t, []*resumeSession{s}, m.detachedSessions("user"))
+
+	select {
+	case e := <-expired:
+		assert.Equal(t, s, e)
+	case <-time.After(time.Second):
+		t.Fatal("the detached session did not expire")
+	}
+	select {
+	case e := <-expired:
+		t.Fatalf("the taken over session %s expired", e.token)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+// closingConn closes its client after limit frames, as if the connection dropped.
+type closingConn struct {
+	drainConn
+	client *Client
+	limit  int
+}
+
+func (c *closingConn) WriteMessage(messageType int, message []byte) error {
+	if err := c.drainConn.WriteMessage(messageType, message); err != nil {
+		return err
+	}
+	if len(c.frames) >= c.limit {
+		c.client.closed.Store(true)
+	}
+	return nil
+}
+
+func TestReplaySessionStopsOnClose(t *testing.T) {
+	ws := &WsServer{}
+	m := newSessionManager("node", time.Minute, 8, func(*resumeSession) {})
+	s := m.create("user", 1)
+	for i := 0; i < 4; i++ {
+		s.addPush(Resp{ReqIdentifier: WSPushMsg})
+	}
+	newClient := func(limit int) (*Client, *closingConn) {
+		conn := &closingConn{limit: limit}
+		client := &Client{w: new(sync.Mutex), conn: conn, Encoding: JsonEncodingProtocol, ctx: newTempContext(), session: s}
+		client.encoder, _ = getEncoder(JsonEncodingProtocol)
+		conn.client = client
+		return client, conn
+	}
+	replay := func(client *Client) {
+		s.mu.Lock()
+		s.client, s.replaying = client, client
+		pushes, _ := s.missedPushes(0)
+		s.mu.Unlock()
+		ws.replaySession(client, s, &gateway.ResumeSessionTips{Resumed: true}, pushes)
+	}
+
+	// the connection drops after the tips and the first push, the rest are not written to it
+	client, conn := newClient(2)
+	replay(client)
+	assert.Len(t, conn.frames, 2)
+	assert.Nil(t, s.replaying)
+
+	// the connection resuming the session gets all the missed pushes
+	client, conn = newClient(100)
+	replay(client)
+	assert.Len(t, conn.frames, 5)
+
+	// the replay to a connection replaced by another one writes nothing
+	client, conn = newClient(100)
+	s.replaying = new(Client)
+	ws.replaySession(client, s, &gateway.ResumeSessionTips{}, nil)
+	assert.Empty(t, conn.frames)
+}
+
+// writingConn signals each write before it blocks, the frames are copied as they are in the pooled buffers.
+type writingConn struct {
+	blockingConn
+	writing chan struct{}
+}
+
+func (c *writingConn) WriteMessage(messageType int, message []byte) error {
+	c.writing <- struct{}{}
+	return c.blockingConn.WriteMessage(messageType, append([]byte(nil), message...))
+}
+
+func TestPushRespWritesOutOfSessionLock(t *testing.T) {
+	m := newSessionManager("node", time.Minute, 8, func(*resumeSession) {})
+	s := m.create("user", 1)
+	conn := &writingConn{blockingConn: blockingConn{block: make(chan struct{})}, writing: make(chan struct{}, 2)}
+	client := &Client{w: new(sync.Mutex), conn: conn, Encoding: JsonEncodingProtocol, ctx: newTempContext(), session: s}
+	client.encoder, _ = getEncoder(JsonEncodingProtocol)
+	s.client = client
+
+	pushed := make(chan error, 2)
+	go func() { pushed <- client.pushResp(Resp{ReqIdentifier: WSPushMsg}) }()
+	<-conn.writing
+	go func() { pushed <- client.pushResp(Resp{ReqIdentifier: WSPushMsg}) }()
+
+	// the session is not locked by the blocked write, the detach of the connection goes on
+	locked := make(chan struct{})
+	go func() {
+		s.mu.Lock()
+		s.mu.Unlock()
+		close(locked)
+	}()
+	select {
+	case <-locked:
+	case <-time.After(time.Second):
+		t.Fatal("the session is locked by the blocked write")
+	}
+
+	close(conn.block)
+	assert.NoError(t, <-pushed)
+	assert.NoError(t, <-pushed)
+	// the frames are written in the order of their msgIncr
+	require.Len(t, conn.frames, 2)
+	assert.Contains(t, string(conn.frames[0]), `"msgIncr":"1"`)
+	assert.Contains(t, string(conn.frames[1]), `"msgIncr":"2"`)
+}
+
+func pushMsgIncrs(pushes []Resp) []string {
+	incrs := make([]string, 0, len(pushes))
+	for _, push := range pushes {
+		incrs = append(incrs, push.MsgIncr)
+	}
+	return incrs
+}
@@ -68,6 +68,24 @@ func (u *UserMap) Get(key string, platformID int) ([]*Client, bool, bool) {
 	return nil, userExisted, false
 }
 
+// Range calls f for each client until f returns false.
+func (u *UserMap) Range(f func(client *Client) bool) {
+	for i := range u.maps {
+		next := true
+		u.maps[i].Range(func(_, value any) bool {
+			for _, client := range value.([]*Client) {
+				if next = f(client); !next {
+					break
+				}
+			}
+			return next
+		})
+		if !next {
+			return
+		}
+	}
+}
+
 // Set adds a client to the map.
 func (u *UserMap) Set(key string, v *Client) {
 	sm := u.getMap(key)
@@ -50,12 +50,14 @@ type MsgTransfer struct {
 	historyCH *OnlineHistoryRedisConsumerHandler
 	//This consumer handle message to mongo
 	historyMongoCH *OnlineHistoryMongoConsumerHandler
+	msgRetry       *msgRetry
 	ctx            context.Context
 	cancel         context.CancelFunc
 }
 
 type Config struct {
 	MsgTransfer    config.MsgTransfer
+	MsgConfig      config.Msg
 	RedisConfig    config.Redis
 	MongodbConfig  config.Mongo
 	KafkaConfig    config.Kafka
@@ -91,19 +93,35 @@ func Start(ctx context.Context, index int, config *Config) error {
 	if err != nil {
 		return err
 	}
+	msgThreadModel, err := mgo.NewMsgThreadMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	threadDatabase := controller.NewMsgThreadDatabase(msgThreadModel, seqModel)
 	conversationRpcClient := rpcclient.NewConversationRpcClient(client, config.Share.RpcRegisterName.Conversation)
 	groupRpcClient := rpcclient.NewGroupRpcClient(client, config.Share.RpcRegisterName.Group)
-	historyCH, err := NewOnlineHistoryRedisConsumerHandler(&config.KafkaConfig, msgDatabase, &conversationRpcClient, &groupRpcClient)
+	msgRetryDeadLetterModel, err := mgo.NewMsgRetryDeadLetterMongo(mgocli.GetDB())
 	if err != nil {
 		return err
 	}
-	historyMongoCH, err := NewOnlineHistoryMongoConsumerHandler(&config.KafkaConfig, msgDatabase)
+	msgRetry := newMsgRetry(controller.NewMsgRetryDatabase(redis.NewMsgRetryCache(rdb), msgRetryDeadLetterModel))
+	historyCH, err := NewOnlineHistoryRedisConsumerHandler(&config.KafkaConfig, msgDatabase, threadDatabase, msgRetry, &conversationRpcClient, &groupRpcClient)
+	if err != nil {
+		return err
+	}
+	var msgRpcClient *rpcclient.MessageRpcClient
+	if config.MsgConfig.Search.Enable {
+		msgClient := rpcclient.NewMessageRpcClient(client, config.Share.RpcRegisterName.Msg)
+		msgRpcClient = &msgClient
+	}
+	historyMongoCH, err := NewOnlineHistoryMongoConsumerHandler(&config.KafkaConfig, msgDatabase, msgRpcClient, config.Share.IMAdminUserID[0], msgRetry)
 	if err != nil {
 		return err
 	}
 	msgTransfer := &MsgTransfer{
 		historyCH:      historyCH,
 		historyMongoCH: historyMongoCH,
+		msgRetry:       msgRetry,
 	}
 	return msgTransfer.Start(index, config)
 }
@@ -117,6 +135,7 @@ func (m *MsgTransfer) Start(index int, config *Config) error {
 
 	go m.historyCH.historyConsumerGroup.RegisterHandleAndConsumer(m.ctx, m.historyCH)
 	go m.historyMongoCH.historyConsumerGroup.RegisterHandleAndConsumer(m.ctx, m.historyMongoCH)
+	go m.msgRetry.start(m.ctx)
 	err := m.historyCH.redisMessageBatches.Start()
 	if err != nil {
 		return err
@@ -0,0 +1,162 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgtransfer
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/convert"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/prommetrics"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/utils/datautil"
+	"github.com/openimsdk/tools/utils/idutil"
+)
+
+const (
+	// msgRetryClaimCount is the max number of the retries claimed at a time.
+	msgRetryClaimCount = 100
+	// msgRetryLease is the time a claimed retry is held by the transfer node before it can be claimed again.
+	msgRetryLease        = 5 * time.Minute
+	msgRetryPollInterval = time.Second
+
+	msgRetryMaxAttempts    = 10
+	msgRetryInitialBackoff = 5 * time.Second
+	msgRetryMaxBackoff     = 10 * time.Minute
+)
+
+// msgRetryFunc processes the msgs of the conversation again.
+type msgRetryFunc func(ctx context.Context, conversationID string, msgs []*sdkws.MsgData) error
+
+// msgRetry retries the failed processing of the stored msgs out of the kafka consumers with exponential backoff,
+// so a failure does not block the partition, the ones failing the max attempts become dead letters.
+type msgRetry struct {
+	db       controller.MsgRetryDatabase
+	handlers map[string]msgRetryFunc
+}
+
+func newMsgRetry(db controller.MsgRetryDatabase) *msgRetry {
+	return &msgRetry{db: db, handlers: make(map[string]msgRetryFunc)}
+}
+
+// register sets the handler of the kind of tasks, it must be called before start.
+func (r *msgRetry) register(kind string, fn msgRetryFunc) {
+	r.handlers[kind] = fn
+}
+
+// backoff is the time to wait before the next attempt after failing attempts times.
+func (r *msgRetry) backoff(attempts int32) time.Duration {
+	backoff := msgRetryInitialBackoff
+	for i := int32(1); i < attempts && backoff < msgRetryMaxBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, msgRetryMaxBackoff)
+}
+
+// add schedules the retry of the kind of processing of the msgs failed by processErr at the first attempt.
+func (r *msgRetry) add(ctx context.Context, kind string, conversationID string, msgs []*sdkws.MsgData, processErr error) {
+	task := &model.MsgRetryTask{
+		TaskID:         idutil.GetMsgIDByMD5(conversationID),
+		Kind:           kind,
+		ConversationID: conversationID,
+		Msgs:           datautil.Slice(msgs, convert.MsgPb2DB),
+		Attempts:       1,
+		ErrMsg:         processErr.Error(),
+		OperationID:    mcontext.GetOperationID(ctx),
+		CreateTime:     time.Now(),
+	}
+	if _, err := r.fail(ctx, task); err != nil {
+		prommetrics.MsgRetryCounter.WithLabelValues(kind, "lost").Inc()
+		log.ZError(ctx, "schedule msg retry failed", err, "kind", kind, "conversationID", conversationID, "msgs", msgs)
+		return
+	}
+	log.ZWarn(ctx, "msg processing failed, retry later", processErr, "kind", kind, "conversationID", conversationID, "taskID", task.TaskID)
+}
+
+// fail reschedules the task after a failed attempt, or kills it if it failed the max attempts.
+func (r *msgRetry) fail(ctx context.Context, task *model.MsgRetryTask) (dead bool, err error) {
+	now := time.Now()
+	if task.Attempts >= msgRetryMaxAttempts {
+		task.DeadTime = now
+		return true, r.db.KillMsgRetry(ctx, task)
+	}
+	return false, r.db.ScheduleMsgRetry(ctx, task, now.Add(r.backoff(task.Attempts)))
+}
+
+func (r *msgRetry) start(ctx context.Context) {
+	ticker := time.NewTicker(msgRetryPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.retryDue(ctx)
+		}
+	}
+}
+
+// retryDue retries the due tasks until no task is due.
+func (r *msgRetry) retryDue(ctx context.Context) {
+	for {
+		tasks, err := r.db.ClaimDueMsgRetries(ctx, msgRetryClaimCount, msgRetryLease)
+		if err != nil {
+			log.ZError(ctx, "claim msg retries failed", err)
+			return
+		}
+		for _, task := range tasks {
+			r.retry(task)
+		}
+		if len(tasks) < msgRetryClaimCount {
+			return
+		}
+	}
+}
+
+func (r *msgRetry) retry(task *model.MsgRetryTask) {
+	ctx := mcontext.SetOperationID(context.Background(), task.OperationID)
+	fn, ok := r.handlers[task.Kind]
+	if !ok {
+		// the task is left for a node handling the kind
+		log.ZWarn(ctx, "no handler of the msg retry", nil, "kind", task.Kind, "taskID", task.TaskID)
+		return
+	}
+	err := fn(ctx, task.ConversationID, datautil.Slice(task.Msgs, convert.MsgDB2Pb))
+	if err == nil {
+		prommetrics.MsgRetryCounter.WithLabelValues(task.Kind, "success").Inc()
+		if err := r.db.FinishMsgRetry(ctx, task.TaskID); err != nil {
+			log.ZError(ctx, "finish msg retry failed", err, "taskID", task.TaskID)
+		}
+		return
+	}
+	task.Attempts++
+	task.ErrMsg = err.Error()
+	dead, err := r.fail(ctx, task)
+	if err != nil {
+		log.ZError(ctx, "reschedule msg retry failed", err, "taskID", task.TaskID, "attempts", task.Attempts)
+		return
+	}
+	if dead {
+		prommetrics.MsgRetryCounter.WithLabelValues(task.Kind, "dead").Inc()
+		log.ZError(ctx, "msg retry dead", nil, "kind", task.Kind, "taskID", task.TaskID, "conversationID", task.ConversationID, "errMsg", task.ErrMsg)
+		return
+	}
+	prommetrics.MsgRetryCounter.WithLabelValues(task.Kind, "failed").Inc()
+	log.ZInfo(ctx, "msg retry failed", "kind", task.Kind, "taskID", task.TaskID, "attempts", task.Attempts, "errMsg", task.ErrMsg)
+}
@@ -0,0 +1,128 @@
+package msgtransfer
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/protocol/sdkws"
+)
+
+type memMsgRetryDatabase struct {
+	controller.MsgRetryDatabase
+	queue map[string]*model.MsgRetryTask
+	dead  map[string]*model.MsgRetryTask
+}
+
+func newMemMsgRetryDatabase() *memMsgRetryDatabase {
+	return &memMsgRetryDatabase{queue: make(map[string]*model.MsgRetryTask), dead: make(map[string]*model.MsgRetryTask)}
+}
+
+func (m *memMsgRetryDatabase) ScheduleMsgRetry(_ context.Context, task *model.MsgRetryTask, _ time.Time) error {
+	m.queue[task.TaskID] = task
+	return nil
+}
+
+func (m *memMsgRetryDatabase) FinishMsgRetry(_ context.Context, taskID string) error {
+	delete(m.queue, taskID)
+	return nil
+}
+
+func (m *memMsgRetryDatabase) KillMsgRetry(_ context.Context, task *model.MsgRetryTask) error {
+	delete(m.queue, task.TaskID)
+	m.dead[task.TaskID] = task
+	return nil
+}
+
+func TestMsgRetryBackoff(t *testing.T) {
+	r := newMsgRetry(nil)
+	expected := []time.Duration{5 * time.Second, 10 * time.Second, 20 * time.Second, 40 * time.Second}
+	for i, backoff := range expected {
+		if got := r.backoff(int32(i + 1)); got != backoff {
+			t.Errorf("backoff(%d) = %s, want %s", i+1, got, backoff)
+		}
+	}
+	if got := r.backoff(msgRetryMaxAttempts); got != msgRetryMaxBackoff {
+		t.Errorf("backoff(%d) = %s, want %s", msgRetryMaxAttempts, got, msgRetryMaxBackoff)
+	}
+}
+
+func TestMsgRetry(t *testing.T) {
+	db := newMemMsgRetryDatabase()
+	r := newMsgRetry(db)
+	var retried [][]*sdkws.MsgData
+	fail := true
+	r.register(model.MsgRetryThread, func(ctx context.Context, conversationID string, msgs []*sdkws.MsgData) error {
+		if conversationID != "si_a_b" {
+			t.Fatalf("conversationID %s", conversationID)
+		}
+		retried = append(retried, msgs)
+		if fail {
+			return errors.New("mongo unavailable")
+		}
+		return nil
+	})
+	ctx := context.Background()
+	msgs := []*sdkws.MsgData{{ServerMsgID: "reply1", Seq: 10}, {ServerMsgID: "reply2", Seq: 11}}
+	r.add(ctx, model.MsgRetryThread, "si_a_b", msgs, errors.New("mongo unavailable"))
+	if len(db.queue) != 1 {
+		t.Fatalf("queued %d tasks, want 1", len(db.queue))
+	}
+	for _, task := range db.queue {
+		r.retry(task)
+		if task.Attempts != 2 || len(db.queue) != 1 {
+			t.Fatalf("attempts %d queued %d, want 2 1", task.Attempts, len(db.queue))
+		}
+		fail = false
+		r.retry(task)
+	}
+	if len(db.queue) != 0 || len(db.dead) != 0 {
+		t.Fatalf("queued %d dead %d, want 0 0", len(db.queue), len(db.dead))
+	}
+	if len(retried) != 2 || len(retried[1]) != 2 || retried[1][0].ServerMsgID != "reply1" || retried[1][1].Seq != 11 {
+		t.Fatalf("retried %v", retried)
+	}
+}
+
+func TestMsgRetryDead(t *testing.T) {
+	db := newMemMsgRetryDatabase()
+	r := newMsgRetry(db)
+	var retries int
+	r.register(model.MsgRetryThread, func(ctx context.Context, conversationID string, msgs []*sdkws.MsgData) error {
+		retries++
+		return errors.New("mongo unavailable")
+	})
+	ctx := context.Background()
+	r.add(ctx, model.MsgRetryThread, "si_a_b", []*sdkws.MsgData{{ServerMsgID: "reply1"}}, errors.New("mongo unavailable"))
+	for _, task := range db.queue {
+		for len(db.queue) > 0 {
+			r.retry(task)
+		}
+	}
+	if retries != msgRetryMaxAttempts-1 || len(db.dead) != 1 {
+		t.Fatalf("retries %d dead %d, want %d 1", retries, len(db.dead), msgRetryMaxAttempts-1)
+	}
+	for _, task := range db.dead {
+		if task.Attempts != msgRetryMaxAttempts || task.DeadTime.IsZero() || task.ErrMsg == "" {
+			t.Fatalf("dead task attempts %d deadTime %s errMsg %s", task.Attempts, task.DeadTime, task.ErrMsg)
+		}
+	}
+}
+
+func TestMsgRetryUnknownKind(t *testing.T) {
+	db := newMemMsgRetryDatabase()
+	r := newMsgRetry(db)
+	r.add(context.Background(), "unknown", "si_a_b", []*sdkws.MsgData{{ServerMsgID: "reply1"}}, errors.New("failed"))
+	for _, task := range db.queue {
+		r.retry(task)
+		if task.Attempts != 1 {
+			t.Fatalf("attempts %d, the task of an unknown kind must be left to the lease", task.Attempts)
+		}
+	}
+	if len(db.queue) != 1 || len(db.dead) != 0 {
+		t.Fatalf("queued %d dead %d, want 1 0", len(db.queue), len(db.dead))
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/go-redis/redis"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
 	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
 	"github.com/openimsdk/open-im-server/v3/pkg/tools/batcher"
@@ -29,6 +30,7 @@ import (
 	"github.com/openimsdk/tools/log"
 	"github.com/openimsdk/tools/mcontext"
 	"github.com/openimsdk/tools/mq/kafka"
+	"github.com/openimsdk/tools/utils/datautil"
 	"github.com/openimsdk/tools/utils/stringutil"
 	"google.golang.org/protobuf/proto"
 	"strconv"
@@ -55,11 +57,13 @@ type OnlineHistoryRedisConsumerHandler struct {
 	redisMessageBatches *batcher.Batcher[sarama.ConsumerMessage]
 
 	msgDatabase           controller.CommonMsgDatabase
+	threadDatabase        controller.MsgThreadDatabase
+	msgRetry              *msgRetry
 	conversationRpcClient *rpcclient.ConversationRpcClient
 	groupRpcClient        *rpcclient.GroupRpcClient
 }
 
-func NewOnlineHistoryRedisConsumerHandler(kafkaConf *config.Kafka, database controller.CommonMsgDatabase,
+func NewOnlineHistoryRedisConsumerHandler(kafkaConf *config.Kafka, database controller.CommonMsgDatabase, threadDatabase controller.MsgThreadDatabase, msgRetry *msgRetry,
 	conversationRpcClient *rpcclient.ConversationRpcClient, groupRpcClient *rpcclient.GroupRpcClient) (*OnlineHistoryRedisConsumerHandler, error) {
 	historyConsumerGroup, err := kafka.NewMConsumerGroup(kafkaConf.Build(), kafkaConf.ToRedisGroupID, []string{kafkaConf.ToRedisTopic}, false)
 	if err != nil {
@@ -67,6 +71,9 @@ func NewOnlineHistoryRedisConsumerHandler(kafkaConf *config.Kafka, database cont
 	}
 	var och OnlineHistoryRedisConsumerHandler
 	och.msgDatabase = database
+	och.threadDatabase = threadDatabase
+	och.msgRetry = msgRetry
+	msgRetry.register(model.MsgRetryThread, threadDatabase.BatchInsertThreadReplies)
 
 	b := batcher.New[sarama.ConsumerMessage](
 		batcher.WithSize(size),
@@ -219,6 +226,8 @@ func (och *OnlineHistoryRedisConsumerHandler) handleMsg(ctx context.Context, key
 			}
 		}
 
+		och.insertThreadReplies(ctx, conversationID, storageMessageList)
+
 		log.ZDebug(ctx, "success incr to next topic")
 		err = och.msgDatabase.MsgToMongoMQ(ctx, key, conversationID, storageMessageList, lastSeq)
 		if err != nil {
@@ -229,6 +238,20 @@ func (och *OnlineHistoryRedisConsumerHandler) handleMsg(ctx context.Context, key
 	}
 }
 
+// insertThreadReplies indexes the thread replies of the msgs. A failed insert is requeued to the msg retry
+// instead of blocking the partition, as the insert is idempotent.
+func (och *OnlineHistoryRedisConsumerHandler) insertThreadReplies(ctx context.Context, conversationID string, msgs []*sdkws.MsgData) {
+	replies := datautil.Filter(msgs, func(msg *sdkws.MsgData) (*sdkws.MsgData, bool) {
+		return msg, msgprocessor.GetThreadRootServerMsgID(msg) != ""
+	})
+	if len(replies) == 0 {
+		return
+	}
+	if err := och.threadDatabase.BatchInsertThreadReplies(ctx, conversationID, replies); err != nil {
+		och.msgRetry.add(ctx, model.MsgRetryThread, conversationID, replies, err)
+	}
+}
+
 func (och *OnlineHistoryRedisConsumerHandler) handleNotification(ctx context.Context, key, conversationID string,
 	storageList, notStorageList []*ContextMsg) {
 	och.toPushTopic(ctx, key, conversationID, notStorageList)
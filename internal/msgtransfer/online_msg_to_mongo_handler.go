@@ -21,8 +21,12 @@ import (
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/prommetrics"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
 	pbmsg "github.com/openimsdk/protocol/msg"
+	"github.com/openimsdk/protocol/sdkws"
 	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/mcontext"
 	"github.com/openimsdk/tools/mq/kafka"
 	"google.golang.org/protobuf/proto"
 )
@@ -30,9 +34,14 @@ import (
 type OnlineHistoryMongoConsumerHandler struct {
 	historyConsumerGroup *kafka.MConsumerGroup
 	msgDatabase          controller.CommonMsgDatabase
+	// msgRpcClient indexes the msgs for search, nil if the search index is disabled
+	msgRpcClient *rpcclient.MessageRpcClient
+	// imAdminUserID is the op user of IndexMsgs, which only the app manager can call
+	imAdminUserID string
+	msgRetry      *msgRetry
 }
 
-func NewOnlineHistoryMongoConsumerHandler(kafkaConf *config.Kafka, database controller.CommonMsgDatabase) (*OnlineHistoryMongoConsumerHandler, error) {
+func NewOnlineHistoryMongoConsumerHandler(kafkaConf *config.Kafka, database controller.CommonMsgDatabase, msgRpcClient *rpcclient.MessageRpcClient, imAdminUserID string, msgRetry *msgRetry) (*OnlineHistoryMongoConsumerHandler, error) {
 	historyConsumerGroup, err := kafka.NewMConsumerGroup(kafkaConf.Build(), kafkaConf.ToMongoGroupID, []string{kafkaConf.ToMongoTopic}, true)
 	if err != nil {
 		return nil, err
@@ -41,10 +50,21 @@ func NewOnlineHistoryMongoConsumerHandler(kafkaConf *config.Kafka, database cont
 	mc := &OnlineHistoryMongoConsumerHandler{
 		historyConsumerGroup: historyConsumerGroup,
 		msgDatabase:          database,
+		msgRpcClient:         msgRpcClient,
+		imAdminUserID:        imAdminUserID,
+		msgRetry:             msgRetry,
+	}
+	if msgRpcClient != nil {
+		msgRetry.register(model.MsgRetryIndex, mc.indexMsgs)
 	}
 	return mc, nil
 }
 
+// indexMsgs indexes the msgs for search by the msg rpc instance holding the index.
+func (mc *OnlineHistoryMongoConsumerHandler) indexMsgs(ctx context.Context, conversationID string, msgs []*sdkws.MsgData) error {
+	return mc.msgRpcClient.IndexMsgs(mcontext.SetOpUserID(ctx, mc.imAdminUserID), conversationID, msgs)
+}
+
 func (mc *OnlineHistoryMongoConsumerHandler) handleChatWs2Mongo(ctx context.Context, cMsg *sarama.ConsumerMessage, key string, session sarama.ConsumerGroupSession) {
 	msg := cMsg.Value
 	msgFromMQ := pbmsg.MsgDataToMongoByMQ{}
@@ -72,6 +92,12 @@ func (mc *OnlineHistoryMongoConsumerHandler) handleChatWs2Mongo(ctx context.Cont
 		prommetrics.MsgInsertMongoFailedCounter.Inc()
 	} else {
 		prommetrics.MsgInsertMongoSuccessCounter.Inc()
+		if mc.msgRpcClient != nil {
+			// the msgs are indexed again later, e.g. while the lease of the index is changing hands
+			if err := mc.indexMsgs(ctx, msgFromMQ.ConversationID, msgFromMQ.MsgData); err != nil {
+				mc.msgRetry.add(ctx, model.MsgRetryIndex, msgFromMQ.ConversationID, msgFromMQ.MsgData, err)
+			}
+		}
 	}
 	var seqs []int64
 	for _, msg := range msgFromMQ.MsgData {
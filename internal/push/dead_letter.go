@@ -0,0 +1,69 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package push
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/convert"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/pushext"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+func (p pushServer) GetOfflinePushDeadLetters(ctx context.Context, req *pushext.GetOfflinePushDeadLettersReq) (*pushext.GetOfflinePushDeadLettersResp, error) {
+	if !authverify.IsAppManagerUid(ctx, p.config.Share.IMAdminUserID) {
+		return nil, errs.ErrNoPermission.WrapMsg("only app manager")
+	}
+	total, deadLetters, err := p.retryDatabase.SearchPushDeadLetters(ctx, req.UserID, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &pushext.GetOfflinePushDeadLettersResp{
+		Total:       total,
+		DeadLetters: datautil.Slice(deadLetters, convertDeadLetter),
+	}, nil
+}
+
+func (p pushServer) ReplayOfflinePushDeadLetters(ctx context.Context, req *pushext.ReplayOfflinePushDeadLettersReq) (*pushext.ReplayOfflinePushDeadLettersResp, error) {
+	if !authverify.IsAppManagerUid(ctx, p.config.Share.IMAdminUserID) {
+		return nil, errs.ErrNoPermission.WrapMsg("only app manager")
+	}
+	if !p.config.RpcConfig.Retry.Enable {
+		return nil, errs.ErrArgs.WrapMsg("offline push retry is disabled")
+	}
+	tasks, err := p.retryDatabase.ReplayPushDeadLetters(ctx, req.TaskIDs)
+	if err != nil {
+		return nil, err
+	}
+	return &pushext.ReplayOfflinePushDeadLettersResp{
+		TaskIDs: datautil.Slice(tasks, func(task *model.OfflinePushTask) string { return task.TaskID }),
+	}, nil
+}
+
+func convertDeadLetter(task *model.OfflinePushTask) *pushext.OfflinePushDeadLetter {
+	return &pushext.OfflinePushDeadLetter{
+		TaskID:     task.TaskID,
+		UserIDs:    task.UserIDs,
+		MsgData:    convert.MsgDB2Pb(task.Msg),
+		Attempts:   task.Attempts,
+		ErrMsg:     task.ErrMsg,
+		CreateTime: task.CreateTime.UnixMilli(),
+		DeadTime:   task.DeadTime.UnixMilli(),
+		Summary:    task.Summary,
+	}
+}
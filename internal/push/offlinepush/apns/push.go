@@ -0,0 +1,323 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apns
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush/options"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+	"github.com/redis/go-redis/v9"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	productionEndpoint = "https://api.push.apple.com"
+	sandboxEndpoint    = "https://api.sandbox.push.apple.com"
+	// tokenRefreshInterval is how often the provider token is signed again,
+	// Apple rejects tokens older than an hour and refreshing more than every 20 minutes.
+	tokenRefreshInterval = 50 * time.Minute
+	// concurrentRequest is the max number of the concurrent requests of a push, they share the http/2 connection.
+	concurrentRequest = 20
+	requestTimeout    = 10 * time.Second
+)
+
+// Terminal are the platforms whose push tokens are apns device tokens.
+var Terminal = []int{constant.IOSPlatformID, constant.IPadPlatformID}
+
+// APNs pushes to apple devices by the http/2 api of apple with token-based authentication.
+type APNs struct {
+	cache      cache.ThirdCache
+	httpClient *http.Client
+	endpoint   string
+	topic      string
+	keyID      string
+	teamID     string
+	key        *ecdsa.PrivateKey
+	sound      string
+	badgeCount bool
+
+	mu          sync.Mutex
+	bearer      string
+	bearerIssue time.Time
+}
+
+// NewClient reads the .p8 signing key, whose path is relative to the config directory.
+func NewClient(pushConf *config.Push, cache cache.ThirdCache, configPath string) (*APNs, error) {
+	conf := pushConf.APNs
+	if conf.KeyFilePath == "" || conf.KeyID == "" || conf.TeamID == "" || conf.Topic == "" {
+		return nil, errs.New("apns keyFilePath, keyID, teamID and topic are required").Wrap()
+	}
+	keyPath := conf.KeyFilePath
+	if !filepath.IsAbs(keyPath) {
+		keyPath = filepath.Join(configPath, keyPath)
+	}
+	data, err := os.ReadFile(keyPath)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "read apns key failed", "keyFilePath", keyPath)
+	}
+	key, err := jwt.ParseECPrivateKeyFromPEM(data)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "parse apns key failed", "keyFilePath", keyPath)
+	}
+	endpoint := conf.Endpoint
+	if endpoint == "" {
+		endpoint = sandboxEndpoint
+		if pushConf.IOSPush.Production {
+			endpoint = productionEndpoint
+		}
+	}
+	return &APNs{
+		cache:      cache,
+		httpClient: &http.Client{Timeout: requestTimeout},
+		endpoint:   strings.TrimSuffix(endpoint, "/"),
+		topic:      conf.Topic,
+		keyID:      conf.KeyID,
+		teamID:     conf.TeamID,
+		key:        key,
+		sound:      pushConf.IOSPush.PushSound,
+		badgeCount: pushConf.IOSPush.BadgeCount,
+	}, nil
+}
+
+type alert struct {
+	Title string `json:"title,omitempty"`
+	Body  string `json:"body,omitempty"`
+}
+
+type aps struct {
+	Alert alert  `json:"alert"`
+	Sound string `json:"sound,omitempty"`
+	Badge *int   `json:"badge,omitempty"`
+}
+
+type payload struct {
+	Aps aps    `json:"aps"`
+	Ex  string `json:"ex,omitempty"`
+}
+
+type errorResponse struct {
+	Reason string `json:"reason"`
+}
+
+func (a *APNs) Push(ctx context.Context, userIDs []string, title, content string, opts *options.Opts) error {
+	sound := opts.IOSPushSound
+	if sound == "" {
+		sound = a.sound
+	}
+	var (
+		mu   sync.Mutex
+		fail int
+		errB strings.Builder
+		// retryUserIDs are the users failed by the errors other than the permanent ones
+		retryUserIDs []string
+	)
+	var g errgroup.Group
+	g.SetLimit(concurrentRequest)
+	for _, userID := range userIDs {
+		tokens := a.deviceTokens(ctx, userID)
+		if len(tokens) == 0 {
+			continue
+		}
+		p := payload{Aps: aps{Alert: alert{Title: title, Body: content}, Sound: sound}, Ex: opts.Ex}
+		if a.badgeCount {
+			badge, err := a.badge(ctx, userID, opts.IOSBadgeCount)
+			if err != nil {
+				log.ZWarn(ctx, "get apns badge failed", err, "userID", userID)
+			} else {
+				p.Aps.Badge = &badge
+			}
+		}
+		body, err := json.Marshal(p)
+		if err != nil {
+			return errs.Wrap(err)
+		}
+		for platformID, token := range tokens {
+			userID, platformID, token := userID, platformID, token
+			g.Go(func() error {
+				if permanent, err := a.send(ctx, userID, platformID, token, body, opts.CollapseID); err != nil {
+					mu.Lock()
+					fail++
+					errB.WriteString(err.Error())
+					errB.WriteByte('.')
+					if !permanent {
+						retryUserIDs = append(retryUserIDs, userID)
+					}
+					mu.Unlock()
+				}
+				return nil
+			})
+		}
+	}
+	_ = g.Wait()
+	if fail != 0 {
+		return &options.PushError{
+			RetryUserIDs: datautil.Distinct(retryUserIDs),
+			Err:          errs.New(fmt.Sprintf("%d message send failed;err:%s", fail, errB.String())).Wrap(),
+		}
+	}
+	return nil
+}
+
+// deviceTokens returns the push tokens of the apple platforms of the user.
+func (a *APNs) deviceTokens(ctx context.Context, userID string) map[int]string {
+	tokens := make(map[int]string)
+	for _, platformID := range Terminal {
+		token, err := a.cache.GetFcmToken(ctx, userID, platformID)
+		if err != nil {
+			if errs.Unwrap(err) != redis.Nil {
+				log.ZWarn(ctx, "get apns device token failed", err, "userID", userID, "platformID", platformID)
+			}
+			continue
+		}
+		if token != "" {
+			tokens[platformID] = token
+		}
+	}
+	return tokens
+}
+
+// badge returns the unread count of the user, it is increased by the push if incr.
+func (a *APNs) badge(ctx context.Context, userID string, incr bool) (int, error) {
+	if incr {
+		return a.cache.IncrUserBadgeUnreadCountSum(ctx, userID)
+	}
+	count, err := a.cache.GetUserBadgeUnreadCountSum(ctx, userID)
+	if err != nil && errs.Unwrap(err) != redis.Nil {
+		return 0, err
+	}
+	return max(count, 1), nil
+}
+
+// send pushes the device of the token, permanent is true if the push would fail again by retrying.
+// The push rejected for the provider token is sent once again with a token signed again.
+func (a *APNs) send(ctx context.Context, userID string, platformID int, token string, body []byte,
+	collapseID string) (permanent bool, err error) {
+	status, reason, err := a.post(ctx, token, body, collapseID)
+	if err != nil {
+		return false, err
+	}
+	if isInvalidProviderToken(status, reason) {
+		log.ZWarn(ctx, "apns provider token rejected, sign it again", nil, "reason", reason)
+		status, reason, err = a.post(ctx, token, body, collapseID)
+		if err != nil {
+			return false, err
+		}
+	}
+	if status == http.StatusOK {
+		return false, nil
+	}
+	permanent = isInvalidToken(status, reason)
+	if permanent {
+		log.ZInfo(ctx, "remove invalid apns device token", "userID", userID, "platformID", platformID, "reason", reason)
+		if err := a.cache.DelFcmToken(ctx, userID, platformID); err != nil {
+			log.ZWarn(ctx, "remove invalid apns device token failed", err, "userID", userID, "platformID", platformID)
+		}
+	}
+	// the bad requests fail again with the same payload
+	permanent = permanent || status == http.StatusBadRequest
+	return permanent, errs.New("apns push failed", "userID", userID, "status", status, "reason", reason).Wrap()
+}
+
+// post sends the push request, the provider token is dropped if apple rejects it, so the next request signs it again.
+func (a *APNs) post(ctx context.Context, token string, body []byte, collapseID string) (status int, reason string, err error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, a.endpoint+"/3/device/"+token, bytes.NewReader(body))
+	if err != nil {
+		return 0, "", errs.Wrap(err)
+	}
+	bearer, err := a.providerToken()
+	if err != nil {
+		return 0, "", err
+	}
+	req.Header.Set("authorization", "bearer "+bearer)
+	req.Header.Set("apns-topic", a.topic)
+	req.Header.Set("apns-push-type", "alert")
+	if collapseID != "" {
+		req.Header.Set("apns-collapse-id", collapseID)
+	}
+	resp, err := a.httpClient.Do(req)
+	if err != nil {
+		return 0, "", errs.Wrap(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusOK {
+		return resp.StatusCode, "", nil
+	}
+	var errResp errorResponse
+	data, _ := io.ReadAll(resp.Body)
+	_ = json.Unmarshal(data, &errResp)
+	if isInvalidProviderToken(resp.StatusCode, errResp.Reason) {
+		a.dropProviderToken(bearer)
+	}
+	return resp.StatusCode, errResp.Reason, nil
+}
+
+// isInvalidToken returns true if apple reports that the device token will never be valid again.
+func isInvalidToken(status int, reason string) bool {
+	switch reason {
+	case "Unregistered", "BadDeviceToken", "DeviceTokenNotForTopic", "ExpiredToken":
+		return true
+	}
+	return status == http.StatusGone
+}
+
+// isInvalidProviderToken returns true if apple rejects the provider token, e.g. after a clock skew or a key rotation.
+func isInvalidProviderToken(status int, reason string) bool {
+	return status == http.StatusForbidden && (reason == "ExpiredProviderToken" || reason == "InvalidProviderToken")
+}
+
+// dropProviderToken drops the rejected provider token unless it has been signed again by another request.
+func (a *APNs) dropProviderToken(bearer string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.bearer == bearer {
+		a.bearer = ""
+	}
+}
+
+// providerToken returns the signed jwt of the key, it is reused until tokenRefreshInterval.
+func (a *APNs) providerToken() (string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	now := time.Now()
+	if a.bearer != "" && now.Sub(a.bearerIssue) < tokenRefreshInterval {
+		return a.bearer, nil
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.MapClaims{"iss": a.teamID, "iat": now.Unix()})
+	token.Header["kid"] = a.keyID
+	bearer, err := token.SignedString(a.key)
+	if err != nil {
+		return "", errs.WrapMsg(err, "sign apns provider token failed")
+	}
+	a.bearer, a.bearerIssue = bearer, now
+	return bearer, nil
+}
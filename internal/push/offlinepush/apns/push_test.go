@@ -0,0 +1,200 @@
+package apns
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush/options"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/redis/go-redis/v9"
+)
+
+type tokenCache struct {
+	cache.ThirdCache
+	mu     sync.Mutex
+	tokens map[string]string
+	badge  int
+}
+
+func tokenKey(userID string, platformID int) string {
+	return userID + ":" + constant.PlatformIDToName(platformID)
+}
+
+func (c *tokenCache) GetFcmToken(_ context.Context, account string, platformID int) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	token, ok := c.tokens[tokenKey(account, platformID)]
+	if !ok {
+		return "", redis.Nil
+	}
+	return token, nil
+}
+
+func (c *tokenCache) DelFcmToken(_ context.Context, account string, platformID int) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.tokens, tokenKey(account, platformID))
+	return nil
+}
+
+func (c *tokenCache) IncrUserBadgeUnreadCountSum(context.Context, string) (int, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.badge++
+	return c.badge, nil
+}
+
+// writeTestKey writes the signing key to key.p8 of the returned dir.
+func writeTestKey(t *testing.T) (*ecdsa.PrivateKey, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "key.p8"), pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return key, dir
+}
+
+func TestPush(t *testing.T) {
+	key, dir := writeTestKey(t)
+
+	var (
+		mu       sync.Mutex
+		payloads = make(map[string]payload)
+	)
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.ProtoMajor != 2 {
+			t.Errorf("proto %s, want http/2", r.Proto)
+		}
+		bearer := strings.TrimPrefix(r.Header.Get("authorization"), "bearer ")
+		if _, err := jwt.Parse(bearer, func(*jwt.Token) (any, error) { return &key.PublicKey, nil }); err != nil {
+			t.Errorf("invalid provider token: %v", err)
+		}
+		if topic := r.Header.Get("apns-topic"); topic != "com.example.app" {
+			t.Errorf("apns-topic %q", topic)
+		}
+		if collapseID := r.Header.Get("apns-collapse-id"); collapseID != "clientMsgID" {
+			t.Errorf("apns-collapse-id %q", collapseID)
+		}
+		token := strings.TrimPrefix(r.URL.Path, "/3/device/")
+		if token == "invalid" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"reason":"BadDeviceToken"}`))
+			return
+		}
+		var p payload
+		if err := json.NewDecoder(r.Body).Decode(&p); err != nil {
+			t.Error(err)
+		}
+		mu.Lock()
+		payloads[token] = p
+		mu.Unlock()
+	}))
+	server.EnableHTTP2 = true
+	server.StartTLS()
+	defer server.Close()
+
+	var pushConf config.Push
+	pushConf.APNs.KeyFilePath = "key.p8"
+	pushConf.APNs.KeyID = "keyID"
+	pushConf.APNs.TeamID = "teamID"
+	pushConf.APNs.Topic = "com.example.app"
+	pushConf.APNs.Endpoint = server.URL
+	pushConf.IOSPush.PushSound = "default"
+	pushConf.IOSPush.BadgeCount = true
+	tokens := &tokenCache{tokens: map[string]string{
+		tokenKey("user1", constant.IOSPlatformID): "valid",
+		tokenKey("user2", constant.IOSPlatformID): "invalid",
+	}}
+	client, err := NewClient(&pushConf, tokens, dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.httpClient = server.Client()
+
+	err = client.Push(context.Background(), []string{"user1", "user2", "user3"}, "title", "content",
+		&options.Opts{IOSBadgeCount: true, CollapseID: "clientMsgID"})
+	if err == nil {
+		t.Fatal("the push to the invalid token must fail")
+	}
+	if userIDs := options.RetryUserIDs(err, nil); len(userIDs) != 0 {
+		t.Fatalf("retry the push to the invalid token for %v", userIDs)
+	}
+	p, ok := payloads["valid"]
+	if !ok {
+		t.Fatal("the valid token is not pushed")
+	}
+	if p.Aps.Alert.Title != "title" || p.Aps.Sound != "default" || p.Aps.Badge == nil || *p.Aps.Badge != 1 {
+		t.Fatalf("payload %+v", p)
+	}
+	if _, ok := tokens.tokens[tokenKey("user2", constant.IOSPlatformID)]; ok {
+		t.Fatal("the invalid token is not removed")
+	}
+}
+
+func TestPushRejectedProviderToken(t *testing.T) {
+	key, dir := writeTestKey(t)
+	var (
+		mu      sync.Mutex
+		bearers []string
+	)
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		bearer := strings.TrimPrefix(r.Header.Get("authorization"), "bearer ")
+		mu.Lock()
+		bearers = append(bearers, bearer)
+		mu.Unlock()
+		if _, err := jwt.Parse(bearer, func(*jwt.Token) (any, error) { return &key.PublicKey, nil }); err != nil {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"reason":"ExpiredProviderToken"}`))
+		}
+	}))
+	server.EnableHTTP2 = true
+	server.StartTLS()
+	defer server.Close()
+
+	var pushConf config.Push
+	pushConf.APNs.KeyFilePath = "key.p8"
+	pushConf.APNs.KeyID = "keyID"
+	pushConf.APNs.TeamID = "teamID"
+	pushConf.APNs.Topic = "com.example.app"
+	pushConf.APNs.Endpoint = server.URL
+	client, err := NewClient(&pushConf, &tokenCache{tokens: map[string]string{tokenKey("user1", constant.IOSPlatformID): "valid"}}, dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.httpClient = server.Client()
+	// the cached provider token is rejected before its refresh interval
+	client.bearer, client.bearerIssue = "stale", time.Now()
+
+	if err := client.Push(context.Background(), []string{"user1"}, "title", "content", &options.Opts{}); err != nil {
+		t.Fatal(err)
+	}
+	if len(bearers) != 2 || bearers[0] != "stale" || bearers[1] == "stale" {
+		t.Fatalf("pushed by the provider tokens %v, want the stale one and one signed again", bearers)
+	}
+	if client.bearer != bearers[1] {
+		t.Fatal("the provider token signed again is not cached")
+	}
+}
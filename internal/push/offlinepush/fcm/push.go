@@ -28,6 +28,7 @@ import (
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
 	"github.com/openimsdk/protocol/constant"
 	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/utils/datautil"
 	"github.com/redis/go-redis/v9"
 	"google.golang.org/api/option"
 )
@@ -93,32 +94,43 @@ func (f *Fcm) Push(ctx context.Context, userIDs []string, title, content string,
 	notification.Body = content
 	notification.Title = title
 	var messages []*messaging.Message
+	// msgUserIDs are the users of the messages
+	var msgUserIDs []string
+	// retryUserIDs are the users failed by the errors other than the permanent ones
+	var retryUserIDs []string
 	var sendErrBuilder strings.Builder
 	var msgErrBuilder strings.Builder
-	for userID, personTokens := range allTokens {
-		apns := &messaging.APNSConfig{Payload: &messaging.APNSPayload{Aps: &messaging.Aps{Sound: opts.IOSPushSound}}}
-		messageCount := len(messages)
-		if messageCount >= SinglePushCountLimit {
-			response, err := f.fcmMsgCli.SendAll(ctx, messages)
-			if err != nil {
-				Fail = Fail + messageCount
-				// Record push error
-				sendErrBuilder.WriteString(err.Error())
-				sendErrBuilder.WriteByte('.')
-			} else {
-				Success = Success + response.SuccessCount
-				Fail = Fail + response.FailureCount
-				if response.FailureCount != 0 {
-					// Record message error
-					for i := range response.Responses {
-						if !response.Responses[i].Success {
-							msgErrBuilder.WriteString(response.Responses[i].Error.Error())
-							msgErrBuilder.WriteByte('.')
+	sendAll := func() {
+		response, err := f.fcmMsgCli.SendAll(ctx, messages)
+		if err != nil {
+			Fail = Fail + len(messages)
+			retryUserIDs = append(retryUserIDs, msgUserIDs...)
+			// Record push error
+			sendErrBuilder.WriteString(err.Error())
+			sendErrBuilder.WriteByte('.')
+		} else {
+			Success = Success + response.SuccessCount
+			Fail = Fail + response.FailureCount
+			if response.FailureCount != 0 {
+				// Record message error
+				for i := range response.Responses {
+					if !response.Responses[i].Success {
+						if !isPermanentErr(response.Responses[i].Error) {
+							retryUserIDs = append(retryUserIDs, msgUserIDs[i])
 						}
+						msgErrBuilder.WriteString(response.Responses[i].Error.Error())
+						msgErrBuilder.WriteByte('.')
 					}
 				}
 			}
-			messages = messages[0:0]
+		}
+		messages = messages[0:0]
+		msgUserIDs = msgUserIDs[0:0]
+	}
+	for userID, personTokens := range allTokens {
+		apns := &messaging.APNSConfig{Payload: &messaging.APNSPayload{Aps: &messaging.Aps{Sound: opts.IOSPushSound}}}
+		if len(messages) >= SinglePushCountLimit {
+			sendAll()
 		}
 		if opts.IOSBadgeCount {
 			unreadCountSum, err := f.cache.IncrUserBadgeUnreadCountSum(ctx, userID)
@@ -127,6 +139,7 @@ func (f *Fcm) Push(ctx context.Context, userIDs []string, title, content string,
 			} else {
 				// log.Error(operationID, "IncrUserBadgeUnreadCountSum redis err", err.Error(), uid)
 				Fail++
+				retryUserIDs = append(retryUserIDs, userID)
 				continue
 			}
 		} else {
@@ -139,6 +152,7 @@ func (f *Fcm) Push(ctx context.Context, userIDs []string, title, content string,
 			} else {
 				// log.Error(operationID, "GetUserBadgeUnreadCountSum redis err", err.Error(), uid)
 				Fail++
+				retryUserIDs = append(retryUserIDs, userID)
 				continue
 			}
 		}
@@ -150,21 +164,23 @@ func (f *Fcm) Push(ctx context.Context, userIDs []string, title, content string,
 				APNS:         apns,
 			}
 			messages = append(messages, temp)
+			msgUserIDs = append(msgUserIDs, userID)
 		}
 	}
-	messageCount := len(messages)
-	if messageCount > 0 {
-		response, err := f.fcmMsgCli.SendAll(ctx, messages)
-		if err != nil {
-			Fail = Fail + messageCount
-		} else {
-			Success = Success + response.SuccessCount
-			Fail = Fail + response.FailureCount
-		}
+	if len(messages) > 0 {
+		sendAll()
 	}
 	if Fail != 0 {
-		return errs.New(fmt.Sprintf("%d message send failed;send err:%s;message err:%s",
-			Fail, sendErrBuilder.String(), msgErrBuilder.String())).Wrap()
+		return &options.PushError{
+			RetryUserIDs: datautil.Distinct(retryUserIDs),
+			Err: errs.New(fmt.Sprintf("%d message send failed;send err:%s;message err:%s",
+				Fail, sendErrBuilder.String(), msgErrBuilder.String())).Wrap(),
+		}
 	}
 	return nil
 }
+
+// isPermanentErr returns true if the message would fail again by retrying.
+func isPermanentErr(err error) bool {
+	return messaging.IsRegistrationTokenNotRegistered(err) || messaging.IsInvalidArgument(err)
+}
@@ -88,15 +88,28 @@ func (g *Client) Push(ctx context.Context, userIDs []string, title, content stri
 			s := splitter.NewSplitter(maxNum, userIDs)
 			wg := sync.WaitGroup{}
 			wg.Add(len(s.GetSplitResult()))
+			var (
+				mu sync.Mutex
+				// retryUserIDs are the users of the failed batches
+				retryUserIDs []string
+				batchErr     error
+			)
 			for i, v := range s.GetSplitResult() {
 				go func(index int, userIDs []string) {
 					defer wg.Done()
-					if err = g.batchPush(ctx, token, userIDs, pushReq); err != nil {
+					if err := g.batchPush(ctx, token, userIDs, pushReq); err != nil {
 						log.ZError(ctx, "batchPush failed", err, "index", index, "token", token, "req", pushReq)
+						mu.Lock()
+						retryUserIDs = append(retryUserIDs, userIDs...)
+						batchErr = err
+						mu.Unlock()
 					}
 				}(i, v.Item)
 			}
 			wg.Wait()
+			if batchErr != nil {
+				err = &options.PushError{RetryUserIDs: retryUserIDs, Err: batchErr}
+			}
 		} else {
 			err = g.batchPush(ctx, token, userIDs, pushReq)
 		}
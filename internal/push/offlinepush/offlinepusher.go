@@ -16,6 +16,7 @@ package offlinepush
 
 import (
 	"context"
+	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush/apns"
 	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush/dummy"
 	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush/fcm"
 	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush/getui"
@@ -23,28 +24,42 @@ import (
 	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush/options"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/thirdext"
 )
 
 const (
 	geTUI    = "geTui"
 	firebase = "fcm"
 	jPush    = "jpush"
+	apple    = "apns"
 )
 
+// oemVendors are the android OEM push channels, they have no pusher yet and can not be routed to.
+var oemVendors = thirdext.OEMVendors
+
 // OfflinePusher Offline Pusher.
 type OfflinePusher interface {
 	Push(ctx context.Context, userIDs []string, title, content string, opts *options.Opts) error
 }
 
 func NewOfflinePusher(pushConf *config.Push, cache cache.ThirdCache, fcmConfigPath string) (OfflinePusher, error) {
+	if pushConf.Routing.Enable {
+		return newRouter(pushConf, cache, fcmConfigPath)
+	}
+	return newVendorPusher(pushConf.Enable, pushConf, cache, fcmConfigPath)
+}
+
+func newVendorPusher(vendor string, pushConf *config.Push, cache cache.ThirdCache, fcmConfigPath string) (OfflinePusher, error) {
 	var offlinePusher OfflinePusher
-	switch pushConf.Enable {
+	switch vendor {
 	case geTUI:
 		offlinePusher = getui.NewClient(pushConf, cache)
 	case firebase:
 		return fcm.NewClient(pushConf, cache, fcmConfigPath)
 	case jPush:
 		offlinePusher = jpush.NewClient(pushConf)
+	case apple:
+		return apns.NewClient(pushConf, cache, fcmConfigPath)
 	default:
 		offlinePusher = dummy.NewClient()
 	}
@@ -0,0 +1,46 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package options
+
+import "errors"
+
+// PushError is the error of a push failed for some of the users.
+type PushError struct {
+	// RetryUserIDs are the users the push can be retried for, the users failed by permanent errors,
+	// e.g. invalid device tokens, are not in it.
+	RetryUserIDs []string
+	Err          error
+}
+
+func (e *PushError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *PushError) Unwrap() error {
+	return e.Err
+}
+
+// RetryUserIDs returns the users of the failed push the push can be retried for, it is all of userIDs
+// if the pusher does not report the failed users.
+func RetryUserIDs(err error, userIDs []string) []string {
+	if err == nil {
+		return nil
+	}
+	var pushErr *PushError
+	if errors.As(err, &pushErr) {
+		return pushErr.RetryUserIDs
+	}
+	return userIDs
+}
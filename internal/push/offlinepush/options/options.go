@@ -6,6 +6,8 @@ type Opts struct {
 	IOSPushSound  string
 	IOSBadgeCount bool
 	Ex            string
+	// CollapseID groups the notifications, the device shows the last one of the same CollapseID.
+	CollapseID string
 }
 
 // Signal message id.
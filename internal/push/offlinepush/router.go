@@ -0,0 +1,205 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package offlinepush
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush/options"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/prommetrics"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+	"github.com/redis/go-redis/v9"
+)
+
+// router pushes each device of the users by the vendor its token is registered for.
+type router struct {
+	cache         cache.ThirdCache
+	vendors       []string
+	defaultVendor string
+	pushers       map[string]OfflinePusher
+}
+
+func newRouter(pushConf *config.Push, thirdCache cache.ThirdCache, fcmConfigPath string) (*router, error) {
+	conf := pushConf.Routing
+	r := &router{
+		cache:         thirdCache,
+		vendors:       datautil.Distinct(conf.Vendors),
+		defaultVendor: conf.DefaultVendor,
+		pushers:       make(map[string]OfflinePusher),
+	}
+	if r.defaultVendor != "" && !datautil.Contain(r.defaultVendor, r.vendors...) {
+		return nil, errs.New("push routing defaultVendor is not in vendors", "defaultVendor", r.defaultVendor).Wrap()
+	}
+	for _, vendor := range r.vendors {
+		switch vendor {
+		case geTUI, firebase, jPush, apple:
+		default:
+			if datautil.Contain(vendor, oemVendors...) {
+				return nil, errs.New("push routing vendor is not supported, the devices of the OEM channels must be pushed by fcm, geTui or jpush",
+					"vendor", vendor).Wrap()
+			}
+			return nil, errs.New("unknown push routing vendor", "vendor", vendor).Wrap()
+		}
+		pusher, err := newVendorPusher(vendor, pushConf, &vendorCache{
+			ThirdCache: thirdCache,
+			vendor:     vendor,
+			isDefault:  vendor == r.defaultVendor,
+			vendors:    r.vendors,
+		}, fcmConfigPath)
+		if err != nil {
+			return nil, err
+		}
+		r.pushers[vendor] = pusher
+	}
+	return r, nil
+}
+
+func (r *router) Push(ctx context.Context, userIDs []string, title, content string, opts *options.Opts) error {
+	var (
+		errB strings.Builder
+		// retryUserIDs are the users failed by the errors other than the permanent ones
+		retryUserIDs []string
+	)
+	vendorUserIDs := make(map[string][]string)
+	for _, userID := range userIDs {
+		tokens, err := r.cache.GetDeviceTokens(ctx, userID)
+		if err != nil {
+			log.ZWarn(ctx, "get device tokens failed", err, "userID", userID)
+			retryUserIDs = append(retryUserIDs, userID)
+			errB.WriteString(fmt.Sprintf("get device tokens of %s: %s.", userID, err.Error()))
+			continue
+		}
+		vendors := make(map[string]struct{})
+		for _, token := range tokens {
+			if _, ok := r.pushers[token.Vendor]; !ok {
+				log.ZDebug(ctx, "device token of an unused vendor", "userID", userID, "platformID", token.PlatformID, "vendor", token.Vendor)
+				continue
+			}
+			if _, ok := vendors[token.Vendor]; ok {
+				continue
+			}
+			vendors[token.Vendor] = struct{}{}
+			vendorUserIDs[token.Vendor] = append(vendorUserIDs[token.Vendor], userID)
+		}
+		// the users without a token of the routed vendors are pushed by the default vendor
+		if len(vendors) == 0 && r.defaultVendor != "" {
+			vendorUserIDs[r.defaultVendor] = append(vendorUserIDs[r.defaultVendor], userID)
+		}
+	}
+	// badged are the users whose badges have been increased by a vendor, the others only get them
+	badged := make(map[string]struct{})
+	for _, vendor := range r.vendors {
+		userIDs := vendorUserIDs[vendor]
+		if len(userIDs) == 0 {
+			continue
+		}
+		vendorRetryUserIDs, err := r.push(ctx, r.pushers[vendor], userIDs, badged, title, content, opts)
+		if err != nil {
+			log.ZWarn(ctx, "vendor offline push failed", err, "vendor", vendor, "userIDs", len(userIDs))
+			prommetrics.MsgOfflinePushVendorCounter.WithLabelValues(vendor, "failed").Inc()
+			errB.WriteString(fmt.Sprintf("%s: %s.", vendor, err.Error()))
+			retryUserIDs = append(retryUserIDs, vendorRetryUserIDs...)
+			continue
+		}
+		prommetrics.MsgOfflinePushVendorCounter.WithLabelValues(vendor, "success").Inc()
+	}
+	if errB.Len() != 0 {
+		return &options.PushError{
+			RetryUserIDs: datautil.Distinct(retryUserIDs),
+			Err:          errs.New("offline push routing failed", "err", errB.String()).Wrap(),
+		}
+	}
+	return nil
+}
+
+// push pushes the users by the pusher, retryUserIDs are the failed users the push can be retried for.
+func (r *router) push(ctx context.Context, pusher OfflinePusher, userIDs []string, badged map[string]struct{},
+	title, content string, opts *options.Opts) (retryUserIDs []string, err error) {
+	if !opts.IOSBadgeCount {
+		err = pusher.Push(ctx, userIDs, title, content, opts)
+		return options.RetryUserIDs(err, userIDs), err
+	}
+	var incrUserIDs, getUserIDs []string
+	for _, userID := range userIDs {
+		if _, ok := badged[userID]; ok {
+			getUserIDs = append(getUserIDs, userID)
+			continue
+		}
+		badged[userID] = struct{}{}
+		incrUserIDs = append(incrUserIDs, userID)
+	}
+	if len(incrUserIDs) != 0 {
+		if incrErr := pusher.Push(ctx, incrUserIDs, title, content, opts); incrErr != nil {
+			err = incrErr
+			retryUserIDs = append(retryUserIDs, options.RetryUserIDs(incrErr, incrUserIDs)...)
+		}
+	}
+	if len(getUserIDs) != 0 {
+		getOpts := *opts
+		getOpts.IOSBadgeCount = false
+		if getErr := pusher.Push(ctx, getUserIDs, title, content, &getOpts); getErr != nil {
+			err = getErr
+			retryUserIDs = append(retryUserIDs, options.RetryUserIDs(getErr, getUserIDs)...)
+		}
+	}
+	return retryUserIDs, err
+}
+
+// vendorCache is the ThirdCache of the pusher of a vendor, its push tokens are the device tokens
+// registered for the vendor. The default vendor uses the tokens updated by fcm_update_token of the users
+// without a device token of the routed vendors.
+type vendorCache struct {
+	cache.ThirdCache
+	vendor    string
+	isDefault bool
+	// vendors are the routed vendors
+	vendors []string
+}
+
+func (c *vendorCache) GetFcmToken(ctx context.Context, account string, platformID int) (string, error) {
+	tokens, err := c.ThirdCache.GetDeviceTokens(ctx, account)
+	if err != nil {
+		return "", err
+	}
+	routed := false
+	for _, token := range tokens {
+		if token.PlatformID == platformID && token.Vendor == c.vendor {
+			return token.Token, nil
+		}
+		if datautil.Contain(token.Vendor, c.vendors...) {
+			routed = true
+		}
+	}
+	if c.isDefault && !routed {
+		return c.ThirdCache.GetFcmToken(ctx, account, platformID)
+	}
+	return "", errs.Wrap(redis.Nil)
+}
+
+func (c *vendorCache) DelFcmToken(ctx context.Context, account string, platformID int) error {
+	if err := c.ThirdCache.DelDeviceToken(ctx, account, platformID, c.vendor); err != nil {
+		return err
+	}
+	if c.isDefault {
+		return c.ThirdCache.DelFcmToken(ctx, account, platformID)
+	}
+	return nil
+}
@@ -0,0 +1,144 @@
+package offlinepush
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush/options"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/protocol/constant"
+)
+
+type deviceCache struct {
+	cache.ThirdCache
+	tokens    map[string][]*model.DeviceToken
+	fcmTokens map[string]string
+}
+
+func (c *deviceCache) GetDeviceTokens(_ context.Context, userID string) ([]*model.DeviceToken, error) {
+	return c.tokens[userID], nil
+}
+
+func (c *deviceCache) GetFcmToken(_ context.Context, account string, _ int) (string, error) {
+	return c.fcmTokens[account], nil
+}
+
+type pushCall struct {
+	userIDs    []string
+	badgeCount bool
+}
+
+type recordPusher struct {
+	calls []pushCall
+}
+
+func (p *recordPusher) Push(_ context.Context, userIDs []string, _, _ string, opts *options.Opts) error {
+	p.calls = append(p.calls, pushCall{userIDs: userIDs, badgeCount: opts.IOSBadgeCount})
+	return nil
+}
+
+func TestRouterPush(t *testing.T) {
+	apnsPusher, fcmPusher := &recordPusher{}, &recordPusher{}
+	r := &router{
+		cache: &deviceCache{tokens: map[string][]*model.DeviceToken{
+			"user1": {
+				{PlatformID: constant.IOSPlatformID, Vendor: apple, Token: "ios"},
+				{PlatformID: constant.AndroidPlatformID, Vendor: firebase, Token: "android"},
+			},
+		}},
+		vendors:       []string{apple, firebase},
+		defaultVendor: firebase,
+		pushers:       map[string]OfflinePusher{apple: apnsPusher, firebase: fcmPusher},
+	}
+	if err := r.Push(context.Background(), []string{"user1", "user2"}, "title", "content",
+		&options.Opts{IOSBadgeCount: true}); err != nil {
+		t.Fatal(err)
+	}
+	if want := []pushCall{{userIDs: []string{"user1"}, badgeCount: true}}; !reflect.DeepEqual(apnsPusher.calls, want) {
+		t.Fatalf("apns calls %+v, want %+v", apnsPusher.calls, want)
+	}
+	// the badge of user1 has been increased by apns
+	want := []pushCall{{userIDs: []string{"user2"}, badgeCount: true}, {userIDs: []string{"user1"}, badgeCount: false}}
+	if !reflect.DeepEqual(fcmPusher.calls, want) {
+		t.Fatalf("fcm calls %+v, want %+v", fcmPusher.calls, want)
+	}
+}
+
+func TestRouterPushUnusedVendor(t *testing.T) {
+	devices := &deviceCache{
+		tokens: map[string][]*model.DeviceToken{
+			"user1": {{PlatformID: constant.AndroidPlatformID, Vendor: "huawei", Token: "huawei"}},
+		},
+		fcmTokens: map[string]string{"user1": "fcm"},
+	}
+	fcmPusher := &recordPusher{}
+	r := &router{
+		cache:         devices,
+		vendors:       []string{apple, firebase},
+		defaultVendor: firebase,
+		pushers:       map[string]OfflinePusher{apple: &recordPusher{}, firebase: fcmPusher},
+	}
+	if err := r.Push(context.Background(), []string{"user1"}, "title", "content", &options.Opts{}); err != nil {
+		t.Fatal(err)
+	}
+	// the only token of user1 has no pusher, it is pushed by the default vendor
+	if want := []pushCall{{userIDs: []string{"user1"}}}; !reflect.DeepEqual(fcmPusher.calls, want) {
+		t.Fatalf("fcm calls %+v, want %+v", fcmPusher.calls, want)
+	}
+	c := &vendorCache{ThirdCache: devices, vendor: firebase, isDefault: true, vendors: r.vendors}
+	token, err := c.GetFcmToken(context.Background(), "user1", constant.AndroidPlatformID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token != "fcm" {
+		t.Fatalf("token %s, want the token updated by fcm_update_token", token)
+	}
+}
+
+type failPusher struct {
+	err error
+}
+
+func (p *failPusher) Push(context.Context, []string, string, string, *options.Opts) error {
+	return p.err
+}
+
+func TestRouterPushRetryUserIDs(t *testing.T) {
+	r := &router{
+		cache: &deviceCache{tokens: map[string][]*model.DeviceToken{
+			"user1": {{PlatformID: constant.IOSPlatformID, Vendor: apple, Token: "ios"}},
+			"user2": {{PlatformID: constant.IOSPlatformID, Vendor: apple, Token: "ios"}},
+		}},
+		vendors:       []string{apple, firebase},
+		defaultVendor: firebase,
+		pushers: map[string]OfflinePusher{
+			// the token of user2 is invalid
+			apple:    &failPusher{err: &options.PushError{RetryUserIDs: []string{"user1"}, Err: errors.New("apns")}},
+			firebase: &failPusher{err: errors.New("fcm")},
+		},
+	}
+	err := r.Push(context.Background(), []string{"user1", "user2", "user3"}, "title", "content", &options.Opts{})
+	if err == nil {
+		t.Fatal("the push must fail")
+	}
+	retryUserIDs := options.RetryUserIDs(err, nil)
+	sort.Strings(retryUserIDs)
+	if want := []string{"user1", "user3"}; !reflect.DeepEqual(retryUserIDs, want) {
+		t.Fatalf("retry userIDs %v, want %v", retryUserIDs, want)
+	}
+}
+
+func TestNewRouterVendors(t *testing.T) {
+	for _, vendor := range append([]string{"unknown"}, oemVendors...) {
+		pushConf := &config.Push{}
+		pushConf.Routing.Vendors = []string{vendor}
+		if _, err := newRouter(pushConf, nil, ""); err == nil {
+			t.Fatalf("vendor %s is routed", vendor)
+		}
+	}
+}
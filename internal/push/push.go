@@ -6,7 +6,10 @@ import (
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/redis"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database/mgo"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/pushext"
 	pbpush "github.com/openimsdk/protocol/push"
+	"github.com/openimsdk/tools/db/mongoutil"
 	"github.com/openimsdk/tools/db/redisutil"
 	"github.com/openimsdk/tools/discovery"
 	"google.golang.org/grpc"
@@ -14,9 +17,11 @@ import (
 
 type pushServer struct {
 	database      controller.PushDatabase
+	retryDatabase controller.PushRetryDatabase
 	disCov        discovery.SvcDiscoveryRegistry
 	offlinePusher offlinepush.OfflinePusher
 	pushCh        *ConsumerHandler
+	config        *Config
 }
 
 type Config struct {
@@ -42,10 +47,17 @@ func (p pushServer) DelUserPushToken(ctx context.Context,
 	if err = p.database.DelFcmToken(ctx, req.UserID, int(req.PlatformID)); err != nil {
 		return nil, err
 	}
+	if err = p.database.DelDeviceTokens(ctx, req.UserID, int(req.PlatformID)); err != nil {
+		return nil, err
+	}
 	return &pbpush.DelUserPushTokenResp{}, nil
 }
 
 func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryRegistry, server *grpc.Server) error {
+	mgocli, err := mongoutil.NewMongoDB(ctx, config.MongodbConfig.Build())
+	if err != nil {
+		return err
+	}
 	rdb, err := redisutil.NewRedisClient(ctx, config.RedisConfig.Build())
 	if err != nil {
 		return err
@@ -57,16 +69,33 @@ func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryReg
 	}
 	database := controller.NewPushDatabase(cacheModel)
 
+	deadLetterDB, err := mgo.NewOfflinePushDeadLetterMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	retryDatabase := controller.NewPushRetryDatabase(redis.NewPushRetryCache(rdb), deadLetterDB)
+
 	consumer, err := NewConsumerHandler(config, offlinePusher, rdb, client)
 	if err != nil {
 		return err
 	}
-	pbpush.RegisterPushMsgServiceServer(server, &pushServer{
+	if config.RpcConfig.Retry.Enable {
+		consumer.pushRetry = newPushRetry(&config.RpcConfig, retryDatabase, consumer.retryOfflinePush)
+		go consumer.pushRetry.start(ctx)
+	}
+	if consumer.stalePush.policy == stalePushSummary {
+		go consumer.pushSummary.start(ctx)
+	}
+	srv := &pushServer{
 		database:      database,
+		retryDatabase: retryDatabase,
 		disCov:        client,
 		offlinePusher: offlinePusher,
 		pushCh:        consumer,
-	})
+		config:        config,
+	}
+	pbpush.RegisterPushMsgServiceServer(server, srv)
+	pushext.RegisterPushExtServer(server, srv)
 	go consumer.pushConsumerGroup.RegisterHandleAndConsumer(ctx, consumer)
 	return nil
 }
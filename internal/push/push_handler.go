@@ -16,11 +16,19 @@ package push
 
 import (
 	"context"
+	"crypto/md5"
+	"encoding/hex"
 	"encoding/json"
+	"strconv"
+	"strings"
+
 	"github.com/IBM/sarama"
 	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush"
 	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush/options"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/convert"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/prommetrics"
+	redis2 "github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/redis"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/webhook"
 	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpccache"
@@ -35,8 +43,6 @@ import (
 	"github.com/openimsdk/tools/mcontext"
 	"github.com/openimsdk/tools/mq/kafka"
 	"github.com/openimsdk/tools/utils/datautil"
-	"github.com/openimsdk/tools/utils/jsonutil"
-	"github.com/openimsdk/tools/utils/timeutil"
 	"github.com/redis/go-redis/v9"
 	"google.golang.org/protobuf/proto"
 )
@@ -51,7 +57,14 @@ type ConsumerHandler struct {
 	conversationRpcClient  rpcclient.ConversationRpcClient
 	groupRpcClient         rpcclient.GroupRpcClient
 	webhookClient          *webhook.Client
-	config                 *Config
+	// pushRetry is nil if the retry of the failed offline pushes is disabled.
+	pushRetry   *pushRetry
+	stalePush   *stalePush
+	pushSummary *pushSummary
+	// pushTemplates is nil if the localized offline pushes are disabled.
+	pushTemplates  *pushTemplates
+	userLocalCache *rpccache.UserLocalCache
+	config         *Config
 }
 
 func NewConsumerHandler(config *Config, offlinePusher offlinepush.OfflinePusher, rdb redis.UniversalClient,
@@ -72,6 +85,19 @@ func NewConsumerHandler(config *Config, offlinePusher offlinepush.OfflinePusher,
 	consumerHandler.conversationLocalCache = rpccache.NewConversationLocalCache(consumerHandler.conversationRpcClient,
 		&config.LocalCacheConfig, rdb)
 	consumerHandler.webhookClient = webhook.NewWebhookClient(config.WebhooksConfig.URL)
+	consumerHandler.stalePush, err = newStalePush(&config.RpcConfig)
+	if err != nil {
+		return nil, err
+	}
+	if config.RpcConfig.Templates.Enable {
+		consumerHandler.pushTemplates, err = newPushTemplates(&config.RpcConfig)
+		if err != nil {
+			return nil, err
+		}
+	}
+	consumerHandler.userLocalCache = rpccache.NewUserLocalCache(rpcclient.NewUserRpcClient(client, config.Share.RpcRegisterName.User,
+		config.Share.IMAdminUserID), &config.LocalCacheConfig, rdb)
+	consumerHandler.pushSummary = newPushSummary(&config.RpcConfig, redis2.NewPushSummaryCache(rdb), consumerHandler.pushSummaries)
 	consumerHandler.config = config
 	return &consumerHandler, nil
 }
@@ -86,10 +112,10 @@ func (c *ConsumerHandler) handleMs2PsChat(ctx context.Context, msg []byte) {
 		MsgData:        msgFromMQ.MsgData,
 		ConversationID: msgFromMQ.ConversationID,
 	}
-	sec := msgFromMQ.MsgData.SendTime / 1000
-	nowSec := timeutil.GetCurrentTimestampBySecond()
-	log.ZDebug(ctx, "push msg", "msg", pbData.String(), "sec", sec, "nowSec", nowSec)
-	if nowSec-sec > 10 {
+	log.ZDebug(ctx, "push msg", "msg", pbData.String())
+	if c.stalePush.policy == stalePushDrop && c.stalePush.stale(pbData.MsgData) {
+		prommetrics.MsgStalePushSkippedCounter.WithLabelValues(stalePushDrop).Inc()
+		log.ZDebug(ctx, "drop stale push", "msg", pbData.String())
 		return
 	}
 	var err error
@@ -280,15 +306,105 @@ func (c *ConsumerHandler) groupMessagesHandler(ctx context.Context, groupID stri
 }
 
 func (c *ConsumerHandler) offlinePushMsg(ctx context.Context, msg *sdkws.MsgData, offlinePushUserIDs []string) error {
+	if c.stalePush.policy == stalePushSummary && c.stalePush.stale(msg) {
+		prommetrics.MsgStalePushSkippedCounter.WithLabelValues(stalePushSummary).Inc()
+		c.pushSummary.add(ctx, offlinePushUserIDs)
+		return nil
+	}
 	title, content, opts, err := c.getOfflinePushInfos(msg)
 	if err != nil {
 		return err
 	}
-	err = c.offlinePusher.Push(ctx, offlinePushUserIDs, title, content, opts)
-	if err != nil {
+	var pushErr error
+	for _, push := range c.localizeOfflinePush(ctx, msg, offlinePushUserIDs, title, content) {
+		err = c.offlinePusher.Push(ctx, push.userIDs, push.title, push.content, opts)
+		if err != nil {
+			prommetrics.MsgOfflinePushFailedCounter.Inc()
+			if retryUserIDs := options.RetryUserIDs(err, push.userIDs); c.pushRetry != nil && len(retryUserIDs) != 0 {
+				if err := c.pushRetry.add(ctx, msg, retryUserIDs, err); err != nil {
+					log.ZError(ctx, "add offline push retry failed", err, "offlinePushUserIDs", retryUserIDs, "msg", msg)
+				}
+			}
+			pushErr = err
+		}
+	}
+	return pushErr
+}
+
+// pushSummaries pushes the summaries of the stale msgs by the numbers of their msgs by userID, the failed
+// ones are retried.
+func (c *ConsumerHandler) pushSummaries(ctx context.Context, counts map[string]int64) {
+	countUserIDs := make(map[int64][]string)
+	for userID, count := range counts {
+		countUserIDs[count] = append(countUserIDs[count], userID)
+	}
+	for count, userIDs := range countUserIDs {
+		err := c.offlinePushSummary(ctx, userIDs, count)
+		if err == nil {
+			continue
+		}
 		prommetrics.MsgOfflinePushFailedCounter.Inc()
+		log.ZWarn(ctx, "push summary failed", err, "userIDs", userIDs, "count", count)
+		if retryUserIDs := options.RetryUserIDs(err, userIDs); c.pushRetry != nil && len(retryUserIDs) != 0 {
+			if err := c.pushRetry.addSummary(ctx, count, retryUserIDs, err); err != nil {
+				log.ZError(ctx, "add summary push retry failed", err, "userIDs", retryUserIDs, "count", count)
+			}
+		}
+	}
+}
+
+// offlinePushSummary pushes the summary of count stale msgs to the users, the error reports the users the push
+// can be retried for.
+func (c *ConsumerHandler) offlinePushSummary(ctx context.Context, userIDs []string, count int64) error {
+	replacer := strings.NewReplacer("{count}", strconv.FormatInt(count, 10))
+	title := replacer.Replace(c.config.RpcConfig.StalePush.Summary.Title)
+	content := replacer.Replace(c.config.RpcConfig.StalePush.Summary.Content)
+	var (
+		pushErr      error
+		retryUserIDs []string
+	)
+	for _, push := range c.localizeSummaryPush(ctx, userIDs, count, title, content) {
+		err := c.offlinePusher.Push(ctx, push.userIDs, push.title, push.content, &options.Opts{Signal: &options.Signal{}})
+		if err != nil {
+			pushErr = err
+			retryUserIDs = append(retryUserIDs, options.RetryUserIDs(err, push.userIDs)...)
+		}
+	}
+	if pushErr != nil {
+		return &options.PushError{RetryUserIDs: retryUserIDs, Err: pushErr}
+	}
+	return nil
+}
+
+// retryOfflinePush pushes the task of the retry queue again.
+func (c *ConsumerHandler) retryOfflinePush(ctx context.Context, task *model.OfflinePushTask) error {
+	if task.Msg == nil {
+		return c.offlinePushSummary(ctx, task.UserIDs, task.Summary)
+	}
+	return c.retryOfflinePushMsg(ctx, convert.MsgDB2Pb(task.Msg), task.UserIDs)
+}
+
+// retryOfflinePushMsg pushes the msg again, the iOS badge is not increased as it was by the first attempt.
+// The error reports the users the push can be retried for.
+func (c *ConsumerHandler) retryOfflinePushMsg(ctx context.Context, msg *sdkws.MsgData, offlinePushUserIDs []string) error {
+	title, content, opts, err := c.getOfflinePushInfos(msg)
+	if err != nil {
 		return err
 	}
+	opts.IOSBadgeCount = false
+	var (
+		pushErr      error
+		retryUserIDs []string
+	)
+	for _, push := range c.localizeOfflinePush(ctx, msg, offlinePushUserIDs, title, content) {
+		if err := c.offlinePusher.Push(ctx, push.userIDs, push.title, push.content, opts); err != nil {
+			pushErr = err
+			retryUserIDs = append(retryUserIDs, options.RetryUserIDs(err, push.userIDs)...)
+		}
+	}
+	if pushErr != nil {
+		return &options.PushError{RetryUserIDs: retryUserIDs, Err: pushErr}
+	}
 	return nil
 }
 
@@ -304,14 +420,19 @@ func (c *ConsumerHandler) filterGroupMessageOfflinePush(ctx context.Context, gro
 	return needOfflinePushUserIDs, nil
 }
 
-func (c *ConsumerHandler) getOfflinePushInfos(msg *sdkws.MsgData) (title, content string, opts *options.Opts, err error) {
-	type AtTextElem struct {
-		Text       string   `json:"text,omitempty"`
-		AtUserList []string `json:"atUserList,omitempty"`
-		IsAtSelf   bool     `json:"isAtSelf"`
+// offlinePushCollapseID collapses the notifications of a conversation into its last one,
+// the conversationID is hashed if it exceeds the 64 bytes allowed by APNs.
+func offlinePushCollapseID(msg *sdkws.MsgData) string {
+	conversationID := msgprocessor.GetConversationIDByMsg(msg)
+	if len(conversationID) <= 64 {
+		return conversationID
 	}
+	sum := md5.Sum([]byte(conversationID))
+	return hex.EncodeToString(sum[:])
+}
 
-	opts = &options.Opts{Signal: &options.Signal{}}
+func (c *ConsumerHandler) getOfflinePushInfos(msg *sdkws.MsgData) (title, content string, opts *options.Opts, err error) {
+	opts = &options.Opts{Signal: &options.Signal{}, CollapseID: offlinePushCollapseID(msg)}
 	if msg.OfflinePushInfo != nil {
 		opts.IOSBadgeCount = msg.OfflinePushInfo.IOSBadgeCount
 		opts.IOSPushSound = msg.OfflinePushInfo.IOSPushSound
@@ -333,10 +454,9 @@ func (c *ConsumerHandler) getOfflinePushInfos(msg *sdkws.MsgData) (title, conten
 		case constant.Video:
 			fallthrough
 		case constant.File:
-			title = constant.ContentType2PushContent[int64(msg.ContentType)]
+			fallthrough
 		case constant.AtText:
-			ac := AtTextElem{}
-			_ = jsonutil.JsonStringToStruct(string(msg.Content), &ac)
+			title = constant.ContentType2PushContent[int64(msg.ContentType)]
 		case constant.SignalingNotification:
 			title = constant.ContentType2PushContent[constant.SignalMsg]
 		default:
@@ -0,0 +1,201 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package push
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush/options"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/convert"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/prommetrics"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/utils/idutil"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// pushRetryClaimCount is the max number of the retries claimed at a time.
+	pushRetryClaimCount = 100
+	// pushRetryLease is the time a claimed retry is held by the push node before it can be claimed again.
+	pushRetryLease = 5 * time.Minute
+	// pushRetryConcurrency is the max number of the retries pushed at the same time.
+	pushRetryConcurrency  = 10
+	pushRetryPollInterval = time.Second
+
+	// Default retry policy if it is not configured.
+	defaultPushRetryMaxAttempts    = 5
+	defaultPushRetryInitialBackoff = 10 * time.Second
+	defaultPushRetryMaxBackoff     = 10 * time.Minute
+)
+
+// pushFunc pushes the task, the error reports the users the push can be retried for.
+type pushFunc func(ctx context.Context, task *model.OfflinePushTask) error
+
+// pushRetry retries the failed offline pushes with exponential backoff, the ones failing the max attempts
+// become dead letters.
+type pushRetry struct {
+	db             controller.PushRetryDatabase
+	maxAttempts    int32
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+	push           pushFunc
+}
+
+func newPushRetry(conf *config.Push, db controller.PushRetryDatabase, push pushFunc) *pushRetry {
+	r := &pushRetry{
+		db:             db,
+		maxAttempts:    int32(conf.Retry.MaxAttempts),
+		initialBackoff: time.Duration(conf.Retry.InitialBackoff) * time.Second,
+		maxBackoff:     time.Duration(conf.Retry.MaxBackoff) * time.Second,
+		push:           push,
+	}
+	if r.maxAttempts <= 0 {
+		r.maxAttempts = defaultPushRetryMaxAttempts
+	}
+	if r.initialBackoff <= 0 {
+		r.initialBackoff = defaultPushRetryInitialBackoff
+	}
+	if r.maxBackoff <= 0 {
+		r.maxBackoff = defaultPushRetryMaxBackoff
+	}
+	r.maxBackoff = max(r.maxBackoff, r.initialBackoff)
+	return r
+}
+
+// backoff is the time to wait before the next attempt after failing attempts times.
+func (r *pushRetry) backoff(attempts int32) time.Duration {
+	backoff := r.initialBackoff
+	for i := int32(1); i < attempts && backoff < r.maxBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, r.maxBackoff)
+}
+
+// add schedules the retry of the offline push of the msg failed by pushErr at the first attempt, userIDs are
+// the users the push can be retried for.
+func (r *pushRetry) add(ctx context.Context, msg *sdkws.MsgData, userIDs []string, pushErr error) error {
+	return r.schedule(ctx, &model.OfflinePushTask{
+		TaskID:  idutil.GetMsgIDByMD5(msg.SendID),
+		UserIDs: userIDs,
+		Msg:     convert.MsgPb2DB(msg),
+	}, pushErr)
+}
+
+// addSummary schedules the retry of the summary push of count stale msgs failed by pushErr at the first attempt.
+func (r *pushRetry) addSummary(ctx context.Context, count int64, userIDs []string, pushErr error) error {
+	return r.schedule(ctx, &model.OfflinePushTask{
+		TaskID:  idutil.GetMsgIDByMD5(userIDs[0]),
+		UserIDs: userIDs,
+		Summary: count,
+	}, pushErr)
+}
+
+func (r *pushRetry) schedule(ctx context.Context, task *model.OfflinePushTask, pushErr error) error {
+	task.Attempts = 1
+	task.ErrMsg = pushErr.Error()
+	task.OperationID = mcontext.GetOperationID(ctx)
+	task.CreateTime = time.Now()
+	_, err := r.fail(ctx, task)
+	return err
+}
+
+// fail reschedules the task after a failed attempt, or kills it if it failed the max attempts.
+func (r *pushRetry) fail(ctx context.Context, task *model.OfflinePushTask) (dead bool, err error) {
+	now := time.Now()
+	if task.Attempts >= r.maxAttempts {
+		task.DeadTime = now
+		return true, r.db.KillPushRetry(ctx, task)
+	}
+	return false, r.db.SchedulePushRetry(ctx, task, now.Add(r.backoff(task.Attempts)))
+}
+
+func (r *pushRetry) start(ctx context.Context) {
+	ticker := time.NewTicker(pushRetryPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.retryDue(ctx)
+		}
+	}
+}
+
+// retryDue retries the due tasks until no task is due.
+func (r *pushRetry) retryDue(ctx context.Context) {
+	for {
+		tasks, err := r.db.ClaimDuePushRetries(ctx, pushRetryClaimCount, pushRetryLease)
+		if err != nil {
+			log.ZError(ctx, "claim offline push retries failed", err)
+			return
+		}
+		var g errgroup.Group
+		g.SetLimit(pushRetryConcurrency)
+		for _, task := range tasks {
+			task := task
+			g.Go(func() error {
+				r.retry(task)
+				return nil
+			})
+		}
+		_ = g.Wait()
+		if len(tasks) < pushRetryClaimCount {
+			return
+		}
+	}
+}
+
+func (r *pushRetry) retry(task *model.OfflinePushTask) {
+	ctx := mcontext.SetOperationID(context.Background(), task.OperationID)
+	err := r.push(ctx, task)
+	if err == nil {
+		prommetrics.MsgOfflinePushRetryCounter.WithLabelValues("success").Inc()
+		if err := r.db.FinishPushRetry(ctx, task.TaskID); err != nil {
+			log.ZError(ctx, "finish offline push retry failed", err, "taskID", task.TaskID)
+		}
+		return
+	}
+	task.Attempts++
+	task.ErrMsg = err.Error()
+	// only the users failed by the errors other than the permanent ones are retried
+	task.UserIDs = options.RetryUserIDs(err, task.UserIDs)
+	if len(task.UserIDs) == 0 {
+		prommetrics.MsgOfflinePushRetryCounter.WithLabelValues("permanent").Inc()
+		log.ZInfo(ctx, "offline push retry failed permanently", "taskID", task.TaskID, "attempts", task.Attempts, "errMsg", task.ErrMsg)
+		if err := r.db.FinishPushRetry(ctx, task.TaskID); err != nil {
+			log.ZError(ctx, "finish offline push retry failed", err, "taskID", task.TaskID)
+		}
+		return
+	}
+	dead, err := r.fail(ctx, task)
+	if err != nil {
+		log.ZError(ctx, "reschedule offline push retry failed", err, "taskID", task.TaskID, "attempts", task.Attempts)
+		return
+	}
+	if dead {
+		prommetrics.MsgOfflinePushRetryCounter.WithLabelValues("dead").Inc()
+		log.ZWarn(ctx, "offline push retry dead", nil, "taskID", task.TaskID, "userIDs", task.UserIDs, "attempts", task.Attempts, "errMsg", task.ErrMsg)
+		return
+	}
+	prommetrics.MsgOfflinePushRetryCounter.WithLabelValues("failed").Inc()
+	log.ZInfo(ctx, "offline push retry failed", "taskID", task.TaskID, "attempts", task.Attempts, "errMsg", task.ErrMsg)
+}
@@ -0,0 +1,123 @@
+package push
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush/options"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/protocol/sdkws"
+)
+
+type memRetryDatabase struct {
+	controller.PushRetryDatabase
+	queue map[string]*model.OfflinePushTask
+	dead  map[string]*model.OfflinePushTask
+}
+
+func (m *memRetryDatabase) SchedulePushRetry(_ context.Context, task *model.OfflinePushTask, _ time.Time) error {
+	m.queue[task.TaskID] = task
+	return nil
+}
+
+func (m *memRetryDatabase) FinishPushRetry(_ context.Context, taskID string) error {
+	delete(m.queue, taskID)
+	return nil
+}
+
+func (m *memRetryDatabase) KillPushRetry(_ context.Context, task *model.OfflinePushTask) error {
+	delete(m.queue, task.TaskID)
+	m.dead[task.TaskID] = task
+	return nil
+}
+
+func TestPushRetryBackoff(t *testing.T) {
+	var conf config.Push
+	conf.Retry.InitialBackoff = 10
+	conf.Retry.MaxBackoff = 60
+	r := newPushRetry(&conf, nil, nil)
+	expected := []time.Duration{10 * time.Second, 20 * time.Second, 40 * time.Second, time.Minute, time.Minute}
+	for i, backoff := range expected {
+		if got := r.backoff(int32(i + 1)); got != backoff {
+			t.Errorf("backoff(%d) = %s, want %s", i+1, got, backoff)
+		}
+	}
+}
+
+func TestPushRetryDead(t *testing.T) {
+	db := &memRetryDatabase{queue: make(map[string]*model.OfflinePushTask), dead: make(map[string]*model.OfflinePushTask)}
+	var conf config.Push
+	conf.Retry.MaxAttempts = 3
+	var pushes int
+	fail := true
+	r := newPushRetry(&conf, db, func(ctx context.Context, task *model.OfflinePushTask) error {
+		pushes++
+		if fail {
+			return errors.New("vendor unavailable")
+		}
+		return nil
+	})
+	ctx := context.Background()
+	msg := &sdkws.MsgData{SendID: "sender", RecvID: "recv"}
+	if err := r.add(ctx, msg, []string{"recv"}, errors.New("vendor unavailable")); err != nil {
+		t.Fatal(err)
+	}
+	if len(db.queue) != 1 {
+		t.Fatalf("queued %d tasks, want 1", len(db.queue))
+	}
+	for _, task := range db.queue {
+		r.retry(task)
+		r.retry(task)
+	}
+	if pushes != 2 || len(db.queue) != 0 || len(db.dead) != 1 {
+		t.Fatalf("pushes %d queued %d dead %d, want 2 0 1", pushes, len(db.queue), len(db.dead))
+	}
+	for _, task := range db.dead {
+		if task.Attempts != 3 || task.DeadTime.IsZero() {
+			t.Fatalf("dead task attempts %d deadTime %s", task.Attempts, task.DeadTime)
+		}
+		task.Attempts = 0
+		fail = false
+		r.retry(task)
+	}
+	if pushes != 3 || len(db.queue) != 0 {
+		t.Fatalf("pushes %d queued %d, want 3 0", pushes, len(db.queue))
+	}
+}
+
+func TestPushRetryFailedUsers(t *testing.T) {
+	db := &memRetryDatabase{queue: make(map[string]*model.OfflinePushTask), dead: make(map[string]*model.OfflinePushTask)}
+	var conf config.Push
+	var pushed [][]string
+	r := newPushRetry(&conf, db, func(ctx context.Context, task *model.OfflinePushTask) error {
+		pushed = append(pushed, task.UserIDs)
+		if len(pushed) == 1 {
+			// the push to user1 succeeds and the token of user3 is invalid
+			return &options.PushError{RetryUserIDs: []string{"user2"}, Err: errors.New("vendor unavailable")}
+		}
+		return &options.PushError{Err: errors.New("invalid token")}
+	})
+	ctx := context.Background()
+	msg := &sdkws.MsgData{SendID: "sender", GroupID: "group"}
+	if err := r.add(ctx, msg, []string{"user1", "user2", "user3"}, errors.New("vendor unavailable")); err != nil {
+		t.Fatal(err)
+	}
+	for _, task := range db.queue {
+		r.retry(task)
+		if len(db.queue) != 1 || !reflect.DeepEqual(task.UserIDs, []string{"user2"}) {
+			t.Fatalf("queued %d tasks of userIDs %v, want 1 of [user2]", len(db.queue), task.UserIDs)
+		}
+		r.retry(task)
+	}
+	if len(db.queue) != 0 || len(db.dead) != 0 {
+		t.Fatalf("queued %d dead %d, the permanent failure must not be retried", len(db.queue), len(db.dead))
+	}
+	if want := [][]string{{"user1", "user2", "user3"}, {"user2"}}; !reflect.DeepEqual(pushed, want) {
+		t.Fatalf("pushed %v, want %v", pushed, want)
+	}
+}
@@ -0,0 +1,290 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package push
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"text/template"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+// maxPushTextLen is the max number of the characters of the text of a msg in a localized offline push.
+const maxPushTextLen = 100
+
+// The kinds of the templates of a locale.
+const (
+	pushTemplateText    = "text"
+	pushTemplatePicture = "picture"
+	pushTemplateVoice   = "voice"
+	pushTemplateVideo   = "video"
+	pushTemplateFile    = "file"
+	pushTemplateMention = "mention"
+	pushTemplateCommon  = "common"
+	pushTemplateSummary = "summary"
+)
+
+type pushTemplate struct {
+	title *template.Template
+	// content is nil if the content is the title.
+	content *template.Template
+}
+
+// pushTemplateData is the data of the templates.
+type pushTemplateData struct {
+	SenderNickname string
+	// GroupName is empty for the msgs which are not group msgs.
+	GroupName string
+	// Text is the text of the text and @ msgs.
+	Text string
+	// Count is the number of the stale msgs of a summary push.
+	Count int64
+}
+
+// pushTemplates are the offline push templates by the locale and the kind.
+type pushTemplates struct {
+	defaultLocale string
+	locales       map[string]map[string]*pushTemplate
+}
+
+func newPushTemplates(conf *config.Push) (*pushTemplates, error) {
+	p := &pushTemplates{
+		defaultLocale: normalizeLocale(conf.Templates.DefaultLocale),
+		locales:       make(map[string]map[string]*pushTemplate),
+	}
+	for locale, localeTemplates := range conf.Templates.Locales {
+		kinds := map[string]config.PushTemplate{
+			pushTemplateText:    localeTemplates.Text,
+			pushTemplatePicture: localeTemplates.Picture,
+			pushTemplateVoice:   localeTemplates.Voice,
+			pushTemplateVideo:   localeTemplates.Video,
+			pushTemplateFile:    localeTemplates.File,
+			pushTemplateMention: localeTemplates.Mention,
+			pushTemplateCommon:  localeTemplates.Common,
+			pushTemplateSummary: localeTemplates.Summary,
+		}
+		templates := make(map[string]*pushTemplate)
+		for kind, conf := range kinds {
+			if conf.Title == "" {
+				continue
+			}
+			var (
+				t   pushTemplate
+				err error
+			)
+			name := locale + "." + kind
+			if t.title, err = template.New(name + ".title").Parse(conf.Title); err != nil {
+				return nil, errs.WrapMsg(err, "invalid push template", "locale", locale, "kind", kind)
+			}
+			if conf.Content != "" {
+				if t.content, err = template.New(name + ".content").Parse(conf.Content); err != nil {
+					return nil, errs.WrapMsg(err, "invalid push template", "locale", locale, "kind", kind)
+				}
+			}
+			templates[kind] = &t
+		}
+		p.locales[normalizeLocale(locale)] = templates
+	}
+	return p, nil
+}
+
+// normalizeLocale lowercases the locale and separates its subtags by "-", e.g. zh_CN to zh-cn.
+func normalizeLocale(locale string) string {
+	return strings.ReplaceAll(strings.ToLower(locale), "_", "-")
+}
+
+// lookup returns the template of the kind of the locale, e.g. zh-hans-cn falls back to zh-hans, zh,
+// and then the default locale. It returns nil if none of them has the template.
+func (p *pushTemplates) lookup(locale string, kind string) *pushTemplate {
+	for locale = normalizeLocale(locale); locale != ""; {
+		if t := p.locales[locale][kind]; t != nil {
+			return t
+		}
+		i := strings.LastIndex(locale, "-")
+		if i < 0 {
+			break
+		}
+		locale = locale[:i]
+	}
+	return p.locales[p.defaultLocale][kind]
+}
+
+func (t *pushTemplate) render(data *pushTemplateData) (title, content string, err error) {
+	var b strings.Builder
+	if err := t.title.Execute(&b, data); err != nil {
+		return "", "", errs.Wrap(err)
+	}
+	title = b.String()
+	if t.content == nil {
+		return title, title, nil
+	}
+	b.Reset()
+	if err := t.content.Execute(&b, data); err != nil {
+		return "", "", errs.Wrap(err)
+	}
+	return title, b.String(), nil
+}
+
+func pushTemplateKind(contentType int32, mentioned bool) string {
+	switch contentType {
+	case constant.Text:
+		return pushTemplateText
+	case constant.AtText:
+		if mentioned {
+			return pushTemplateMention
+		}
+		return pushTemplateText
+	case constant.Picture:
+		return pushTemplatePicture
+	case constant.Voice:
+		return pushTemplateVoice
+	case constant.Video:
+		return pushTemplateVideo
+	case constant.File:
+		return pushTemplateFile
+	default:
+		return pushTemplateCommon
+	}
+}
+
+// pushText returns the text of the text and @ msgs truncated to maxPushTextLen.
+func pushText(msg *sdkws.MsgData) string {
+	var text string
+	switch msg.ContentType {
+	case constant.Text:
+		var elem struct {
+			Content string `json:"content"`
+		}
+		_ = json.Unmarshal(msg.Content, &elem)
+		text = elem.Content
+	case constant.AtText:
+		var elem struct {
+			Text string `json:"text"`
+		}
+		_ = json.Unmarshal(msg.Content, &elem)
+		text = elem.Text
+	}
+	if runes := []rune(text); len(runes) > maxPushTextLen {
+		text = string(runes[:maxPushTextLen]) + "..."
+	}
+	return text
+}
+
+// offlinePush is the offline push of the users with the same title and content.
+type offlinePush struct {
+	userIDs []string
+	title   string
+	content string
+}
+
+// localizeOfflinePush renders the title and content of the offline push of the msg by the templates of the
+// locales of the users, the users without templates get the title and content by default.
+func (c *ConsumerHandler) localizeOfflinePush(ctx context.Context, msg *sdkws.MsgData, userIDs []string, title, content string) []*offlinePush {
+	if c.pushTemplates == nil || (msg.OfflinePushInfo != nil && msg.OfflinePushInfo.Title != "") {
+		return []*offlinePush{{userIDs: userIDs, title: title, content: content}}
+	}
+	data := &pushTemplateData{SenderNickname: msg.SenderNickname, Text: pushText(msg)}
+	if msg.SessionType == constant.ReadGroupChatType || msg.SessionType == constant.WriteGroupChatType {
+		groupInfo, err := c.groupLocalCache.GetGroupInfo(ctx, msg.GroupID)
+		if err != nil {
+			log.ZWarn(ctx, "get group info of push template failed", err, "groupID", msg.GroupID)
+		} else {
+			data.GroupName = groupInfo.GroupName
+		}
+	}
+	atUserIDs := datautil.SliceSet(msg.AtUserIDList)
+	_, atAll := atUserIDs[constant.AtAllString]
+	return c.renderOfflinePush(ctx, userIDs, title, content, data, func(userID string) string {
+		_, mentioned := atUserIDs[userID]
+		return pushTemplateKind(msg.ContentType, atAll || mentioned)
+	})
+}
+
+// localizeSummaryPush renders the title and content of the summary push of count stale msgs by the summary
+// templates of the locales of the users, the users without templates get the title and content by default.
+func (c *ConsumerHandler) localizeSummaryPush(ctx context.Context, userIDs []string, count int64, title, content string) []*offlinePush {
+	if c.pushTemplates == nil {
+		return []*offlinePush{{userIDs: userIDs, title: title, content: content}}
+	}
+	return c.renderOfflinePush(ctx, userIDs, title, content, &pushTemplateData{Count: count}, func(string) string {
+		return pushTemplateSummary
+	})
+}
+
+// renderOfflinePush renders the data by the template of the kind of each user in its locale, the users with
+// the same rendered title and content are pushed together.
+func (c *ConsumerHandler) renderOfflinePush(ctx context.Context, userIDs []string, title, content string,
+	data *pushTemplateData, kind func(userID string) string) []*offlinePush {
+	locales := c.getUserLocales(ctx, userIDs)
+	type rendered struct {
+		title   string
+		content string
+	}
+	var (
+		renders = make(map[[2]string]rendered)
+		pushes  = make(map[rendered]*offlinePush)
+		result  []*offlinePush
+	)
+	for _, userID := range userIDs {
+		key := [2]string{locales[userID], kind(userID)}
+		r, ok := renders[key]
+		if !ok {
+			r = rendered{title: title, content: content}
+			if t := c.pushTemplates.lookup(key[0], key[1]); t != nil {
+				renderedTitle, renderedContent, err := t.render(data)
+				if err != nil {
+					log.ZWarn(ctx, "render push template failed", err, "locale", key[0], "kind", key[1])
+				} else if renderedTitle != "" {
+					r = rendered{title: renderedTitle, content: renderedContent}
+				}
+			}
+			renders[key] = r
+		}
+		push, ok := pushes[r]
+		if !ok {
+			push = &offlinePush{title: r.title, content: r.content}
+			pushes[r] = push
+			result = append(result, push)
+		}
+		push.userIDs = append(push.userIDs, userID)
+	}
+	return result
+}
+
+// getUserLocales returns the locales of the users, the users without locales are omitted.
+func (c *ConsumerHandler) getUserLocales(ctx context.Context, userIDs []string) map[string]string {
+	// the locales are only got by the app managers
+	ctx = mcontext.SetOpUserID(ctx, c.config.Share.IMAdminUserID[0])
+	locales := make(map[string]string)
+	for _, userID := range userIDs {
+		locale, err := c.userLocalCache.GetUserLocale(ctx, userID)
+		if err != nil {
+			log.ZWarn(ctx, "get user locale failed", err, "userID", userID)
+			continue
+		}
+		if locale != "" {
+			locales[userID] = locale
+		}
+	}
+	return locales
+}
@@ -0,0 +1,119 @@
+package push
+
+import (
+	"context"
+	"testing"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/userext"
+	"github.com/openimsdk/open-im-server/v3/pkg/rpccache"
+	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/mcontext"
+	"google.golang.org/grpc"
+)
+
+type localeClient struct {
+	userext.UserExtClient
+	locales map[string]string
+}
+
+func (l *localeClient) GetUserLocales(ctx context.Context, req *userext.GetUserLocalesReq, _ ...grpc.CallOption) (*userext.GetUserLocalesResp, error) {
+	if opUserID := mcontext.GetOpUserID(ctx); opUserID != "admin" {
+		return nil, errs.ErrNoPermission.WrapMsg("not admin", "opUserID", opUserID)
+	}
+	locales := make(map[string]string)
+	for _, userID := range req.UserIDs {
+		if locale, ok := l.locales[userID]; ok {
+			locales[userID] = locale
+		}
+	}
+	return &userext.GetUserLocalesResp{Locales: locales}, nil
+}
+
+func newLocaleHandler(templates *pushTemplates, locales map[string]string) *ConsumerHandler {
+	c := &ConsumerHandler{
+		pushTemplates: templates,
+		userLocalCache: rpccache.NewUserLocalCache(rpcclient.UserRpcClient{ExtClient: &localeClient{locales: locales}},
+			&config.LocalCache{}, nil),
+		config: &Config{},
+	}
+	c.config.Share.IMAdminUserID = []string{"admin"}
+	return c
+}
+
+func TestLocalizeOfflinePush(t *testing.T) {
+	var conf config.Push
+	conf.Templates.DefaultLocale = "en"
+	conf.Templates.Locales = map[string]config.PushLocaleTemplates{
+		"en": {
+			Text:    config.PushTemplate{Title: "{{.SenderNickname}}", Content: "{{.Text}}"},
+			Mention: config.PushTemplate{Title: "{{.SenderNickname}} mentioned you", Content: "{{.Text}}"},
+		},
+		"zh": {
+			Text: config.PushTemplate{Title: "{{.SenderNickname}}说"},
+		},
+	}
+	templates, err := newPushTemplates(&conf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := newLocaleHandler(templates, map[string]string{"zh1": "zh_CN", "zh2": "zh", "fr": "fr"})
+	msg := &sdkws.MsgData{
+		SenderNickname: "Alice",
+		SessionType:    constant.SingleChatType,
+		ContentType:    constant.AtText,
+		Content:        []byte(`{"text":"hi @Bob"}`),
+		AtUserIDList:   []string{"bob"},
+	}
+	pushes := c.localizeOfflinePush(context.Background(), msg, []string{"bob", "zh1", "zh2", "fr"}, "[@TEXT]", "[@TEXT]")
+	expected := []offlinePush{
+		{userIDs: []string{"bob"}, title: "Alice mentioned you", content: "hi @Bob"},
+		{userIDs: []string{"zh1", "zh2"}, title: "Alice说", content: "Alice说"},
+		{userIDs: []string{"fr"}, title: "Alice", content: "hi @Bob"},
+	}
+	if len(pushes) != len(expected) {
+		t.Fatalf("got %d pushes, want %d", len(pushes), len(expected))
+	}
+	for i, push := range pushes {
+		if push.title != expected[i].title || push.content != expected[i].content ||
+			len(push.userIDs) != len(expected[i].userIDs) {
+			t.Errorf("push %d is %+v, want %+v", i, *push, expected[i])
+		}
+	}
+
+	msg.ContentType = constant.Picture
+	pushes = c.localizeOfflinePush(context.Background(), msg, []string{"bob", "zh1"}, "[PICTURE]", "[PICTURE]")
+	if len(pushes) != 1 || pushes[0].title != "[PICTURE]" || len(pushes[0].userIDs) != 2 {
+		t.Fatalf("pushes without templates are not by default: %+v", pushes)
+	}
+}
+
+func TestLocalizeSummaryPush(t *testing.T) {
+	var conf config.Push
+	conf.Templates.Locales = map[string]config.PushLocaleTemplates{
+		"zh": {
+			Summary: config.PushTemplate{Title: "新消息", Content: "你有{{.Count}}条新消息"},
+		},
+	}
+	templates, err := newPushTemplates(&conf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := newLocaleHandler(templates, map[string]string{"zh": "zh-CN"})
+	pushes := c.localizeSummaryPush(context.Background(), []string{"zh", "en"}, 3, "New messages", "You have 3 new messages")
+	expected := []offlinePush{
+		{userIDs: []string{"zh"}, title: "新消息", content: "你有3条新消息"},
+		{userIDs: []string{"en"}, title: "New messages", content: "You have 3 new messages"},
+	}
+	if len(pushes) != len(expected) {
+		t.Fatalf("got %d pushes, want %d", len(pushes), len(expected))
+	}
+	for i, push := range pushes {
+		if push.title != expected[i].title || push.content != expected[i].content {
+			t.Errorf("push %d is %+v, want %+v", i, *push, expected[i])
+		}
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package push
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/mcontext"
+)
+
+const (
+	// The policies of the stale msgs, push pushes them online and offline as the other msgs, summary pushes them
+	// online and collapses their offline pushes, drop pushes them neither online nor offline.
+	stalePushPush    = "push"
+	stalePushSummary = "summary"
+	stalePushDrop    = "drop"
+
+	// Default summary window if it is not configured.
+	defaultPushSummaryWindow = 30 * time.Second
+
+	// pushSummaryTakeCount is the max number of the due summaries taken at a time.
+	pushSummaryTakeCount    = 100
+	pushSummaryPollInterval = time.Second
+)
+
+// stalePush decides how the msgs consumed long after they were sent are pushed, e.g. after a restart
+// or a lag of the push consumer.
+type stalePush struct {
+	policy     string
+	thresholds map[int32]time.Duration
+}
+
+func newStalePush(conf *config.Push) (*stalePush, error) {
+	s := &stalePush{
+		policy: conf.StalePush.Policy,
+		thresholds: map[int32]time.Duration{
+			constant.SingleChatType:       time.Duration(conf.StalePush.Threshold.Single) * time.Second,
+			constant.WriteGroupChatType:   time.Duration(conf.StalePush.Threshold.Group) * time.Second,
+			constant.ReadGroupChatType:    time.Duration(conf.StalePush.Threshold.Group) * time.Second,
+			constant.NotificationChatType: time.Duration(conf.StalePush.Threshold.Notification) * time.Second,
+		},
+	}
+	switch s.policy {
+	case "":
+		s.policy = stalePushPush
+	case stalePushPush, stalePushSummary, stalePushDrop:
+	default:
+		return nil, errs.ErrArgs.WrapMsg("invalid stale push policy", "policy", s.policy)
+	}
+	return s, nil
+}
+
+func (s *stalePush) stale(msg *sdkws.MsgData) bool {
+	threshold := s.thresholds[msg.SessionType]
+	return threshold > 0 && time.Since(time.UnixMilli(msg.SendTime)) > threshold
+}
+
+// pushSummary collapses the offline pushes of the stale msgs of a user in a window into one summary push.
+// The summaries are counted in redis and due at the end of their windows, so that the msgs of the user consumed
+// by different push nodes are collapsed together and a due summary is pushed by one of the nodes polling them.
+type pushSummary struct {
+	cache  cache.PushSummaryCache
+	window time.Duration
+	// push pushes the summaries by the numbers of their msgs by userID.
+	push func(ctx context.Context, counts map[string]int64)
+}
+
+func newPushSummary(conf *config.Push, cache cache.PushSummaryCache, push func(ctx context.Context, counts map[string]int64)) *pushSummary {
+	s := &pushSummary{
+		cache:  cache,
+		window: time.Duration(conf.StalePush.Summary.Window) * time.Second,
+		push:   push,
+	}
+	if s.window <= 0 {
+		s.window = defaultPushSummaryWindow
+	}
+	return s
+}
+
+func (s *pushSummary) add(ctx context.Context, userIDs []string) {
+	if err := s.cache.IncrPushSummaries(ctx, userIDs, time.Now().Add(s.window)); err != nil {
+		log.ZError(ctx, "incr push summaries failed", err, "userIDs", userIDs)
+	}
+}
+
+func (s *pushSummary) start(ctx context.Context) {
+	ticker := time.NewTicker(pushSummaryPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			s.flushDue(mcontext.SetOperationID(ctx, fmt.Sprintf("push_summary_%d_%d", os.Getpid(), now.UnixMilli())))
+		}
+	}
+}
+
+// flushDue pushes the due summaries until no summary is due.
+func (s *pushSummary) flushDue(ctx context.Context) {
+	for {
+		counts, err := s.cache.TakeDuePushSummaries(ctx, pushSummaryTakeCount)
+		if err != nil {
+			log.ZError(ctx, "take due push summaries failed", err)
+			return
+		}
+		if len(counts) != 0 {
+			s.push(ctx, counts)
+		}
+		if len(counts) < pushSummaryTakeCount {
+			return
+		}
+	}
+}
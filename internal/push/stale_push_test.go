@@ -0,0 +1,100 @@
+package push
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/sdkws"
+)
+
+func TestStalePush(t *testing.T) {
+	var conf config.Push
+	conf.StalePush.Threshold.Single = 10
+	conf.StalePush.Threshold.Group = 60
+	s, err := newStalePush(&conf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.policy != stalePushPush {
+		t.Fatalf("default policy %s, want %s", s.policy, stalePushPush)
+	}
+	sendTime := time.Now().Add(-30 * time.Second).UnixMilli()
+	for sessionType, stale := range map[int32]bool{
+		constant.SingleChatType:       true,
+		constant.ReadGroupChatType:    false,
+		constant.NotificationChatType: false,
+	} {
+		if got := s.stale(&sdkws.MsgData{SessionType: sessionType, SendTime: sendTime}); got != stale {
+			t.Errorf("session type %d stale %t, want %t", sessionType, got, stale)
+		}
+	}
+	conf.StalePush.Policy = "unknown"
+	if _, err := newStalePush(&conf); err == nil {
+		t.Fatal("invalid policy accepted")
+	}
+}
+
+type memSummaryCache struct {
+	mu       sync.Mutex
+	counts   map[string]int64
+	dueTimes map[string]time.Time
+}
+
+func (m *memSummaryCache) IncrPushSummaries(_ context.Context, userIDs []string, dueTime time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, userID := range userIDs {
+		m.counts[userID]++
+		if m.counts[userID] == 1 {
+			m.dueTimes[userID] = dueTime
+		}
+	}
+	return nil
+}
+
+func (m *memSummaryCache) TakeDuePushSummaries(_ context.Context, count int) (map[string]int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	due := make(map[string]int64)
+	for userID, dueTime := range m.dueTimes {
+		if len(due) == count {
+			break
+		}
+		if dueTime.After(time.Now()) {
+			continue
+		}
+		due[userID] = m.counts[userID]
+		delete(m.counts, userID)
+		delete(m.dueTimes, userID)
+	}
+	return due, nil
+}
+
+func TestPushSummary(t *testing.T) {
+	var conf config.Push
+	conf.StalePush.Summary.Window = 60
+	cache := &memSummaryCache{counts: make(map[string]int64), dueTimes: make(map[string]time.Time)}
+	var pushed []map[string]int64
+	s := newPushSummary(&conf, cache, func(ctx context.Context, counts map[string]int64) {
+		pushed = append(pushed, counts)
+	})
+	ctx := context.Background()
+	s.add(ctx, []string{"a", "b"})
+	s.add(ctx, []string{"a"})
+	s.add(ctx, []string{"a"})
+	s.flushDue(ctx)
+	if len(pushed) != 0 {
+		t.Fatalf("pushed %v before the window ends", pushed)
+	}
+	cache.dueTimes["a"] = time.Now()
+	cache.dueTimes["b"] = time.Now()
+	s.flushDue(ctx)
+	if want := []map[string]int64{{"a": 3, "b": 1}}; !reflect.DeepEqual(pushed, want) {
+		t.Fatalf("pushed %v, want %v", pushed, want)
+	}
+}
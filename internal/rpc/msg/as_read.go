@@ -116,6 +116,9 @@ func (m *msgServer) MarkMsgsAsRead(ctx context.Context, req *msg.MarkMsgsAsReadR
 			return nil, err
 		}
 	}
+	if err := m.startBurnMsgs(ctx, conversation, req.UserID, req.Seqs); err != nil {
+		return nil, err
+	}
 
 	reqCallback := &cbapi.CallbackSingleMsgReadReq{
 		ConversationID: conversation.ConversationID,
@@ -156,6 +159,9 @@ func (m *msgServer) MarkConversationAsRead(ctx context.Context, req *msg.MarkCon
 			if err = m.MsgDatabase.MarkSingleChatMsgsAsRead(ctx, req.UserID, req.ConversationID, seqs); err != nil {
 				return nil, err
 			}
+			if err := m.startBurnMsgs(ctx, conversation, req.UserID, seqs); err != nil {
+				return nil, err
+			}
 		}
 		if req.HasReadSeq > hasReadSeq {
 			err = m.MsgDatabase.SetHasReadSeq(ctx, req.UserID, req.ConversationID, req.HasReadSeq)
@@ -0,0 +1,211 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/conversation"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/utils/datautil"
+	"github.com/openimsdk/tools/utils/idutil"
+)
+
+const (
+	// burnMsgInterval is how often the msgs whose burn time has passed are deleted.
+	burnMsgInterval = time.Second
+	// burnMsgBatchSize is the max number of msgs burned in one round.
+	burnMsgBatchSize = 500
+	// burnMsgLease is how long the claimed msgs are held by the instance, they are claimed again if neither
+	// burned nor delayed within it (e.g. the instance restarted).
+	burnMsgLease = time.Minute
+	// burnMsgMaxAttempts is the max number of attempts to burn a msg, the retries are backed off
+	// from burnMsgRetryBackoff, doubled each time up to burnMsgMaxRetryBackoff.
+	burnMsgMaxAttempts     = 10
+	burnMsgRetryBackoff    = time.Second * 5
+	burnMsgMaxRetryBackoff = time.Minute * 10
+)
+
+// startBurnMsgs starts the burn timer of the msgs read by userID in a private chat,
+// the msgs sent by userID itself are burned when the peer reads them.
+func (m *msgServer) startBurnMsgs(ctx context.Context, conversation *conversation.Conversation, userID string, seqs []int64) error {
+	if !conversation.IsPrivateChat || conversation.BurnDuration <= 0 || len(seqs) == 0 ||
+		conversation.ConversationType != constant.SingleChatType {
+		return nil
+	}
+	_, _, msgs, err := m.MsgDatabase.GetMsgBySeqs(ctx, userID, conversation.ConversationID, seqs)
+	if err != nil {
+		return err
+	}
+	var burnSeqs []int64
+	for _, msg := range msgs {
+		if msg == nil || msg.SendID == userID || msg.Status == constant.MsgDeleted {
+			continue
+		}
+		burnSeqs = append(burnSeqs, msg.Seq)
+	}
+	if len(burnSeqs) == 0 {
+		return nil
+	}
+	burnTime := time.Now().Add(time.Duration(conversation.BurnDuration) * time.Second)
+	log.ZDebug(ctx, "start burn msgs", "conversationID", conversation.ConversationID, "seqs", burnSeqs, "burnTime", burnTime)
+	return m.MsgDatabase.AddBurnMsgs(ctx, conversation.ConversationID, burnSeqs, burnTime)
+}
+
+func (m *msgServer) burnMsgsLoop(ctx context.Context) {
+	ctx = mcontext.SetOpUserID(ctx, m.config.Share.IMAdminUserID[0])
+	ticker := time.NewTicker(burnMsgInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			m.burnDueMsgs(mcontext.SetOperationID(ctx, fmt.Sprintf("burn_%d_%d", os.Getpid(), now.UnixMilli())))
+		}
+	}
+}
+
+// burnDueMsgs physically deletes the msgs whose burn time has passed for both sides of the private chat.
+// The msgs are leased from the burn queue and removed from it only after they are deleted, so a msg is burned
+// at least once.
+func (m *msgServer) burnDueMsgs(ctx context.Context) {
+	burnMsgs, err := m.MsgDatabase.ClaimDueBurnMsgs(ctx, burnMsgBatchSize, burnMsgLease)
+	if err != nil {
+		log.ZError(ctx, "claim due burn msgs failed", err)
+		return
+	}
+	for conversationID, seqs := range burnMsgs {
+		// a msg only in the cache would be written back by the mongo consumer after it is burned
+		msgs, unpersistedSeqs, err := m.MsgDatabase.GetPersistedMsgs(ctx, conversationID, seqs)
+		if err != nil {
+			log.ZError(ctx, "get persisted burn msgs failed", err, "conversationID", conversationID, "seqs", seqs)
+			m.retryBurnMsgs(ctx, conversationID, seqs, err)
+			continue
+		}
+		burnSeqs := datautil.Slice(msgs, func(msg *model.MsgDataModel) int64 { return msg.Seq })
+		// the msgs neither persisted nor cached have been burned by a claim whose finish failed
+		if burnedSeqs := datautil.Single(seqs, append(burnSeqs, unpersistedSeqs...)); len(burnedSeqs) > 0 {
+			if err := m.MsgDatabase.FinishBurnMsgs(ctx, conversationID, burnedSeqs); err != nil {
+				log.ZWarn(ctx, "finish burn msgs failed", err, "conversationID", conversationID, "seqs", burnedSeqs)
+			}
+		}
+		if len(unpersistedSeqs) > 0 {
+			log.ZDebug(ctx, "burn msgs not persisted yet", "conversationID", conversationID, "seqs", unpersistedSeqs)
+			m.retryBurnMsgs(ctx, conversationID, unpersistedSeqs, errs.New("msgs not persisted"))
+		}
+		if len(burnSeqs) == 0 {
+			continue
+		}
+		if err := m.MsgDatabase.DeleteMsgsPhysicalBySeqs(ctx, conversationID, burnSeqs); err != nil {
+			log.ZError(ctx, "burn msgs failed", err, "conversationID", conversationID, "seqs", burnSeqs)
+			m.retryBurnMsgs(ctx, conversationID, burnSeqs, err)
+			continue
+		}
+		// the msgs failed to be removed are claimed again and deleted idempotently
+		if err := m.MsgDatabase.FinishBurnMsgs(ctx, conversationID, burnSeqs); err != nil {
+			log.ZWarn(ctx, "finish burn msgs failed", err, "conversationID", conversationID, "seqs", burnSeqs)
+		}
+		m.deleteIndexedMsgs(ctx, conversationID, burnSeqs)
+		m.deletePinnedMsgs(ctx, conversationID, burnSeqs)
+		m.burnMsgsNotification(ctx, conversationID, msgs)
+	}
+}
+
+// retryBurnMsgs delays the msgs failed to burn by burnErr with backoff, the msgs failed too many times are given up
+// to the dead letters.
+func (m *msgServer) retryBurnMsgs(ctx context.Context, conversationID string, seqs []int64, burnErr error) {
+	attempts, err := m.MsgDatabase.IncrBurnMsgAttempts(ctx, conversationID, seqs)
+	if err != nil {
+		log.ZError(ctx, "incr burn msg attempts failed", err, "conversationID", conversationID, "seqs", seqs)
+		attempts = make(map[int64]int64)
+	}
+	var (
+		retrySeqs  = make(map[time.Duration][]int64)
+		giveUpSeqs []int64
+	)
+	for _, seq := range seqs {
+		n := max(attempts[seq], 1)
+		if n >= burnMsgMaxAttempts {
+			giveUpSeqs = append(giveUpSeqs, seq)
+			continue
+		}
+		backoff := min(burnMsgRetryBackoff<<(n-1), burnMsgMaxRetryBackoff)
+		retrySeqs[backoff] = append(retrySeqs[backoff], seq)
+	}
+	if len(giveUpSeqs) > 0 {
+		if err := m.giveUpBurnMsgs(ctx, conversationID, giveUpSeqs, burnErr); err != nil {
+			log.ZError(ctx, "give up burning msgs failed", err, "conversationID", conversationID, "seqs", giveUpSeqs)
+			retrySeqs[burnMsgMaxRetryBackoff] = append(retrySeqs[burnMsgMaxRetryBackoff], giveUpSeqs...)
+		}
+	}
+	for backoff, seqs := range retrySeqs {
+		// the msgs failed to be delayed are claimed again after the lease
+		if err := m.MsgDatabase.DelayBurnMsgs(ctx, conversationID, seqs, time.Now().Add(backoff)); err != nil {
+			log.ZError(ctx, "delay burn msgs failed", err, "conversationID", conversationID, "seqs", seqs)
+		}
+	}
+}
+
+// giveUpBurnMsgs moves the msgs from the burn queue to the dead letters for manual review.
+func (m *msgServer) giveUpBurnMsgs(ctx context.Context, conversationID string, seqs []int64, burnErr error) error {
+	now := time.Now()
+	task := &model.MsgRetryTask{
+		TaskID:         idutil.GetMsgIDByMD5(conversationID),
+		Kind:           model.MsgRetryBurn,
+		ConversationID: conversationID,
+		Seqs:           seqs,
+		Attempts:       burnMsgMaxAttempts,
+		ErrMsg:         burnErr.Error(),
+		OperationID:    mcontext.GetOperationID(ctx),
+		CreateTime:     now,
+		DeadTime:       now,
+	}
+	if err := m.MsgRetryDatabase.KillMsgRetry(ctx, task); err != nil {
+		return err
+	}
+	log.ZError(ctx, "give up burning msgs", burnErr, "conversationID", conversationID, "seqs", seqs, "taskID", task.TaskID)
+	if err := m.MsgDatabase.FinishBurnMsgs(ctx, conversationID, seqs); err != nil {
+		// the msgs claimed again are given up again, the dead letter is replaced
+		log.ZWarn(ctx, "finish burn msgs failed", err, "conversationID", conversationID, "seqs", seqs)
+	}
+	return nil
+}
+
+// burnMsgsNotification notifies both sides of the burned msgs on behalf of the readers, who started the burn timers.
+func (m *msgServer) burnMsgsNotification(ctx context.Context, conversationID string, msgs []*model.MsgDataModel) {
+	type readerPeer struct {
+		readerID string
+		peerID   string
+	}
+	seqs := make(map[readerPeer][]int64)
+	for _, msg := range msgs {
+		key := readerPeer{readerID: msg.RecvID, peerID: msg.SendID}
+		seqs[key] = append(seqs[key], msg.Seq)
+	}
+	for key, seqs := range seqs {
+		tips := &sdkws.DeleteMsgsTips{UserID: key.readerID, ConversationID: conversationID, Seqs: seqs}
+		m.notificationSender.NotificationWithSessionType(ctx, key.readerID, key.peerID,
+			constant.DeleteMsgsNotification, constant.SingleChatType, tips)
+	}
+}
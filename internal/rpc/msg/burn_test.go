@@ -0,0 +1,164 @@
+package msg
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
+	pbmsg "github.com/openimsdk/protocol/msg"
+)
+
+// burnMsgDatabase keeps a burn queue in memory, the claimed msgs stay queued until they are finished.
+type burnMsgDatabase struct {
+	controller.CommonMsgDatabase
+	queue     map[int64]time.Time
+	persisted []int64
+	cached    []int64
+	deleteErr error
+	attempts  map[int64]int64
+	deleted   []int64
+}
+
+func (b *burnMsgDatabase) ClaimDueBurnMsgs(_ context.Context, _ int64, lease time.Duration) (map[string][]int64, error) {
+	now := time.Now()
+	var seqs []int64
+	for seq, burnTime := range b.queue {
+		if !burnTime.After(now) {
+			seqs = append(seqs, seq)
+			b.queue[seq] = now.Add(lease)
+		}
+	}
+	slices.Sort(seqs)
+	return map[string][]int64{"si_a_b": seqs}, nil
+}
+
+func (b *burnMsgDatabase) GetPersistedMsgs(_ context.Context, _ string, seqs []int64) ([]*model.MsgDataModel, []int64, error) {
+	var (
+		msgs            []*model.MsgDataModel
+		unpersistedSeqs []int64
+	)
+	for _, seq := range seqs {
+		if slices.Contains(b.persisted, seq) {
+			msgs = append(msgs, &model.MsgDataModel{Seq: seq, SendID: "a", RecvID: "b"})
+		} else if slices.Contains(b.cached, seq) {
+			unpersistedSeqs = append(unpersistedSeqs, seq)
+		}
+	}
+	return msgs, unpersistedSeqs, nil
+}
+
+func (b *burnMsgDatabase) DeleteMsgsPhysicalBySeqs(_ context.Context, _ string, seqs []int64) error {
+	if b.deleteErr != nil {
+		return b.deleteErr
+	}
+	b.deleted = append(b.deleted, seqs...)
+	return nil
+}
+
+func (b *burnMsgDatabase) DelayBurnMsgs(_ context.Context, _ string, seqs []int64, burnTime time.Time) error {
+	for _, seq := range seqs {
+		if _, ok := b.queue[seq]; ok {
+			b.queue[seq] = burnTime
+		}
+	}
+	return nil
+}
+
+func (b *burnMsgDatabase) IncrBurnMsgAttempts(_ context.Context, _ string, seqs []int64) (map[int64]int64, error) {
+	for _, seq := range seqs {
+		b.attempts[seq]++
+	}
+	return b.attempts, nil
+}
+
+func (b *burnMsgDatabase) FinishBurnMsgs(_ context.Context, _ string, seqs []int64) error {
+	for _, seq := range seqs {
+		delete(b.queue, seq)
+		delete(b.attempts, seq)
+	}
+	return nil
+}
+
+type burnPinnedMsgDatabase struct {
+	controller.PinnedMsgDatabase
+}
+
+func (burnPinnedMsgDatabase) UnpinMsgs(context.Context, string, []int64) (int64, error) {
+	return 0, nil
+}
+
+type burnDeadLetters struct {
+	controller.MsgRetryDatabase
+	tasks []*model.MsgRetryTask
+}
+
+func (d *burnDeadLetters) KillMsgRetry(_ context.Context, task *model.MsgRetryTask) error {
+	d.tasks = append(d.tasks, task)
+	return nil
+}
+
+func newBurnMsgServer(db *burnMsgDatabase, deadLetters *burnDeadLetters) *msgServer {
+	return &msgServer{
+		MsgDatabase:       db,
+		PinnedMsgDatabase: burnPinnedMsgDatabase{},
+		MsgRetryDatabase:  deadLetters,
+		notificationSender: NewMsgNotificationSender(&Config{}, rpcclient.WithLocalSendMsg(func(context.Context, *pbmsg.SendMsgReq) (*pbmsg.SendMsgResp, error) {
+			return &pbmsg.SendMsgResp{}, nil
+		})),
+	}
+}
+
+func TestBurnDueMsgs(t *testing.T) {
+	due := time.Now().Add(-time.Second)
+	db := &burnMsgDatabase{
+		queue:     map[int64]time.Time{1: due, 2: due, 3: due, 4: time.Now().Add(time.Hour)},
+		persisted: []int64{1},
+		cached:    []int64{2},
+		attempts:  make(map[int64]int64),
+	}
+	m := newBurnMsgServer(db, &burnDeadLetters{})
+	m.burnDueMsgs(context.Background())
+	if !reflect.DeepEqual(db.deleted, []int64{1}) {
+		t.Fatalf("deleted %v, want [1]", db.deleted)
+	}
+	// 1 is burned, 3 was burned before, 2 waits to be persisted and 4 is not due
+	burnTime, ok := db.queue[2]
+	if len(db.queue) != 2 || !ok || !burnTime.Before(time.Now().Add(burnMsgRetryBackoff)) {
+		t.Fatalf("queue %v, want 2 delayed by the backoff and 4", db.queue)
+	}
+	if db.attempts[2] != 1 {
+		t.Fatalf("attempts of 2: %d, want 1", db.attempts[2])
+	}
+}
+
+func TestBurnDueMsgsFailed(t *testing.T) {
+	db := &burnMsgDatabase{
+		queue:     map[int64]time.Time{1: time.Now().Add(-time.Second)},
+		persisted: []int64{1},
+		deleteErr: errors.New("mongo unavailable"),
+		attempts:  make(map[int64]int64),
+	}
+	deadLetters := &burnDeadLetters{}
+	m := newBurnMsgServer(db, deadLetters)
+	for i := 1; i < burnMsgMaxAttempts; i++ {
+		m.burnDueMsgs(context.Background())
+		if _, ok := db.queue[1]; !ok || db.attempts[1] != int64(i) {
+			t.Fatalf("attempt %d: the failed msg must stay queued, queue %v attempts %v", i, db.queue, db.attempts)
+		}
+		db.queue[1] = time.Now().Add(-time.Second)
+	}
+	m.burnDueMsgs(context.Background())
+	if len(db.queue) != 0 || len(deadLetters.tasks) != 1 {
+		t.Fatalf("queue %v dead letters %d, want the msg given up to the dead letters", db.queue, len(deadLetters.tasks))
+	}
+	task := deadLetters.tasks[0]
+	if task.Kind != model.MsgRetryBurn || task.ConversationID != "si_a_b" || !reflect.DeepEqual(task.Seqs, []int64{1}) || task.ErrMsg == "" {
+		t.Fatalf("dead letter %+v", task)
+	}
+}
@@ -74,6 +74,8 @@ func (m *msgServer) DeleteMsgs(ctx context.Context, req *msg.DeleteMsgsReq) (*ms
 		if err := m.MsgDatabase.DeleteMsgsPhysicalBySeqs(ctx, req.ConversationID, req.Seqs); err != nil {
 			return nil, err
 		}
+		m.deleteIndexedMsgs(ctx, req.ConversationID, req.Seqs)
+		m.deletePinnedMsgs(ctx, req.ConversationID, req.Seqs)
 		conversations, err := m.Conversation.GetConversationsByConversationID(ctx, []string{req.ConversationID})
 		if err != nil {
 			return nil, err
@@ -104,6 +106,8 @@ func (m *msgServer) DeleteMsgPhysicalBySeq(ctx context.Context, req *msg.DeleteM
 	if err != nil {
 		return nil, err
 	}
+	m.deleteIndexedMsgs(ctx, req.ConversationID, req.Seqs)
+	m.deletePinnedMsgs(ctx, req.ConversationID, req.Seqs)
 	return &msg.DeleteMsgPhysicalBySeqResp{}, nil
 }
 
@@ -0,0 +1,135 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgext"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+// ModifiableContentType are the content types whose content can be modified after sending.
+var ModifiableContentType = []int32{constant.Text, constant.AtText, constant.Quote, constant.Custom}
+
+func (m *msgServer) ModifyMsg(ctx context.Context, req *msgext.ModifyMsgReq) (*msgext.ModifyMsgResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	user, err := m.UserLocalCache.GetUserInfo(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	_, _, msgs, err := m.MsgDatabase.GetMsgBySeqs(ctx, req.UserID, req.ConversationID, []int64{req.Seq})
+	if err != nil {
+		return nil, err
+	}
+	if len(msgs) == 0 || msgs[0] == nil {
+		return nil, errs.ErrRecordNotFound.WrapMsg("msg not found")
+	}
+	if msgs[0].ContentType == constant.MsgRevokeNotification {
+		return nil, servererrs.ErrMsgAlreadyRevoke.WrapMsg("msg already revoke")
+	}
+	if !datautil.Contain(msgs[0].ContentType, ModifiableContentType...) {
+		return nil, errs.ErrArgs.WrapMsg("msg content type can not be modified", "contentType", msgs[0].ContentType)
+	}
+	if _, err := m.checkMsgOperatorRole(ctx, req.UserID, user, msgs[0]); err != nil {
+		return nil, err
+	}
+	if m.config.RpcConfig.SensitiveWord.Enable {
+		content, hit, err := m.filterSensitiveWords(msgs[0].ContentType, []byte(req.Content))
+		if err != nil {
+			return nil, err
+		}
+		if hit && m.config.RpcConfig.SensitiveWord.Mode == sensitiveWordFlag {
+			log.ZInfo(ctx, "modified msg contains sensitive words", "userID", req.UserID, "clientMsgID", msgs[0].ClientMsgID)
+		}
+		req.Content = string(content)
+	}
+	if string(msgs[0].Content) == req.Content {
+		return &msgext.ModifyMsgResp{}, nil
+	}
+	now := time.Now().UnixMilli()
+	err = m.MsgDatabase.ModifyMsg(ctx, req.ConversationID, req.Seq, req.Content, &model.ModifyModel{
+		UserID:   req.UserID,
+		Nickname: user.Nickname,
+		Content:  string(msgs[0].Content),
+		Time:     now,
+	})
+	if err != nil {
+		return nil, err
+	}
+	m.indexModifiedMsg(ctx, req.ConversationID, msgs[0], req.Content)
+	modifierUserID := mcontext.GetOpUserID(ctx)
+	var recvID string
+	if msgs[0].SessionType == constant.ReadGroupChatType {
+		recvID = msgs[0].GroupID
+	} else {
+		recvID = msgs[0].RecvID
+	}
+	m.notificationSender.MsgModifiedNotification(ctx, req.UserID, recvID, msgs[0].SessionType, &msgext.MsgModifiedTips{
+		ModifierUserID: modifierUserID,
+		ConversationID: req.ConversationID,
+		Seq:            req.Seq,
+		ClientMsgID:    msgs[0].ClientMsgID,
+		SessionType:    msgs[0].SessionType,
+		Content:        req.Content,
+		ModifyTime:     now,
+		IsAdminModify:  datautil.Contain(modifierUserID, m.config.Share.IMAdminUserID...),
+	})
+	return &msgext.ModifyMsgResp{ModifyTime: now}, nil
+}
+
+func (m *msgServer) GetMsgModifyHistory(ctx context.Context, req *msgext.GetMsgModifyHistoryReq) (*msgext.GetMsgModifyHistoryResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	if _, err := m.ConversationLocalCache.GetConversation(ctx, req.UserID, req.ConversationID); err != nil {
+		return nil, err
+	}
+	// the msg is got for the user first, so the history of a msg the user can not see is not returned
+	_, _, msgs, err := m.MsgDatabase.GetMsgBySeqs(ctx, req.UserID, req.ConversationID, []int64{req.Seq})
+	if err != nil {
+		return nil, err
+	}
+	if len(msgs) == 0 || msgs[0] == nil || msgs[0].Status == constant.MsgDeleted {
+		return nil, errs.ErrRecordNotFound.WrapMsg("msg not found")
+	}
+	if msgs[0].ContentType == constant.MsgRevokeNotification {
+		return nil, servererrs.ErrMsgAlreadyRevoke.WrapMsg("msg already revoke")
+	}
+	history, err := m.MsgDatabase.GetMsgModifyHistory(ctx, req.ConversationID, req.Seq)
+	if err != nil {
+		return nil, err
+	}
+	return &msgext.GetMsgModifyHistoryResp{
+		Records: datautil.Slice(history, func(h *model.ModifyModel) *msgext.MsgModifyRecord {
+			return &msgext.MsgModifyRecord{
+				UserID:     h.UserID,
+				Nickname:   h.Nickname,
+				Content:    h.Content,
+				ModifyTime: h.Time,
+			}
+		}),
+	}, nil
+}
@@ -17,6 +17,7 @@ package msg
 import (
 	"context"
 
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgext"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
 	"github.com/openimsdk/protocol/constant"
 	"github.com/openimsdk/protocol/sdkws"
@@ -48,3 +49,19 @@ func (m *MsgNotificationSender) MarkAsReadNotification(ctx context.Context, conv
 	}
 	m.NotificationWithSessionType(ctx, sendID, recvID, constant.HasReadReceipt, sessionType, tips)
 }
+
+func (m *MsgNotificationSender) MsgModifiedNotification(ctx context.Context, sendID, recvID string, sessionType int32, tips *msgext.MsgModifiedTips) {
+	m.NotificationWithSessionType(ctx, sendID, recvID, msgext.MsgModifiedNotification, sessionType, tips)
+}
+
+func (m *MsgNotificationSender) MsgReactionChangedNotification(ctx context.Context, sendID, recvID string, sessionType int32, tips *msgext.MsgReactionChangedTips) {
+	m.NotificationWithSessionType(ctx, sendID, recvID, msgext.MsgReactionChangedNotification, sessionType, tips)
+}
+
+func (m *MsgNotificationSender) MsgPinChangedNotification(ctx context.Context, sendID, recvID string, sessionType int32, tips *msgext.MsgPinChangedTips) {
+	m.NotificationWithSessionType(ctx, sendID, recvID, msgext.MsgPinChangedNotification, sessionType, tips)
+}
+
+func (m *MsgNotificationSender) ThreadHasReadNotification(ctx context.Context, tips *msgext.ThreadHasReadTips) {
+	m.Notification(ctx, tips.UserID, tips.UserID, msgext.ThreadHasReadNotification, tips)
+}
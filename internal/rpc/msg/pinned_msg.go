@@ -0,0 +1,243 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgext"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/conversation"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+func (m *msgServer) PinMsg(ctx context.Context, req *msgext.PinMsgReq) (*msgext.PinMsgResp, error) {
+	conv, err := m.checkPinMsgAccess(ctx, req.UserID, req.ConversationID)
+	if err != nil {
+		return nil, err
+	}
+	_, _, msgs, err := m.MsgDatabase.GetMsgBySeqs(ctx, req.UserID, req.ConversationID, []int64{req.Seq})
+	if err != nil {
+		return nil, err
+	}
+	if len(msgs) == 0 || msgs[0] == nil || msgs[0].Status == constant.MsgDeleted {
+		return nil, errs.ErrRecordNotFound.WrapMsg("msg not found")
+	}
+	msg := msgs[0]
+	if msg.ContentType == constant.MsgRevokeNotification {
+		return nil, servererrs.ErrMsgAlreadyRevoke.WrapMsg("msg already revoke")
+	}
+	now := time.Now()
+	pin := &model.PinnedMsg{
+		ConversationID: req.ConversationID,
+		Seq:            req.Seq,
+		ClientMsgID:    msg.ClientMsgID,
+		SessionType:    msg.SessionType,
+		PinnerUserID:   req.UserID,
+		PinTime:        now,
+	}
+	pinned, err := m.PinnedMsgDatabase.PinMsg(ctx, pin, msgext.MaxPinnedMsgs)
+	if err != nil {
+		return nil, err
+	}
+	if !pinned {
+		pin, err := m.getPinnedMsg(ctx, req.ConversationID, req.Seq)
+		if err != nil {
+			return nil, err
+		}
+		return &msgext.PinMsgResp{PinnedMsg: convertPinnedMsg(pin)}, nil
+	}
+	m.notificationSender.MsgPinChangedNotification(ctx, req.UserID, m.conversationAndGetRecvID(conv, req.UserID), msg.SessionType, &msgext.MsgPinChangedTips{
+		OpUserID:       req.UserID,
+		ConversationID: req.ConversationID,
+		Seq:            req.Seq,
+		ClientMsgID:    msg.ClientMsgID,
+		SessionType:    msg.SessionType,
+		IsPinned:       true,
+		PinTime:        now.UnixMilli(),
+	})
+	return &msgext.PinMsgResp{PinnedMsg: convertPinnedMsg(pin)}, nil
+}
+
+func (m *msgServer) UnpinMsg(ctx context.Context, req *msgext.UnpinMsgReq) (*msgext.UnpinMsgResp, error) {
+	conv, err := m.checkPinMsgAccess(ctx, req.UserID, req.ConversationID)
+	if err != nil {
+		return nil, err
+	}
+	pin, err := m.getPinnedMsg(ctx, req.ConversationID, req.Seq)
+	if err != nil {
+		if errs.ErrRecordNotFound.Is(err) {
+			return &msgext.UnpinMsgResp{}, nil
+		}
+		return nil, err
+	}
+	n, err := m.PinnedMsgDatabase.UnpinMsgs(ctx, req.ConversationID, []int64{req.Seq})
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return &msgext.UnpinMsgResp{}, nil
+	}
+	m.notificationSender.MsgPinChangedNotification(ctx, req.UserID, m.conversationAndGetRecvID(conv, req.UserID), pin.SessionType, &msgext.MsgPinChangedTips{
+		OpUserID:       req.UserID,
+		ConversationID: req.ConversationID,
+		Seq:            req.Seq,
+		ClientMsgID:    pin.ClientMsgID,
+		SessionType:    pin.SessionType,
+		IsPinned:       false,
+		PinTime:        pin.PinTime.UnixMilli(),
+	})
+	return &msgext.UnpinMsgResp{}, nil
+}
+
+func (m *msgServer) GetPinnedMsgs(ctx context.Context, req *msgext.GetPinnedMsgsReq) (*msgext.GetPinnedMsgsResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	if _, err := m.ConversationLocalCache.GetConversation(ctx, req.UserID, req.ConversationID); err != nil {
+		return nil, err
+	}
+	pins, err := m.PinnedMsgDatabase.GetPinnedMsgs(ctx, []string{req.ConversationID})
+	if err != nil {
+		return nil, err
+	}
+	if len(pins[req.ConversationID]) == 0 {
+		return &msgext.GetPinnedMsgsResp{}, nil
+	}
+	seqs := datautil.Slice(pins[req.ConversationID], func(pin *model.PinnedMsg) int64 { return pin.Seq })
+	_, _, msgs, err := m.MsgDatabase.GetMsgBySeqs(ctx, req.UserID, req.ConversationID, seqs)
+	if err != nil {
+		return nil, err
+	}
+	msgMap := make(map[int64]*sdkws.MsgData, len(msgs))
+	for _, msg := range msgs {
+		if msg != nil {
+			msgMap[msg.Seq] = msg
+		}
+	}
+	resp := &msgext.GetPinnedMsgsResp{PinnedMsgs: make([]*msgext.PinnedMsg, 0, len(seqs))}
+	for _, pin := range pins[req.ConversationID] {
+		// the msgs deleted by the user or out of its min seq are not returned
+		msg, ok := msgMap[pin.Seq]
+		if !ok || msg.Status == constant.MsgDeleted || msg.ContentType == constant.MsgRevokeNotification {
+			continue
+		}
+		pbPin := convertPinnedMsg(pin)
+		pbPin.Msg = msg
+		resp.PinnedMsgs = append(resp.PinnedMsgs, pbPin)
+	}
+	return resp, nil
+}
+
+func (m *msgServer) GetConversationsPinnedMsgs(ctx context.Context, req *msgext.GetConversationsPinnedMsgsReq) (*msgext.GetConversationsPinnedMsgsResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	conversationIDs, err := m.ConversationLocalCache.GetConversationIDs(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.ConversationIDs) > 0 {
+		conversationIDs = datautil.BothExist(conversationIDs, req.ConversationIDs)
+	}
+	pins, err := m.PinnedMsgDatabase.GetPinnedMsgs(ctx, conversationIDs)
+	if err != nil {
+		return nil, err
+	}
+	resp := &msgext.GetConversationsPinnedMsgsResp{PinnedMsgs: make(map[string]*msgext.PinnedMsgs, len(pins))}
+	for conversationID, conversationPins := range pins {
+		resp.PinnedMsgs[conversationID] = &msgext.PinnedMsgs{PinnedMsgs: datautil.Slice(conversationPins, convertPinnedMsg)}
+	}
+	return resp, nil
+}
+
+// checkPinMsgAccess checks whether userID can pin or unpin the msgs of the conversation,
+// in group chat only the owner and admins can unless the group allows the ordinary members to.
+func (m *msgServer) checkPinMsgAccess(ctx context.Context, userID string, conversationID string) (*conversation.Conversation, error) {
+	if err := authverify.CheckAccessV3(ctx, userID, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	conv, err := m.ConversationLocalCache.GetConversation(ctx, userID, conversationID)
+	if err != nil {
+		return nil, err
+	}
+	switch conv.ConversationType {
+	case constant.SingleChatType:
+		return conv, nil
+	case constant.ReadGroupChatType:
+	default:
+		return nil, errs.ErrArgs.WrapMsg("msgs of the conversation can not be pinned", "conversationType", conv.ConversationType)
+	}
+	if authverify.IsAppManagerUid(ctx, m.config.Share.IMAdminUserID) {
+		return conv, nil
+	}
+	member, err := m.GroupLocalCache.GetGroupMember(ctx, conv.GroupID, userID)
+	if err != nil {
+		if errs.ErrRecordNotFound.Is(err) {
+			return nil, servererrs.ErrNotInGroupYet.WrapMsg("user not in group", "groupID", conv.GroupID, "userID", userID)
+		}
+		return nil, err
+	}
+	if member.RoleLevel == constant.GroupOwner || member.RoleLevel == constant.GroupAdmin {
+		return conv, nil
+	}
+	groupInfo, err := m.GroupLocalCache.GetGroupInfo(ctx, conv.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	if !msgprocessor.IsGroupMemberPinMsgAllowed(groupInfo.Ex) {
+		return nil, errs.ErrNoPermission.WrapMsg("only the owner and admins can pin msgs")
+	}
+	return conv, nil
+}
+
+func (m *msgServer) getPinnedMsg(ctx context.Context, conversationID string, seq int64) (*model.PinnedMsg, error) {
+	pins, err := m.PinnedMsgDatabase.GetPinnedMsgs(ctx, []string{conversationID})
+	if err != nil {
+		return nil, err
+	}
+	for _, pin := range pins[conversationID] {
+		if pin.Seq == seq {
+			return pin, nil
+		}
+	}
+	return nil, errs.ErrRecordNotFound.WrapMsg("msg not pinned")
+}
+
+// deletePinnedMsgs unpins the revoked or physically deleted msgs,
+// the clients drop the pins when they receive the revoke or delete notifications.
+func (m *msgServer) deletePinnedMsgs(ctx context.Context, conversationID string, seqs []int64) {
+	if _, err := m.PinnedMsgDatabase.UnpinMsgs(ctx, conversationID, seqs); err != nil {
+		log.ZWarn(ctx, "delete pinned msgs failed", err, "conversationID", conversationID, "seqs", seqs)
+	}
+}
+
+func convertPinnedMsg(pin *model.PinnedMsg) *msgext.PinnedMsg {
+	return &msgext.PinnedMsg{
+		ConversationID: pin.ConversationID,
+		Seq:            pin.Seq,
+		ClientMsgID:    pin.ClientMsgID,
+		PinnerUserID:   pin.PinnerUserID,
+		PinTime:        pin.PinTime.UnixMilli(),
+	}
+}
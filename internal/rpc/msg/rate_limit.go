@@ -0,0 +1,46 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+// checkSendMsgRateLimit limits how fast users send msgs, notifications and the msgs of app managers are not limited.
+func (m *msgServer) checkSendMsgRateLimit(ctx context.Context, data *sdkws.MsgData) error {
+	if !m.config.RpcConfig.RateLimit.Enable {
+		return nil
+	}
+	if data.ContentType >= constant.NotificationBegin && data.ContentType <= constant.NotificationEnd {
+		return nil
+	}
+	if authverify.IsAppManagerUid(ctx, m.config.Share.IMAdminUserID) || datautil.Contain(data.SendID, m.config.Share.IMAdminUserID...) {
+		return nil
+	}
+	limit, err := m.MsgDatabase.TakeSendMsgTokens(ctx, data, &m.config.RpcConfig.RateLimit)
+	if err != nil {
+		return err
+	}
+	if limit != "" {
+		return servererrs.ErrMsgRateLimited.WrapMsg("send msg too frequently", "limit", limit, "sendID", data.SendID)
+	}
+	return nil
+}
@@ -0,0 +1,195 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgext"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+const (
+	// maxMsgReactionKeys is the max number of different reactions of one message.
+	maxMsgReactionKeys = 50
+	// msgReactionsAttachedKey is the attachedInfo field carrying the reactions of a pulled message.
+	msgReactionsAttachedKey = "reactions"
+)
+
+func (m *msgServer) AddMessageReaction(ctx context.Context, req *msgext.AddMessageReactionReq) (*msgext.AddMessageReactionResp, error) {
+	reaction, err := m.setMessageReaction(ctx, req.UserID, req.ConversationID, req.Seq, req.ReactionKey, true)
+	if err != nil {
+		return nil, err
+	}
+	return &msgext.AddMessageReactionResp{Reaction: reaction}, nil
+}
+
+func (m *msgServer) RemoveMessageReaction(ctx context.Context, req *msgext.RemoveMessageReactionReq) (*msgext.RemoveMessageReactionResp, error) {
+	reaction, err := m.setMessageReaction(ctx, req.UserID, req.ConversationID, req.Seq, req.ReactionKey, false)
+	if err != nil {
+		return nil, err
+	}
+	return &msgext.RemoveMessageReactionResp{Reaction: reaction}, nil
+}
+
+func (m *msgServer) GetMessagesReaction(ctx context.Context, req *msgext.GetMessagesReactionReq) (*msgext.GetMessagesReactionResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	if _, err := m.ConversationLocalCache.GetConversation(ctx, req.UserID, req.ConversationID); err != nil {
+		return nil, err
+	}
+	_, _, msgs, err := m.MsgDatabase.GetMsgBySeqs(ctx, req.UserID, req.ConversationID, datautil.Distinct(req.Seqs))
+	if err != nil {
+		return nil, err
+	}
+	msgs = datautil.Filter(msgs, func(msg *sdkws.MsgData) (*sdkws.MsgData, bool) {
+		return msg, msg != nil && msg.Status != constant.MsgDeleted
+	})
+	reactions, err := m.MsgDatabase.GetMsgsReactions(ctx, req.ConversationID, msgs)
+	if err != nil {
+		return nil, err
+	}
+	resp := &msgext.GetMessagesReactionResp{MsgReactions: make([]*msgext.MessageReactions, 0, len(msgs))}
+	for _, msg := range msgs {
+		resp.MsgReactions = append(resp.MsgReactions, &msgext.MessageReactions{
+			Seq:         msg.Seq,
+			ClientMsgID: msg.ClientMsgID,
+			Reactions:   convertMessageReactions(reactions[msg.Seq]),
+		})
+	}
+	return resp, nil
+}
+
+func (m *msgServer) setMessageReaction(ctx context.Context, userID string, conversationID string, seq int64, reactionKey string, isAdd bool) (*msgext.MessageReaction, error) {
+	if err := authverify.CheckAccessV3(ctx, userID, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	conv, err := m.ConversationLocalCache.GetConversation(ctx, userID, conversationID)
+	if err != nil {
+		return nil, err
+	}
+	_, _, msgs, err := m.MsgDatabase.GetMsgBySeqs(ctx, userID, conversationID, []int64{seq})
+	if err != nil {
+		return nil, err
+	}
+	if len(msgs) == 0 || msgs[0] == nil || msgs[0].Status == constant.MsgDeleted {
+		return nil, errs.ErrRecordNotFound.WrapMsg("msg not found")
+	}
+	msg := msgs[0]
+	if msg.ContentType == constant.MsgRevokeNotification {
+		return nil, servererrs.ErrMsgAlreadyRevoke.WrapMsg("msg already revoke")
+	}
+	if msg.SessionType == constant.ReadGroupChatType {
+		if _, err := m.GroupLocalCache.GetGroupMember(ctx, msg.GroupID, userID); err != nil {
+			if errs.ErrRecordNotFound.Is(err) {
+				return nil, servererrs.ErrNotInGroupYet.WrapMsg("user not in group", "groupID", msg.GroupID, "userID", userID)
+			}
+			return nil, err
+		}
+	}
+	reactions, err := m.MsgDatabase.SetMsgReaction(ctx, conversationID, msg, reactionKey, userID, isAdd, maxMsgReactionKeys)
+	if err != nil {
+		return nil, err
+	}
+	reaction := &msgext.MessageReaction{
+		ReactionKey: reactionKey,
+		UserIDs:     reactions[reactionKey],
+		Count:       int64(len(reactions[reactionKey])),
+	}
+	m.notificationSender.MsgReactionChangedNotification(ctx, userID, m.conversationAndGetRecvID(conv, userID), msg.SessionType, &msgext.MsgReactionChangedTips{
+		OpUserID:       userID,
+		ConversationID: conversationID,
+		Seq:            seq,
+		ClientMsgID:    msg.ClientMsgID,
+		SessionType:    msg.SessionType,
+		IsAdd:          isAdd,
+		Reaction:       reaction,
+	})
+	return reaction, nil
+}
+
+// fillMsgsReaction attaches the reactions to the pulled msgs, they are carried in the attachedInfo,
+// so clients not knowing reactions are not affected. Only the msgs explicitly setting the reaction option
+// to false are skipped, as the option is missing in the msgs sent by the sdk.
+func (m *msgServer) fillMsgsReaction(ctx context.Context, conversationID string, msgs []*sdkws.MsgData) {
+	msgs = datautil.Filter(msgs, func(msg *sdkws.MsgData) (*sdkws.MsgData, bool) {
+		return msg, msg != nil && msgprocessor.Options(msg.Options).IsReactionFromCache() &&
+			msg.Status != constant.MsgDeleted && msg.ContentType != constant.MsgRevokeNotification
+	})
+	if len(msgs) == 0 {
+		return
+	}
+	msgsReactions, err := m.MsgDatabase.GetMsgsReactions(ctx, conversationID, msgs)
+	if err != nil {
+		log.ZWarn(ctx, "GetMsgsReactions error", err, "conversationID", conversationID)
+		return
+	}
+	for _, msg := range msgs {
+		reactions, ok := msgsReactions[msg.Seq]
+		if !ok {
+			continue
+		}
+		attachedInfo, err := setAttachedInfo(msg.AttachedInfo, msgReactionsAttachedKey, convertMessageReactions(reactions))
+		if err != nil {
+			log.ZWarn(ctx, "set msg reactions to attachedInfo failed", err, "conversationID", conversationID, "seq", msg.Seq)
+			continue
+		}
+		msg.AttachedInfo = attachedInfo
+	}
+}
+
+// setAttachedInfo sets the field key of the attachedInfo json of a msg to value.
+func setAttachedInfo(attachedInfo string, key string, value any) (string, error) {
+	info := make(map[string]any)
+	if attachedInfo != "" {
+		if err := json.Unmarshal([]byte(attachedInfo), &info); err != nil {
+			return "", errs.Wrap(err)
+		}
+	}
+	info[key] = value
+	data, err := json.Marshal(info)
+	if err != nil {
+		return "", errs.Wrap(err)
+	}
+	return string(data), nil
+}
+
+func convertMessageReactions(reactions map[string][]string) []*msgext.MessageReaction {
+	res := make([]*msgext.MessageReaction, 0, len(reactions))
+	for reactionKey, userIDs := range reactions {
+		res = append(res, &msgext.MessageReaction{
+			ReactionKey: reactionKey,
+			UserIDs:     userIDs,
+			Count:       int64(len(userIDs)),
+		})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Count == res[j].Count {
+			return res[i].ReactionKey < res[j].ReactionKey
+		}
+		return res[i].Count > res[j].Count
+	})
+	return res
+}
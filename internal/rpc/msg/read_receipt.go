@@ -0,0 +1,256 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgext"
+	"github.com/openimsdk/open-im-server/v3/pkg/util/conversationutil"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/conversation"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+// groupHasReadInfoAttachedKey is the attachedInfo field carrying the read count of a pulled group msg.
+const groupHasReadInfoAttachedKey = "groupHasReadInfo"
+
+const (
+	// groupReadStateTTL is how long the members and their has read seqs of a group are reused by the pulls,
+	// the read counts of the pulled msgs may lag behind the reads by it.
+	groupReadStateTTL = time.Second * 3
+	// groupReadStateSize is the max number of the groups whose read states are kept.
+	groupReadStateSize = 1024
+)
+
+type groupHasReadInfo struct {
+	HasReadCount     int32 `json:"hasReadCount"`
+	UnreadCount      int32 `json:"unreadCount"`
+	GroupMemberCount int32 `json:"groupMemberCount"`
+}
+
+func (m *msgServer) SetGroupReadReceipt(ctx context.Context, req *msgext.SetGroupReadReceiptReq) (*msgext.SetGroupReadReceiptResp, error) {
+	member, err := m.checkGroupReadReceiptAccess(ctx, req.GroupID, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if member != nil && member.RoleLevel != constant.GroupOwner && member.RoleLevel != constant.GroupAdmin {
+		return nil, errs.ErrNoPermission.WrapMsg("only the owner and admins can set read receipts")
+	}
+	if err := m.GroupMsgSettingDatabase.SetGroupReadReceipt(ctx, req.GroupID, req.Enable); err != nil {
+		return nil, err
+	}
+	return &msgext.SetGroupReadReceiptResp{}, nil
+}
+
+func (m *msgServer) GetGroupReadReceipt(ctx context.Context, req *msgext.GetGroupReadReceiptReq) (*msgext.GetGroupReadReceiptResp, error) {
+	if _, err := m.checkGroupReadReceiptAccess(ctx, req.GroupID, req.UserID); err != nil {
+		return nil, err
+	}
+	enable, err := m.GroupMsgSettingDatabase.IsGroupReadReceiptEnabled(ctx, req.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	return &msgext.GetGroupReadReceiptResp{Enable: enable}, nil
+}
+
+// checkGroupReadReceiptAccess checks that userID is the caller and a member of the group, unless the caller is an app manager.
+// It returns the member, nil for the app managers.
+func (m *msgServer) checkGroupReadReceiptAccess(ctx context.Context, groupID string, userID string) (*sdkws.GroupMemberFullInfo, error) {
+	if err := authverify.CheckAccessV3(ctx, userID, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	if authverify.IsAppManagerUid(ctx, m.config.Share.IMAdminUserID) {
+		return nil, nil
+	}
+	member, err := m.GroupLocalCache.GetGroupMember(ctx, groupID, userID)
+	if err != nil {
+		if errs.ErrRecordNotFound.Is(err) {
+			return nil, servererrs.ErrNotInGroupYet.WrapMsg("user not in group", "groupID", groupID, "userID", userID)
+		}
+		return nil, err
+	}
+	return member, nil
+}
+
+func (m *msgServer) GetGroupMsgReadMembers(ctx context.Context, req *msgext.GetGroupMsgReadMembersReq) (*msgext.GetGroupMsgReadMembersResp, error) {
+	groupID := conversationutil.GetGroupIDByConversationID(req.ConversationID)
+	if groupID == "" {
+		return nil, errs.ErrArgs.WrapMsg("not a group conversation")
+	}
+	// the access is checked before the msg is read, so the non-members learn nothing about the msgs of the group
+	if _, err := m.checkGroupReadReceiptAccess(ctx, groupID, req.UserID); err != nil {
+		return nil, err
+	}
+	enable, err := m.GroupMsgSettingDatabase.IsGroupReadReceiptEnabled(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+	if !enable {
+		return nil, servererrs.ErrMessageHasReadDisable.WrapMsg("group read receipts are disabled")
+	}
+	_, _, msgs, err := m.MsgDatabase.GetMsgBySeqs(ctx, req.UserID, req.ConversationID, []int64{req.Seq})
+	if err != nil {
+		return nil, err
+	}
+	if len(msgs) == 0 || msgs[0] == nil || msgs[0].Status == constant.MsgDeleted {
+		return nil, errs.ErrRecordNotFound.WrapMsg("msg not found")
+	}
+	msg := msgs[0]
+	memberIDs, err := m.GroupLocalCache.GetGroupMemberIDs(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+	members, err := m.GroupLocalCache.GetGroupMembers(ctx, groupID, memberIDs)
+	if err != nil {
+		return nil, err
+	}
+	hasReadSeqs, err := m.MsgDatabase.GetUsersHasReadSeqs(ctx, req.ConversationID, memberIDs)
+	if err != nil {
+		return nil, err
+	}
+	readUserIDs, unreadUserIDs := groupMsgReaders(msg, members, hasReadSeqs)
+	return &msgext.GetGroupMsgReadMembersResp{
+		ReadUserIDs:   datautil.Paginate(readUserIDs, int(req.Pagination.GetPageNumber()), int(req.Pagination.GetShowNumber())),
+		UnreadUserIDs: datautil.Paginate(unreadUserIDs, int(req.Pagination.GetPageNumber()), int(req.Pagination.GetShowNumber())),
+		ReadTotal:     int32(len(readUserIDs)),
+		UnreadTotal:   int32(len(unreadUserIDs)),
+	}, nil
+}
+
+// groupMsgReaders splits the readers of the group msg by whether they have read it, sorted by userID.
+// The readers are the members except the sender who joined before the msg was sent.
+func groupMsgReaders(msg *sdkws.MsgData, members []*sdkws.GroupMemberFullInfo, hasReadSeqs map[string]int64) (readUserIDs []string, unreadUserIDs []string) {
+	for _, member := range members {
+		if member.UserID == msg.SendID || member.JoinTime > msg.SendTime {
+			continue
+		}
+		if hasReadSeqs[member.UserID] >= msg.Seq {
+			readUserIDs = append(readUserIDs, member.UserID)
+		} else {
+			unreadUserIDs = append(unreadUserIDs, member.UserID)
+		}
+	}
+	sort.Strings(readUserIDs)
+	sort.Strings(unreadUserIDs)
+	return readUserIDs, unreadUserIDs
+}
+
+// groupReader is a member of the group counted by the read counts of the msgs.
+type groupReader struct {
+	userID     string
+	joinTime   int64
+	hasReadSeq int64
+}
+
+// groupReadState is the members and their has read seqs of a group, shared by the pulls within groupReadStateTTL.
+type groupReadState struct {
+	memberCount int32
+	readers     []groupReader
+}
+
+func newGroupReadState(members []*sdkws.GroupMemberFullInfo, hasReadSeqs map[string]int64) *groupReadState {
+	state := &groupReadState{memberCount: int32(len(members)), readers: make([]groupReader, 0, len(members))}
+	for _, member := range members {
+		state.readers = append(state.readers, groupReader{
+			userID:     member.UserID,
+			joinTime:   member.JoinTime,
+			hasReadSeq: hasReadSeqs[member.UserID],
+		})
+	}
+	return state
+}
+
+// count counts the readers of the group msg as groupMsgReaders in one pass.
+func (s *groupReadState) count(msg *sdkws.MsgData) (hasReadCount int32, unreadCount int32) {
+	for i := range s.readers {
+		reader := &s.readers[i]
+		if reader.userID == msg.SendID || reader.joinTime > msg.SendTime {
+			continue
+		}
+		if reader.hasReadSeq >= msg.Seq {
+			hasReadCount++
+		} else {
+			unreadCount++
+		}
+	}
+	return hasReadCount, unreadCount
+}
+
+// fillMsgsGroupReadCount sets the read count of the pulled msgs of a group which enables read receipts.
+func (m *msgServer) fillMsgsGroupReadCount(ctx context.Context, conversation *conversation.Conversation, msgs []*sdkws.MsgData) {
+	if conversation.ConversationType != constant.ReadGroupChatType {
+		return
+	}
+	enable, err := m.GroupMsgSettingDatabase.IsGroupReadReceiptEnabled(ctx, conversation.GroupID)
+	if err != nil {
+		log.ZWarn(ctx, "IsGroupReadReceiptEnabled error", err, "groupID", conversation.GroupID)
+		return
+	}
+	if !enable {
+		return
+	}
+	state, _, err := m.groupReadStates.Get(conversation.ConversationID, func() (*groupReadState, error) {
+		return m.getGroupReadState(ctx, conversation)
+	})
+	if err != nil {
+		log.ZWarn(ctx, "get group read state error", err, "conversationID", conversation.ConversationID)
+		return
+	}
+	setMsgsGroupReadCount(ctx, msgs, state)
+}
+
+func (m *msgServer) getGroupReadState(ctx context.Context, conversation *conversation.Conversation) (*groupReadState, error) {
+	memberIDs, err := m.GroupLocalCache.GetGroupMemberIDs(ctx, conversation.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	members, err := m.GroupLocalCache.GetGroupMembers(ctx, conversation.GroupID, memberIDs)
+	if err != nil {
+		return nil, err
+	}
+	hasReadSeqs, err := m.MsgDatabase.GetUsersHasReadSeqs(ctx, conversation.ConversationID, memberIDs)
+	if err != nil {
+		return nil, err
+	}
+	return newGroupReadState(members, hasReadSeqs), nil
+}
+
+// setMsgsGroupReadCount sets the read count of each msg to its attachedInfo, counted as groupMsgReaders.
+func setMsgsGroupReadCount(ctx context.Context, msgs []*sdkws.MsgData, state *groupReadState) {
+	for _, msg := range msgs {
+		if msg == nil || msg.Status == constant.MsgDeleted || msg.ContentType == constant.MsgRevokeNotification {
+			continue
+		}
+		hasReadCount, unreadCount := state.count(msg)
+		attachedInfo, err := setAttachedInfo(msg.AttachedInfo, groupHasReadInfoAttachedKey, &groupHasReadInfo{
+			HasReadCount:     hasReadCount,
+			UnreadCount:      unreadCount,
+			GroupMemberCount: state.memberCount,
+		})
+		if err != nil {
+			log.ZWarn(ctx, "set group has read info to attachedInfo failed", err, "seq", msg.Seq)
+			continue
+		}
+		msg.AttachedInfo = attachedInfo
+	}
+}
@@ -0,0 +1,164 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/localcache/lru"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgext"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/conversation"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/mcontext"
+)
+
+func TestGroupMsgReaders(t *testing.T) {
+	msg := &sdkws.MsgData{SendID: "sender", Seq: 10, SendTime: 1000}
+	members := []*sdkws.GroupMemberFullInfo{
+		{UserID: "sender", JoinTime: 100},
+		{UserID: "u3", JoinTime: 100},
+		{UserID: "u1", JoinTime: 1000},
+		{UserID: "u2", JoinTime: 500},
+		{UserID: "u4", JoinTime: 200},
+		// joined after the msg was sent
+		{UserID: "late", JoinTime: 1001},
+	}
+	hasReadSeqs := map[string]int64{"sender": 10, "u1": 10, "u2": 9, "u3": 11, "late": 20}
+	readUserIDs, unreadUserIDs := groupMsgReaders(msg, members, hasReadSeqs)
+	if want := []string{"u1", "u3"}; !reflect.DeepEqual(readUserIDs, want) {
+		t.Fatalf("read %v, want %v", readUserIDs, want)
+	}
+	if want := []string{"u2", "u4"}; !reflect.DeepEqual(unreadUserIDs, want) {
+		t.Fatalf("unread %v, want %v", unreadUserIDs, want)
+	}
+}
+
+type fakeGroupMsgSettingDatabase struct {
+	controller.GroupMsgSettingDatabase
+	enabled map[string]bool
+}
+
+func (f *fakeGroupMsgSettingDatabase) IsGroupReadReceiptEnabled(_ context.Context, groupID string) (bool, error) {
+	return f.enabled[groupID], nil
+}
+
+func TestSetMsgsGroupReadCount(t *testing.T) {
+	msgs := []*sdkws.MsgData{
+		{SendID: "sender", Seq: 10, SendTime: 1000, AttachedInfo: `{"reactions":[]}`},
+		{SendID: "u1", Seq: 11, SendTime: 1100},
+		{SendID: "sender", Seq: 12, SendTime: 1200, Status: constant.MsgDeleted},
+	}
+	members := []*sdkws.GroupMemberFullInfo{
+		{UserID: "sender", JoinTime: 100},
+		{UserID: "u1", JoinTime: 100},
+		{UserID: "u2", JoinTime: 100},
+		{UserID: "late", JoinTime: 1050},
+	}
+	hasReadSeqs := map[string]int64{"u1": 11, "u2": 10, "late": 11}
+	state := newGroupReadState(members, hasReadSeqs)
+	for _, msg := range msgs {
+		// counted as groupMsgReaders
+		readUserIDs, unreadUserIDs := groupMsgReaders(msg, members, hasReadSeqs)
+		if hasReadCount, unreadCount := state.count(msg); int(hasReadCount) != len(readUserIDs) || int(unreadCount) != len(unreadUserIDs) {
+			t.Fatalf("msg %d counts %d %d, readers %v %v", msg.Seq, hasReadCount, unreadCount, readUserIDs, unreadUserIDs)
+		}
+	}
+	setMsgsGroupReadCount(context.Background(), msgs, state)
+
+	wants := []*groupHasReadInfo{
+		{HasReadCount: 2, UnreadCount: 0, GroupMemberCount: 4},
+		{HasReadCount: 1, UnreadCount: 2, GroupMemberCount: 4},
+	}
+	for i, want := range wants {
+		var info map[string]json.RawMessage
+		if err := json.Unmarshal([]byte(msgs[i].AttachedInfo), &info); err != nil {
+			t.Fatal(err)
+		}
+		var got groupHasReadInfo
+		if err := json.Unmarshal(info[groupHasReadInfoAttachedKey], &got); err != nil {
+			t.Fatal(err)
+		}
+		if got != *want {
+			t.Fatalf("msg %d read info %+v, want %+v", i, got, *want)
+		}
+	}
+	if !strings.Contains(msgs[0].AttachedInfo, `"reactions"`) {
+		t.Fatal("the other attachedInfo fields are lost")
+	}
+	if msgs[2].AttachedInfo != "" {
+		t.Fatal("the read count of a deleted msg is set")
+	}
+}
+
+func TestFillMsgsGroupReadCountDisabled(t *testing.T) {
+	// the group cache is not read if the group disables read receipts
+	m := &msgServer{GroupMsgSettingDatabase: &fakeGroupMsgSettingDatabase{enabled: map[string]bool{"g2": true}}}
+	msgs := []*sdkws.MsgData{{SendID: "sender", Seq: 10}}
+	m.fillMsgsGroupReadCount(context.Background(), &conversation.Conversation{ConversationType: constant.ReadGroupChatType, GroupID: "g1"}, msgs)
+	m.fillMsgsGroupReadCount(context.Background(), &conversation.Conversation{ConversationType: constant.SingleChatType, GroupID: "g2"}, msgs)
+	if msgs[0].AttachedInfo != "" {
+		t.Fatalf("attachedInfo %q, want empty", msgs[0].AttachedInfo)
+	}
+}
+
+func TestFillMsgsGroupReadCountReusesState(t *testing.T) {
+	m := &msgServer{
+		GroupMsgSettingDatabase: &fakeGroupMsgSettingDatabase{enabled: map[string]bool{"g1": true}},
+		groupReadStates:         lru.NewLayLRU[string, *groupReadState](groupReadStateSize, time.Minute, time.Second, nil),
+	}
+	state := newGroupReadState([]*sdkws.GroupMemberFullInfo{{UserID: "u1"}, {UserID: "u2"}}, map[string]int64{"u1": 10})
+	if _, _, err := m.groupReadStates.Get("sg_g1", func() (*groupReadState, error) { return state, nil }); err != nil {
+		t.Fatal(err)
+	}
+	// the group local cache and the msg database are nil, the pull must not scan the members again
+	msgs := []*sdkws.MsgData{{SendID: "u2", Seq: 10}}
+	m.fillMsgsGroupReadCount(context.Background(), &conversation.Conversation{ConversationType: constant.ReadGroupChatType,
+		GroupID: "g1", ConversationID: "sg_g1"}, msgs)
+	if !strings.Contains(msgs[0].AttachedInfo, `"hasReadCount":1`) {
+		t.Fatalf("attachedInfo %q", msgs[0].AttachedInfo)
+	}
+}
+
+func TestGetGroupMsgReadMembersAccess(t *testing.T) {
+	// the msg database is nil, the requests failing the checks must not read the msg
+	m := &msgServer{
+		config:                  &Config{Share: config.Share{IMAdminUserID: []string{"admin"}}},
+		GroupMsgSettingDatabase: &fakeGroupMsgSettingDatabase{enabled: map[string]bool{}},
+	}
+	ctx := mcontext.SetOpUserID(context.Background(), "user1")
+	_, err := m.GetGroupMsgReadMembers(ctx, &msgext.GetGroupMsgReadMembersReq{ConversationID: "si_user1_user2", Seq: 1, UserID: "user1"})
+	if !errs.ErrArgs.Is(err) {
+		t.Fatalf("single conversation: %v", err)
+	}
+	_, err = m.GetGroupMsgReadMembers(ctx, &msgext.GetGroupMsgReadMembersReq{ConversationID: "sg_g1", Seq: 1, UserID: "user2"})
+	if !errs.ErrNoPermission.Is(err) {
+		t.Fatalf("another user: %v", err)
+	}
+	ctx = mcontext.SetOpUserID(context.Background(), "admin")
+	_, err = m.GetGroupMsgReadMembers(ctx, &msgext.GetGroupMsgReadMembersReq{ConversationID: "sg_g1", Seq: 1, UserID: "user1"})
+	if !servererrs.ErrMessageHasReadDisable.Is(err) {
+		t.Fatalf("read receipts disabled: %v", err)
+	}
+}
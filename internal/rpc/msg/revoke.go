@@ -61,36 +61,9 @@ func (m *msgServer) RevokeMsg(ctx context.Context, req *msg.RevokeMsgReq) (*msg.
 
 	data, _ := json.Marshal(msgs[0])
 	log.ZDebug(ctx, "GetMsgBySeqs", "conversationID", req.ConversationID, "seq", req.Seq, "msg", string(data))
-	var role int32
-	if !authverify.IsAppManagerUid(ctx, m.config.Share.IMAdminUserID) {
-		switch msgs[0].SessionType {
-		case constant.SingleChatType:
-			if err := authverify.CheckAccessV3(ctx, msgs[0].SendID, m.config.Share.IMAdminUserID); err != nil {
-				return nil, err
-			}
-			role = user.AppMangerLevel
-		case constant.ReadGroupChatType:
-			members, err := m.GroupLocalCache.GetGroupMemberInfoMap(ctx, msgs[0].GroupID, datautil.Distinct([]string{req.UserID, msgs[0].SendID}))
-			if err != nil {
-				return nil, err
-			}
-			if req.UserID != msgs[0].SendID {
-				switch members[req.UserID].RoleLevel {
-				case constant.GroupOwner:
-				case constant.GroupAdmin:
-					if members[msgs[0].SendID].RoleLevel != constant.GroupOrdinaryUsers {
-						return nil, errs.ErrNoPermission.WrapMsg("no permission")
-					}
-				default:
-					return nil, errs.ErrNoPermission.WrapMsg("no permission")
-				}
-			}
-			if member := members[req.UserID]; member != nil {
-				role = member.RoleLevel
-			}
-		default:
-			return nil, errs.ErrInternalServer.WrapMsg("msg sessionType not supported")
-		}
+	role, err := m.checkMsgOperatorRole(ctx, req.UserID, user, msgs[0])
+	if err != nil {
+		return nil, err
 	}
 	now := time.Now().UnixMilli()
 	err = m.MsgDatabase.RevokeMsg(ctx, req.ConversationID, req.Seq, &model.RevokeModel{
@@ -102,6 +75,8 @@ func (m *msgServer) RevokeMsg(ctx context.Context, req *msg.RevokeMsgReq) (*msg.
 	if err != nil {
 		return nil, err
 	}
+	m.deleteIndexedMsgs(ctx, req.ConversationID, []int64{req.Seq})
+	m.deletePinnedMsgs(ctx, req.ConversationID, []int64{req.Seq})
 	revokerUserID := mcontext.GetOpUserID(ctx)
 	var flag bool
 
@@ -127,3 +102,40 @@ func (m *msgServer) RevokeMsg(ctx context.Context, req *msg.RevokeMsgReq) (*msg.
 	m.webhookAfterRevokeMsg(ctx, &m.config.WebhooksConfig.AfterRevokeMsg, req)
 	return &msg.RevokeMsgResp{}, nil
 }
+
+// checkMsgOperatorRole checks whether userID can operate (revoke, modify) on the message and returns the role of the operator.
+// In single chat only the sender can, in group chat the owner can operate on all messages and admins on ordinary members' messages.
+func (m *msgServer) checkMsgOperatorRole(ctx context.Context, userID string, user *sdkws.UserInfo, msgData *sdkws.MsgData) (int32, error) {
+	if authverify.IsAppManagerUid(ctx, m.config.Share.IMAdminUserID) {
+		return 0, nil
+	}
+	switch msgData.SessionType {
+	case constant.SingleChatType:
+		if err := authverify.CheckAccessV3(ctx, msgData.SendID, m.config.Share.IMAdminUserID); err != nil {
+			return 0, err
+		}
+		return user.AppMangerLevel, nil
+	case constant.ReadGroupChatType:
+		members, err := m.GroupLocalCache.GetGroupMemberInfoMap(ctx, msgData.GroupID, datautil.Distinct([]string{userID, msgData.SendID}))
+		if err != nil {
+			return 0, err
+		}
+		if userID != msgData.SendID {
+			switch members[userID].RoleLevel {
+			case constant.GroupOwner:
+			case constant.GroupAdmin:
+				if members[msgData.SendID].RoleLevel != constant.GroupOrdinaryUsers {
+					return 0, errs.ErrNoPermission.WrapMsg("no permission")
+				}
+			default:
+				return 0, errs.ErrNoPermission.WrapMsg("no permission")
+			}
+		}
+		if member := members[userID]; member != nil {
+			return member.RoleLevel, nil
+		}
+		return 0, nil
+	default:
+		return 0, errs.ErrInternalServer.WrapMsg("msg sessionType not supported")
+	}
+}
@@ -0,0 +1,165 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/convert"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgext"
+	pbmsg "github.com/openimsdk/protocol/msg"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+	"github.com/openimsdk/tools/utils/idutil"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// maxClaimScheduledMsgs is the max number of scheduled msgs claimed at a time, the claimed msgs are sent
+	// one by one within scheduledMsgClaimTimeout.
+	maxClaimScheduledMsgs = 10
+	// scheduledMsgClaimTimeout is how long a claimed msg waits for its result before it is claimed again,
+	// or marked as unknown if it has started sending.
+	scheduledMsgClaimTimeout = time.Minute
+)
+
+func (m *msgServer) ScheduleSendMsg(ctx context.Context, req *msgext.ScheduleSendMsgReq) (*msgext.ScheduleSendMsgResp, error) {
+	if err := authverify.CheckAdmin(ctx, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	if req.SendTime <= time.Now().UnixMilli() {
+		return nil, errs.ErrArgs.WrapMsg("sendTime must be in the future")
+	}
+	if _, err := m.UserLocalCache.GetUserInfo(ctx, req.MsgData.SendID); err != nil {
+		return nil, err
+	}
+	msgData := proto.Clone(req.MsgData).(*sdkws.MsgData)
+	msgData.ServerMsgID = ""
+	msgData.Seq = 0
+	msgData.SendTime = 0
+	if msgData.ClientMsgID == "" {
+		msgData.ClientMsgID = idutil.GetMsgIDByMD5(msgData.SendID)
+	}
+	scheduledMsg := &model.ScheduledMsg{
+		ScheduleID: idutil.GetMsgIDByMD5(msgData.SendID),
+		Msg:        convert.MsgPb2DB(msgData),
+		SendTime:   time.UnixMilli(req.SendTime),
+		Status:     msgext.ScheduledMsgPending,
+		CreateTime: time.Now(),
+	}
+	if err := m.ScheduledMsgDatabase.CreateScheduledMsg(ctx, scheduledMsg); err != nil {
+		return nil, err
+	}
+	return &msgext.ScheduleSendMsgResp{ScheduleID: scheduledMsg.ScheduleID}, nil
+}
+
+func (m *msgServer) CancelScheduledMsg(ctx context.Context, req *msgext.CancelScheduledMsgReq) (*msgext.CancelScheduledMsgResp, error) {
+	if err := authverify.CheckAdmin(ctx, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	scheduledMsg, err := m.ScheduledMsgDatabase.TakeScheduledMsg(ctx, req.ScheduleID)
+	if err != nil {
+		return nil, err
+	}
+	if scheduledMsg.Status != msgext.ScheduledMsgPending {
+		return nil, errs.ErrArgs.WrapMsg("scheduled msg is not pending", "status", scheduledMsg.Status)
+	}
+	if err := m.ScheduledMsgDatabase.CancelScheduledMsg(ctx, req.ScheduleID); err != nil {
+		if IsNotFound(err) {
+			return nil, errs.ErrArgs.WrapMsg("scheduled msg is being sent")
+		}
+		return nil, err
+	}
+	return &msgext.CancelScheduledMsgResp{}, nil
+}
+
+func (m *msgServer) GetScheduledMsgs(ctx context.Context, req *msgext.GetScheduledMsgsReq) (*msgext.GetScheduledMsgsResp, error) {
+	if err := authverify.CheckAdmin(ctx, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	total, scheduledMsgs, err := m.ScheduledMsgDatabase.SearchScheduledMsgs(ctx, req.SendID, req.Status, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &msgext.GetScheduledMsgsResp{
+		Total:         total,
+		ScheduledMsgs: datautil.Slice(scheduledMsgs, convertScheduledMsg),
+	}, nil
+}
+
+func (m *msgServer) ClaimDueScheduledMsgs(ctx context.Context, req *msgext.ClaimDueScheduledMsgsReq) (*msgext.ClaimDueScheduledMsgsResp, error) {
+	if err := authverify.CheckAdmin(ctx, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	count := int(req.Count)
+	if count > maxClaimScheduledMsgs {
+		count = maxClaimScheduledMsgs
+	}
+	claimID := idutil.OperationIDGenerator()
+	scheduledMsgs, err := m.ScheduledMsgDatabase.ClaimDueScheduledMsgs(ctx, claimID, count, scheduledMsgClaimTimeout)
+	if err != nil {
+		return nil, err
+	}
+	return &msgext.ClaimDueScheduledMsgsResp{
+		ClaimID:       claimID,
+		ScheduledMsgs: datautil.Slice(scheduledMsgs, convertScheduledMsg),
+	}, nil
+}
+
+// SendScheduledMsg sends a claimed msg and sets its result. The msg is marked as started sending first,
+// so a claim lost before it is claimed again, and a claim released by timeout is not sent by its stale claimer.
+func (m *msgServer) SendScheduledMsg(ctx context.Context, req *msgext.SendScheduledMsgReq) (*msgext.SendScheduledMsgResp, error) {
+	if err := authverify.CheckAdmin(ctx, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	scheduledMsg, err := m.ScheduledMsgDatabase.StartSendingScheduledMsg(ctx, req.ScheduleID, req.ClaimID)
+	if err != nil {
+		return nil, err
+	}
+	var serverMsgID, errMsg string
+	sendResp, sendErr := m.SendMsg(ctx, &pbmsg.SendMsgReq{MsgData: convert.MsgDB2Pb(scheduledMsg.Msg)})
+	if sendErr == nil {
+		serverMsgID = sendResp.ServerMsgID
+	} else {
+		errMsg = sendErr.Error()
+	}
+	if err := m.ScheduledMsgDatabase.SetScheduledMsgResult(ctx, req.ScheduleID, req.ClaimID, serverMsgID, errMsg); err != nil {
+		log.ZError(ctx, "set scheduled msg result failed", err, "scheduleID", req.ScheduleID, "serverMsgID", serverMsgID, "errMsg", errMsg)
+		if sendErr == nil {
+			return nil, err
+		}
+	}
+	if sendErr != nil {
+		return nil, sendErr
+	}
+	return &msgext.SendScheduledMsgResp{ServerMsgID: serverMsgID}, nil
+}
+
+func convertScheduledMsg(scheduledMsg *model.ScheduledMsg) *msgext.ScheduledMsg {
+	return &msgext.ScheduledMsg{
+		ScheduleID:  scheduledMsg.ScheduleID,
+		MsgData:     convert.MsgDB2Pb(scheduledMsg.Msg),
+		SendTime:    scheduledMsg.SendTime.UnixMilli(),
+		Status:      scheduledMsg.Status,
+		CreateTime:  scheduledMsg.CreateTime.UnixMilli(),
+		ServerMsgID: scheduledMsg.ServerMsgID,
+		ErrMsg:      scheduledMsg.ErrMsg,
+	}
+}
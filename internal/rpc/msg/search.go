@@ -0,0 +1,252 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/convert"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgext"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/msg"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/db/pagination"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/utils/datautil"
+	"github.com/openimsdk/tools/utils/idutil"
+	"google.golang.org/protobuf/proto"
+)
+
+// IndexMsgs is called by msgtransfer as the app manager after the msgs are written to mongodb.
+func (m *msgServer) IndexMsgs(ctx context.Context, req *msgext.IndexMsgsReq) (*msgext.IndexMsgsResp, error) {
+	if err := authverify.CheckAdmin(ctx, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	if m.SearchDatabase == nil {
+		return &msgext.IndexMsgsResp{}, nil
+	}
+	if err := m.indexMsgs(ctx, req.ConversationID, req.Msgs, req.Forwarded); err != nil {
+		return nil, err
+	}
+	return &msgext.IndexMsgsResp{}, nil
+}
+
+func (m *msgServer) DeleteIndexedMsgs(ctx context.Context, req *msgext.DeleteIndexedMsgsReq) (*msgext.DeleteIndexedMsgsResp, error) {
+	if err := authverify.CheckAdmin(ctx, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	if m.SearchDatabase == nil {
+		return &msgext.DeleteIndexedMsgsResp{}, nil
+	}
+	if err := m.SearchDatabase.DeleteMsgs(ctx, req.ConversationID, req.Seqs); err != nil {
+		return nil, err
+	}
+	return &msgext.DeleteIndexedMsgsResp{}, nil
+}
+
+func (m *msgServer) SearchMsgIndex(ctx context.Context, req *msgext.SearchMsgIndexReq) (*msgext.SearchMsgIndexResp, error) {
+	if err := authverify.CheckAdmin(ctx, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	if m.SearchDatabase == nil {
+		return nil, errs.ErrArgs.WrapMsg("the msg search index is not enabled")
+	}
+	total, hits, err := m.SearchDatabase.SearchMsgs(ctx, convert.MsgSearchFilterPb2DB(req.Filter), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &msgext.SearchMsgIndexResp{Total: total, Hits: convert.MsgSearchHitsDB2Pb(hits)}, nil
+}
+
+func (m *msgServer) SearchMsg(ctx context.Context, req *msgext.SearchMsgReq) (*msgext.SearchMsgResp, error) {
+	if req.UserID == "" {
+		if err := authverify.CheckAdmin(ctx, m.config.Share.IMAdminUserID); err != nil {
+			return nil, err
+		}
+	} else {
+		if err := authverify.CheckAccessV3(ctx, req.UserID, m.config.Share.IMAdminUserID); err != nil {
+			return nil, err
+		}
+	}
+	var (
+		total    int32
+		chatLogs []*sdkws.MsgData
+	)
+	if m.SearchDatabase == nil {
+		// only the filters of SearchMessage can be served without the index
+		if req.UserID != "" || req.Keyword != "" || len(req.ConversationIDs) > 0 || len(req.ContentTypes) > 0 || req.StartTime != 0 || req.EndTime != 0 {
+			return nil, errs.ErrArgs.WrapMsg("the msg search index is not enabled")
+		}
+		var err error
+		total, chatLogs, err = m.MsgDatabase.SearchMessage(ctx, &msg.SearchMessageReq{
+			SendID:      req.SendID,
+			RecvID:      req.RecvID,
+			ContentType: req.ContentType,
+			SendTime:    req.SendTime,
+			SessionType: req.SessionType,
+			Pagination:  req.Pagination,
+		})
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		filter, err := m.searchMessageFilter(req.SendID, req.RecvID, req.ContentType, req.SessionType, req.SendTime)
+		if err != nil {
+			return nil, err
+		}
+		filter.Keyword = req.Keyword
+		filter.ConversationIDs = req.ConversationIDs
+		filter.ContentTypes = append(filter.ContentTypes, req.ContentTypes...)
+		if req.StartTime > filter.StartTime {
+			filter.StartTime = req.StartTime
+		}
+		if req.EndTime != 0 && (filter.EndTime == 0 || req.EndTime < filter.EndTime) {
+			filter.EndTime = req.EndTime
+		}
+		if req.UserID != "" {
+			conversationIDs, err := m.Conversation.GetConversationIDs(ctx, req.UserID)
+			if err != nil {
+				return nil, err
+			}
+			if len(filter.ConversationIDs) > 0 {
+				conversationIDs = datautil.BothExist(conversationIDs, filter.ConversationIDs)
+			}
+			if len(conversationIDs) == 0 {
+				return &msgext.SearchMsgResp{}, nil
+			}
+			filter.ConversationIDs = conversationIDs
+		}
+		total, chatLogs, err = m.searchIndexedMsgs(ctx, req.UserID, filter, req.Pagination)
+		if err != nil {
+			return nil, err
+		}
+	}
+	pbChatLogs, err := m.convertChatLogs(ctx, chatLogs)
+	if err != nil {
+		return nil, err
+	}
+	return &msgext.SearchMsgResp{ChatLogs: pbChatLogs, ChatLogsNum: total}, nil
+}
+
+// backfillMsgSearchIndex indexes the msgs stored before the search index was enabled.
+func (m *msgServer) backfillMsgSearchIndex(ctx context.Context) {
+	ctx = mcontext.SetOperationID(ctx, fmt.Sprintf("msg_search_backfill_%d", os.Getpid()))
+	if err := m.SearchDatabase.Backfill(ctx); err != nil {
+		log.ZError(ctx, "msg search index backfill failed, it resumes when the lease is taken again", err)
+	}
+}
+
+// indexModifiedMsg indexes the msg again with its modified content, it is retried by msgtransfer if it fails,
+// e.g. when the index is taken over meanwhile.
+func (m *msgServer) indexModifiedMsg(ctx context.Context, conversationID string, msg *sdkws.MsgData, content string) {
+	if m.SearchDatabase == nil {
+		return
+	}
+	modified := proto.Clone(msg).(*sdkws.MsgData)
+	modified.Content = []byte(content)
+	err := m.indexMsgs(ctx, conversationID, []*sdkws.MsgData{modified}, false)
+	if err == nil {
+		return
+	}
+	log.ZWarn(ctx, "index modified msg failed, retry later", err, "conversationID", conversationID, "seq", msg.Seq)
+	task := &model.MsgRetryTask{
+		TaskID:         idutil.GetMsgIDByMD5(conversationID),
+		Kind:           model.MsgRetryIndex,
+		ConversationID: conversationID,
+		Msgs:           []*model.MsgDataModel{convert.MsgPb2DB(modified)},
+		Attempts:       1,
+		ErrMsg:         err.Error(),
+		OperationID:    mcontext.GetOperationID(ctx),
+		CreateTime:     time.Now(),
+	}
+	if err := m.MsgRetryDatabase.ScheduleMsgRetry(ctx, task, time.Now()); err != nil {
+		log.ZError(ctx, "schedule index modified msg retry failed", err, "conversationID", conversationID, "seq", msg.Seq)
+	}
+}
+
+// searchMessageFilter converts the filters of SearchMessage, sendTime is a date in the format of time.DateOnly.
+func (m *msgServer) searchMessageFilter(sendID string, recvID string, contentType int32, sessionType int32, sendTime string) (*model.MsgSearchFilter, error) {
+	filter := &model.MsgSearchFilter{
+		SendID:      sendID,
+		RecvID:      recvID,
+		SessionType: sessionType,
+	}
+	if contentType != 0 {
+		filter.ContentTypes = []int32{contentType}
+	}
+	if sendTime != "" {
+		date, err := time.Parse(time.DateOnly, sendTime)
+		if err != nil {
+			return nil, errs.ErrArgs.WrapMsg("invalid sendTime", "sendTime", sendTime, "format", time.DateOnly)
+		}
+		filter.StartTime = date.UnixMilli()
+		filter.EndTime = date.Add(time.Hour * 24).UnixMilli()
+	}
+	return filter, nil
+}
+
+// searchIndexedMsgs searches the index and loads the matched msgs, the msgs deleted by the user or revoked are dropped
+// and not counted in the total, so a page may have less msgs than requested.
+func (m *msgServer) searchIndexedMsgs(ctx context.Context, userID string, filter *model.MsgSearchFilter, pagination pagination.Pagination) (int32, []*sdkws.MsgData, error) {
+	total, hits, err := m.searchMsgIndex(ctx, filter, pagination)
+	if err != nil {
+		return 0, nil, err
+	}
+	conversationSeqs := make(map[string][]int64)
+	for _, hit := range hits {
+		conversationSeqs[hit.ConversationID] = append(conversationSeqs[hit.ConversationID], hit.Seq)
+	}
+	conversationMsgs := make(map[string]map[int64]*sdkws.MsgData)
+	for conversationID, seqs := range conversationSeqs {
+		_, _, msgs, err := m.MsgDatabase.GetMsgBySeqs(ctx, userID, conversationID, seqs)
+		if err != nil {
+			return 0, nil, err
+		}
+		seqMsgs := make(map[int64]*sdkws.MsgData)
+		for _, msg := range msgs {
+			if msg == nil || msg.Status == constant.MsgDeleted || msg.ContentType == constant.MsgRevokeNotification {
+				continue
+			}
+			seqMsgs[msg.Seq] = msg
+		}
+		conversationMsgs[conversationID] = seqMsgs
+	}
+	chatLogs := make([]*sdkws.MsgData, 0, len(hits))
+	for _, hit := range hits {
+		if msg, ok := conversationMsgs[hit.ConversationID][hit.Seq]; ok {
+			chatLogs = append(chatLogs, msg)
+		}
+	}
+	total -= int64(len(hits) - len(chatLogs))
+	return int32(total), chatLogs, nil
+}
+
+// deleteIndexedMsgs removes the revoked or physically deleted msgs from the search index. It is not retried,
+// as such msgs left in the index are dropped from the search results.
+func (m *msgServer) deleteIndexedMsgs(ctx context.Context, conversationID string, seqs []int64) {
+	if m.SearchDatabase == nil {
+		return
+	}
+	if err := m.deleteIndexedMsgsOnHolder(ctx, conversationID, seqs); err != nil {
+		log.ZWarn(ctx, "delete indexed msgs failed", err, "conversationID", conversationID, "seqs", seqs)
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"sync"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/convert"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgext"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/db/pagination"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/mw"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// msgSearchHolder is the client of the msg rpc instance holding the search index, which the other instances
+// forward their index and search calls to. It is dialed again once the lease is taken by another instance.
+type msgSearchHolder struct {
+	mu     sync.Mutex
+	addr   string
+	conn   *grpc.ClientConn
+	client msgext.MsgExtClient
+	// dial is grpc.Dial, replaced by the tests.
+	dial func(addr string) (msgext.MsgExtClient, *grpc.ClientConn, error)
+}
+
+func (h *msgSearchHolder) get(addr string) (msgext.MsgExtClient, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.addr == addr {
+		return h.client, nil
+	}
+	dial := h.dial
+	if dial == nil {
+		dial = dialMsgSearchHolder
+	}
+	client, conn, err := dial(addr)
+	if err != nil {
+		return nil, err
+	}
+	if h.conn != nil {
+		_ = h.conn.Close()
+	}
+	h.addr, h.conn, h.client = addr, conn, client
+	return client, nil
+}
+
+func dialMsgSearchHolder(addr string) (msgext.MsgExtClient, *grpc.ClientConn, error) {
+	conn, err := grpc.Dial(addr, mw.GrpcClient(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, nil, errs.WrapMsg(err, "dial the msg search index holder failed", "addr", addr)
+	}
+	return msgext.NewMsgExtClient(conn), conn, nil
+}
+
+// searchHolderClient returns the client of the instance holding the search index, the calls forwarded by ctx are
+// made as the app manager, as the holder serves them only for it.
+func (m *msgServer) searchHolderClient(ctx context.Context) (context.Context, msgext.MsgExtClient, error) {
+	addr, err := m.SearchDatabase.GetHolderAddr(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	// the lease of this instance is not served until it is taken over
+	if addr == "" || addr == m.searchAddr {
+		return nil, nil, errs.ErrInternalServer.WrapMsg("the msg search index is not served by any msg rpc instance", "addr", addr)
+	}
+	client, err := m.searchHolder.get(addr)
+	if err != nil {
+		return nil, nil, err
+	}
+	return mcontext.SetOpUserID(ctx, m.config.Share.IMAdminUserID[0]), client, nil
+}
+
+// indexMsgs indexes the msgs by the search index of this instance if it holds the index, otherwise forwards them
+// to the holder once.
+func (m *msgServer) indexMsgs(ctx context.Context, conversationID string, msgs []*sdkws.MsgData, forwarded bool) error {
+	if forwarded || m.SearchDatabase.Held() {
+		return m.SearchDatabase.IndexMsgs(ctx, conversationID, msgs)
+	}
+	ctx, client, err := m.searchHolderClient(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = client.IndexMsgs(ctx, &msgext.IndexMsgsReq{ConversationID: conversationID, Msgs: msgs, Forwarded: true})
+	return err
+}
+
+func (m *msgServer) deleteIndexedMsgsOnHolder(ctx context.Context, conversationID string, seqs []int64) error {
+	if m.SearchDatabase.Held() {
+		return m.SearchDatabase.DeleteMsgs(ctx, conversationID, seqs)
+	}
+	ctx, client, err := m.searchHolderClient(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = client.DeleteIndexedMsgs(ctx, &msgext.DeleteIndexedMsgsReq{ConversationID: conversationID, Seqs: seqs})
+	return err
+}
+
+func (m *msgServer) searchMsgIndex(ctx context.Context, filter *model.MsgSearchFilter, pagination pagination.Pagination) (int64, []*model.MsgSearchHit, error) {
+	if m.SearchDatabase.Held() {
+		return m.SearchDatabase.SearchMsgs(ctx, filter, pagination)
+	}
+	ctx, client, err := m.searchHolderClient(ctx)
+	if err != nil {
+		return 0, nil, err
+	}
+	resp, err := client.SearchMsgIndex(ctx, &msgext.SearchMsgIndexReq{
+		Filter:     convert.MsgSearchFilterDB2Pb(filter),
+		Pagination: &sdkws.RequestPagination{PageNumber: pagination.GetPageNumber(), ShowNumber: pagination.GetShowNumber()},
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+	return resp.Total, convert.MsgSearchHitsPb2DB(resp.Hits), nil
+}
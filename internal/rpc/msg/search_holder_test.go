@@ -0,0 +1,126 @@
+package msg
+
+import (
+	"context"
+	"testing"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgext"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/db/pagination"
+	"github.com/openimsdk/tools/mcontext"
+	"google.golang.org/grpc"
+)
+
+type fakeMsgSearchDatabase struct {
+	controller.MsgSearchDatabase
+	held    bool
+	addr    string
+	indexed int
+}
+
+func (f *fakeMsgSearchDatabase) Held() bool { return f.held }
+
+func (f *fakeMsgSearchDatabase) GetHolderAddr(context.Context) (string, error) { return f.addr, nil }
+
+func (f *fakeMsgSearchDatabase) IndexMsgs(_ context.Context, _ string, msgs []*sdkws.MsgData) error {
+	f.indexed += len(msgs)
+	return nil
+}
+
+func (f *fakeMsgSearchDatabase) SearchMsgs(context.Context, *model.MsgSearchFilter, pagination.Pagination) (int64, []*model.MsgSearchHit, error) {
+	return 1, []*model.MsgSearchHit{{ConversationID: "si_a_b", Seq: 1}}, nil
+}
+
+type fakeHolderClient struct {
+	msgext.MsgExtClient
+	opUserIDs []string
+	index     []*msgext.IndexMsgsReq
+	search    []*msgext.SearchMsgIndexReq
+}
+
+func (f *fakeHolderClient) IndexMsgs(ctx context.Context, req *msgext.IndexMsgsReq, _ ...grpc.CallOption) (*msgext.IndexMsgsResp, error) {
+	f.opUserIDs = append(f.opUserIDs, mcontext.GetOpUserID(ctx))
+	f.index = append(f.index, req)
+	return &msgext.IndexMsgsResp{}, nil
+}
+
+func (f *fakeHolderClient) SearchMsgIndex(ctx context.Context, req *msgext.SearchMsgIndexReq, _ ...grpc.CallOption) (*msgext.SearchMsgIndexResp, error) {
+	f.opUserIDs = append(f.opUserIDs, mcontext.GetOpUserID(ctx))
+	f.search = append(f.search, req)
+	return &msgext.SearchMsgIndexResp{Total: 2, Hits: []*msgext.MsgSearchHit{{ConversationID: "si_a_b", Seq: 3}}}, nil
+}
+
+func newSearchForwardServer(db *fakeMsgSearchDatabase, client *fakeHolderClient) (*msgServer, *[]string) {
+	var dialed []string
+	m := &msgServer{
+		SearchDatabase: db,
+		searchAddr:     "10.0.0.2:10130",
+		config:         &Config{Share: config.Share{IMAdminUserID: []string{"admin"}}},
+	}
+	m.searchHolder.dial = func(addr string) (msgext.MsgExtClient, *grpc.ClientConn, error) {
+		dialed = append(dialed, addr)
+		return client, nil, nil
+	}
+	return m, &dialed
+}
+
+func TestForwardMsgSearchToHolder(t *testing.T) {
+	ctx := mcontext.SetOpUserID(context.Background(), "user1")
+	db := &fakeMsgSearchDatabase{addr: "10.0.0.1:10130"}
+	client := &fakeHolderClient{}
+	m, dialed := newSearchForwardServer(db, client)
+	msgs := []*sdkws.MsgData{{Seq: 1}}
+
+	// the instance not holding the index forwards the calls to the holder as the app manager
+	if err := m.indexMsgs(ctx, "si_a_b", msgs, false); err != nil {
+		t.Fatal(err)
+	}
+	total, hits, err := m.searchMsgIndex(ctx, &model.MsgSearchFilter{Keyword: "hello"}, &sdkws.RequestPagination{PageNumber: 2, ShowNumber: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if db.indexed != 0 || len(client.index) != 1 || !client.index[0].Forwarded {
+		t.Fatalf("indexed %d locally and forwarded %v", db.indexed, client.index)
+	}
+	if total != 2 || len(hits) != 1 || hits[0].Seq != 3 {
+		t.Fatalf("forwarded search returned %d, %v", total, hits)
+	}
+	if req := client.search[0]; req.Filter.Keyword != "hello" || req.Pagination.PageNumber != 2 || req.Pagination.ShowNumber != 10 {
+		t.Fatalf("forwarded search req %v", req)
+	}
+	for _, opUserID := range client.opUserIDs {
+		if opUserID != "admin" {
+			t.Fatalf("forwarded as %s, want the app manager", opUserID)
+		}
+	}
+	// the conn to the holder is reused
+	if len(*dialed) != 1 {
+		t.Fatalf("dialed %v, want the holder once", *dialed)
+	}
+
+	// the forwarded msgs are not forwarded again
+	if err := m.indexMsgs(ctx, "si_a_b", msgs, true); err != nil || db.indexed != 1 || len(client.index) != 1 {
+		t.Fatalf("forwarded msgs: %v, indexed %d, forwarded %d", err, db.indexed, len(client.index))
+	}
+
+	// the holder serves the calls itself
+	db.held = true
+	if total, _, err := m.searchMsgIndex(ctx, &model.MsgSearchFilter{}, &sdkws.RequestPagination{PageNumber: 1, ShowNumber: 10}); err != nil || total != 1 {
+		t.Fatalf("local search: %d, %v", total, err)
+	}
+	if len(client.search) != 1 {
+		t.Fatal("the holder forwards the search")
+	}
+
+	// no instance serves the index
+	db.held = false
+	for _, addr := range []string{"", m.searchAddr} {
+		db.addr = addr
+		if err := m.indexMsgs(ctx, "si_a_b", msgs, false); err == nil {
+			t.Fatalf("indexed with the holder addr %q", addr)
+		}
+	}
+}
@@ -35,6 +35,13 @@ import (
 func (m *msgServer) SendMsg(ctx context.Context, req *pbmsg.SendMsgReq) (*pbmsg.SendMsgResp, error) {
 	if req.MsgData != nil {
 		m.encapsulateMsgData(req.MsgData)
+		for _, handler := range m.Handlers {
+			msgData, err := handler(ctx, m.config, req)
+			if err != nil {
+				return nil, err
+			}
+			req.MsgData = msgData
+		}
 		switch req.MsgData.SessionType {
 		case constant.SingleChatType:
 			return m.sendMsgSingleChat(ctx, req)
@@ -54,6 +61,13 @@ func (m *msgServer) sendMsgGroupChat(ctx context.Context, req *pbmsg.SendMsgReq)
 		prommetrics.GroupChatMsgProcessFailedCounter.Inc()
 		return nil, err
 	}
+	// the msgs failing the verification cost no tokens
+	if err = m.checkSendMsgRateLimit(ctx, req.MsgData); err != nil {
+		return nil, err
+	}
+	if err = m.checkThreadRoot(ctx, req.MsgData); err != nil {
+		return nil, err
+	}
 
 	if err = m.webhookBeforeSendGroupMsg(ctx, &m.config.WebhooksConfig.BeforeSendGroupMsg, req); err != nil {
 		return nil, err
@@ -136,6 +150,13 @@ func (m *msgServer) sendMsgSingleChat(ctx context.Context, req *pbmsg.SendMsgReq
 	if err := m.messageVerification(ctx, req); err != nil {
 		return nil, err
 	}
+	// the msgs failing the verification cost no tokens
+	if err := m.checkSendMsgRateLimit(ctx, req.MsgData); err != nil {
+		return nil, err
+	}
+	if err := m.checkThreadRoot(ctx, req.MsgData); err != nil {
+		return nil, err
+	}
 	isSend := true
 	isNotification := msgprocessor.IsNotificationByMsg(req.MsgData)
 	if !isNotification {
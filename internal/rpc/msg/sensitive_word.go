@@ -0,0 +1,238 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgext"
+	"github.com/openimsdk/open-im-server/v3/pkg/util/ahocorasick"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/msg"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/mcontext"
+)
+
+// Modes of the sensitive-word filter.
+const (
+	sensitiveWordReject = "reject"
+	sensitiveWordMask   = "mask"
+	sensitiveWordFlag   = "flag"
+)
+
+// loadSensitiveWords rebuilds the sensitive-word filter if the dictionary has been reloaded since the last load.
+func (m *msgServer) loadSensitiveWords(ctx context.Context) error {
+	// the version is read before the words, a reload in between is picked up by the next check
+	version, err := m.SensitiveWordDatabase.GetSensitiveWordsVersion(ctx)
+	if err != nil {
+		return err
+	}
+	if m.sensitiveWords.Load() != nil && version == m.sensitiveWordsVersion.Load() {
+		return nil
+	}
+	words, err := m.SensitiveWordDatabase.GetAllSensitiveWords(ctx)
+	if err != nil {
+		return err
+	}
+	m.sensitiveWords.Store(ahocorasick.New(words))
+	m.sensitiveWordsVersion.Store(version)
+	log.ZInfo(ctx, "sensitive words loaded", "version", version, "count", len(words))
+	return nil
+}
+
+func (m *msgServer) sensitiveWordsLoop(ctx context.Context) {
+	interval := time.Duration(m.config.RpcConfig.SensitiveWord.ReloadCheckInterval) * time.Second
+	if interval <= 0 {
+		interval = time.Second * 10
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			ctx := mcontext.SetOperationID(ctx, fmt.Sprintf("sensitive_word_%d_%d", os.Getpid(), now.UnixMilli()))
+			if err := m.loadSensitiveWords(ctx); err != nil {
+				log.ZError(ctx, "load sensitive words failed", err)
+			}
+		}
+	}
+}
+
+// checkSensitiveWordMode rejects a mode other than reject, mask and flag.
+func checkSensitiveWordMode(mode string) error {
+	switch mode {
+	case sensitiveWordReject, sensitiveWordMask, sensitiveWordFlag:
+		return nil
+	default:
+		return errs.ErrArgs.WrapMsg("invalid sensitiveWord.mode", "mode", mode)
+	}
+}
+
+// filterSensitiveWords applies the sensitive-word filter to the text of a Text or AtText content.
+// It returns the content to save and whether the text contains sensitive words.
+func (m *msgServer) filterSensitiveWords(contentType int32, content []byte) ([]byte, bool, error) {
+	var field string
+	switch contentType {
+	case constant.Text:
+		field = "content"
+	case constant.AtText:
+		field = "text"
+	default:
+		return content, false, nil
+	}
+	matcher := m.sensitiveWords.Load()
+	if matcher == nil || matcher.Empty() {
+		return content, false, nil
+	}
+	text, start, end, ok := jsonStringField(content, field)
+	if !ok {
+		return content, false, nil
+	}
+	switch m.config.RpcConfig.SensitiveWord.Mode {
+	case sensitiveWordReject:
+		if matcher.Contains(text) {
+			return nil, true, servererrs.ErrMsgHasSensitiveWord.WrapMsg("msg contains sensitive words")
+		}
+		return content, false, nil
+	case sensitiveWordFlag:
+		return content, matcher.Contains(text), nil
+	default:
+		masked, ok := matcher.Replace(text, '*')
+		if !ok {
+			return content, false, nil
+		}
+		// only the text is replaced, the other fields of the content are kept as the client sent them
+		buf := bytes.NewBuffer(make([]byte, 0, len(content)))
+		buf.Write(content[:start])
+		enc := json.NewEncoder(buf)
+		enc.SetEscapeHTML(false)
+		if err := enc.Encode(masked); err != nil {
+			return nil, true, errs.Wrap(err)
+		}
+		buf.Truncate(buf.Len() - 1) // the newline written by Encode
+		buf.Write(content[end:])
+		return buf.Bytes(), true, nil
+	}
+}
+
+// jsonStringField gets the string value of the top level field of a json object, and the offsets of the value in content.
+func jsonStringField(content []byte, field string) (value string, start int, end int, ok bool) {
+	dec := json.NewDecoder(bytes.NewReader(content))
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('{') {
+		return "", 0, 0, false
+	}
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return "", 0, 0, false
+		}
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err != nil {
+			return "", 0, 0, false
+		}
+		if key, _ := tok.(string); key != field {
+			continue
+		}
+		// the last one wins, as json.Unmarshal does
+		ok = json.Unmarshal(raw, &value) == nil
+		end = int(dec.InputOffset())
+		start = end - len(raw)
+	}
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('}') {
+		return "", 0, 0, false
+	}
+	return value, start, end, ok
+}
+
+// sensitiveWordInterceptor filters the sensitive words in the text of Text and AtText msgs.
+func (m *msgServer) sensitiveWordInterceptor(ctx context.Context, globalConfig *Config, req *msg.SendMsgReq) (*sdkws.MsgData, error) {
+	data := req.MsgData
+	content, hit, err := m.filterSensitiveWords(data.ContentType, data.Content)
+	if err != nil {
+		return nil, err
+	}
+	if hit && globalConfig.RpcConfig.SensitiveWord.Mode == sensitiveWordFlag {
+		if data.Options == nil {
+			data.Options = make(map[string]bool)
+		}
+		data.Options[msgprocessor.IsSensitiveWord] = true
+		log.ZInfo(ctx, "msg contains sensitive words", "sendID", data.SendID, "clientMsgID", data.ClientMsgID)
+	}
+	data.Content = content
+	return data, nil
+}
+
+func (m *msgServer) AddSensitiveWords(ctx context.Context, req *msgext.AddSensitiveWordsReq) (*msgext.AddSensitiveWordsResp, error) {
+	if err := authverify.CheckAdmin(ctx, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	if err := m.SensitiveWordDatabase.AddSensitiveWords(ctx, req.Words); err != nil {
+		return nil, err
+	}
+	return &msgext.AddSensitiveWordsResp{}, nil
+}
+
+func (m *msgServer) DeleteSensitiveWords(ctx context.Context, req *msgext.DeleteSensitiveWordsReq) (*msgext.DeleteSensitiveWordsResp, error) {
+	if err := authverify.CheckAdmin(ctx, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	if err := m.SensitiveWordDatabase.DeleteSensitiveWords(ctx, req.Words); err != nil {
+		return nil, err
+	}
+	return &msgext.DeleteSensitiveWordsResp{}, nil
+}
+
+func (m *msgServer) SearchSensitiveWords(ctx context.Context, req *msgext.SearchSensitiveWordsReq) (*msgext.SearchSensitiveWordsResp, error) {
+	if err := authverify.CheckAdmin(ctx, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	total, words, err := m.SensitiveWordDatabase.SearchSensitiveWords(ctx, req.Keyword, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	resp := &msgext.SearchSensitiveWordsResp{Total: total, Words: make([]*msgext.SensitiveWord, 0, len(words))}
+	for _, word := range words {
+		resp.Words = append(resp.Words, &msgext.SensitiveWord{Word: word.Word, CreateTime: word.CreateTime.UnixMilli()})
+	}
+	return resp, nil
+}
+
+// ReloadSensitiveWords rebuilds the filter of this replica at once, the other replicas rebuild theirs in reloadCheckInterval.
+func (m *msgServer) ReloadSensitiveWords(ctx context.Context, req *msgext.ReloadSensitiveWordsReq) (*msgext.ReloadSensitiveWordsResp, error) {
+	if err := authverify.CheckAdmin(ctx, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	if err := m.SensitiveWordDatabase.ReloadSensitiveWords(ctx); err != nil {
+		return nil, err
+	}
+	if m.config.RpcConfig.SensitiveWord.Enable {
+		if err := m.loadSensitiveWords(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return &msgext.ReloadSensitiveWordsResp{}, nil
+}
@@ -0,0 +1,87 @@
+package msg
+
+import (
+	"testing"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/util/ahocorasick"
+	"github.com/openimsdk/protocol/constant"
+)
+
+func newSensitiveWordMsgServer(mode string, words ...string) *msgServer {
+	m := &msgServer{config: &Config{}}
+	m.config.RpcConfig.SensitiveWord.Mode = mode
+	m.sensitiveWords.Store(ahocorasick.New(words))
+	return m
+}
+
+func TestFilterSensitiveWordsMask(t *testing.T) {
+	m := newSensitiveWordMsgServer(sensitiveWordMask, "bad")
+	tests := []struct {
+		name        string
+		contentType int32
+		content     string
+		want        string
+		hit         bool
+	}{
+		{
+			name:        "keeps the other fields as sent",
+			contentType: constant.Text,
+			content:     `{"z":1, "content":"a bad <b>&</b> word","id":9007199254740993,"a":{"content":"bad"}}`,
+			want:        `{"z":1, "content":"a *** <b>&</b> word","id":9007199254740993,"a":{"content":"bad"}}`,
+			hit:         true,
+		},
+		{
+			name:        "at text",
+			contentType: constant.AtText,
+			content:     `{"text":"bad!","atUserList":["u1"]}`,
+			want:        `{"text":"***!","atUserList":["u1"]}`,
+			hit:         true,
+		},
+		{
+			name:        "no sensitive word",
+			contentType: constant.Text,
+			content:     `{"content":"good" }`,
+			want:        `{"content":"good" }`,
+		},
+		{
+			name:        "not a string",
+			contentType: constant.Text,
+			content:     `{"content":["bad"]}`,
+			want:        `{"content":["bad"]}`,
+		},
+		{
+			name:        "invalid json",
+			contentType: constant.Text,
+			content:     `{"content":"bad"`,
+			want:        `{"content":"bad"`,
+		},
+		{
+			name:        "other content type",
+			contentType: constant.Picture,
+			content:     `{"content":"bad"}`,
+			want:        `{"content":"bad"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content, hit, err := m.filterSensitiveWords(tt.contentType, []byte(tt.content))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(content) != tt.want || hit != tt.hit {
+				t.Fatalf("got %s, %v, want %s, %v", content, hit, tt.want, tt.hit)
+			}
+		})
+	}
+}
+
+func TestFilterSensitiveWordsReject(t *testing.T) {
+	m := newSensitiveWordMsgServer(sensitiveWordReject, "bad")
+	if _, _, err := m.filterSensitiveWords(constant.Text, []byte(`{"content":"so bad"}`)); err == nil {
+		t.Fatal("msg with sensitive words is not rejected")
+	}
+	content, hit, err := m.filterSensitiveWords(constant.Text, []byte(`{"content":"good"}`))
+	if err != nil || hit || string(content) != `{"content":"good"}` {
+		t.Fatalf("got %s, %v, %v", content, hit, err)
+	}
+}
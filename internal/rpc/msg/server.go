@@ -18,21 +18,31 @@ import (
 	"context"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/redis"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database/bleveindex"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database/mgo"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/webhook"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgext"
+	"github.com/openimsdk/open-im-server/v3/pkg/util/ahocorasick"
 	"github.com/openimsdk/protocol/sdkws"
 	"github.com/openimsdk/tools/db/mongoutil"
 	"github.com/openimsdk/tools/db/redisutil"
 	"log"
+	"net"
 	"net/http"
+	"strconv"
+	"sync/atomic"
+	"time"
 
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/localcache/lru"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpccache"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
 	"github.com/openimsdk/protocol/constant"
 	"github.com/openimsdk/protocol/conversation"
 	"github.com/openimsdk/protocol/msg"
 	"github.com/openimsdk/tools/discovery"
+	"github.com/openimsdk/tools/utils/datautil"
+	"github.com/openimsdk/tools/utils/network"
 	"google.golang.org/grpc"
 )
 
@@ -43,17 +53,29 @@ type (
 
 	// MsgServer encapsulates dependencies required for message handling.
 	msgServer struct {
-		RegisterCenter         discovery.SvcDiscoveryRegistry   // Service discovery registry for service registration.
-		MsgDatabase            controller.CommonMsgDatabase     // Interface for message database operations.
-		Conversation           *rpcclient.ConversationRpcClient // RPC client for conversation service.
-		UserLocalCache         *rpccache.UserLocalCache         // Local cache for user data.
-		FriendLocalCache       *rpccache.FriendLocalCache       // Local cache for friend data.
-		GroupLocalCache        *rpccache.GroupLocalCache        // Local cache for group data.
-		ConversationLocalCache *rpccache.ConversationLocalCache // Local cache for conversation data.
-		Handlers               MessageInterceptorChain          // Chain of handlers for processing messages.
-		notificationSender     *rpcclient.NotificationSender    // RPC client for sending notifications.
-		config                 *Config                          // Global configuration settings.
-		webhookClient          *webhook.Client
+		RegisterCenter          discovery.SvcDiscoveryRegistry     // Service discovery registry for service registration.
+		MsgDatabase             controller.CommonMsgDatabase       // Interface for message database operations.
+		ThreadDatabase          controller.MsgThreadDatabase       // Interface for thread seq and reply operations.
+		ScheduledMsgDatabase    controller.ScheduledMsgDatabase    // Interface for scheduled message operations.
+		SearchDatabase          controller.MsgSearchDatabase       // Interface for the message search index, nil if it is disabled.
+		searchAddr              string                             // RPC address of this instance in the lease of the search index.
+		searchHolder            msgSearchHolder                    // Client of the instance holding the search index.
+		SensitiveWordDatabase   controller.SensitiveWordDatabase   // Interface for the sensitive word dictionary.
+		PinnedMsgDatabase       controller.PinnedMsgDatabase       // Interface for the pinned msgs of the conversations.
+		GroupMsgSettingDatabase controller.GroupMsgSettingDatabase // Interface for the msg features switched on by the groups.
+		MsgRetryDatabase        controller.MsgRetryDatabase        // Interface for the index retries and the burn dead letters.
+		Conversation            *rpcclient.ConversationRpcClient   // RPC client for conversation service.
+		UserLocalCache          *rpccache.UserLocalCache           // Local cache for user data.
+		FriendLocalCache        *rpccache.FriendLocalCache         // Local cache for friend data.
+		GroupLocalCache         *rpccache.GroupLocalCache          // Local cache for group data.
+		ConversationLocalCache  *rpccache.ConversationLocalCache   // Local cache for conversation data.
+		Handlers                MessageInterceptorChain            // Chain of handlers for processing messages.
+		notificationSender      *MsgNotificationSender             // RPC client for sending notifications.
+		config                  *Config                            // Global configuration settings.
+		webhookClient           *webhook.Client
+		sensitiveWords          atomic.Pointer[ahocorasick.Matcher] // Sensitive-word filter, nil until the words are loaded.
+		sensitiveWordsVersion   atomic.Int64                        // Version of the loaded sensitive words.
+		groupReadStates         lru.LRU[string, *groupReadState]    // Members and has read seqs of the groups counting the read counts of the pulled msgs.
 	}
 
 	Config struct {
@@ -66,6 +88,8 @@ type (
 		WebhooksConfig     config.Webhooks
 		LocalCacheConfig   config.LocalCache
 		Discovery          config.Discovery
+		// Index is the index of the process in RpcConfig.RPC.Ports.
+		Index int
 	}
 )
 
@@ -75,6 +99,11 @@ func (m *msgServer) addInterceptorHandler(interceptorFunc ...MessageInterceptorF
 }
 
 func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryRegistry, server *grpc.Server) error {
+	if config.RpcConfig.SensitiveWord.Enable {
+		if err := checkSensitiveWordMode(config.RpcConfig.SensitiveWord.Mode); err != nil {
+			return err
+		}
+	}
 	mgocli, err := mongoutil.NewMongoDB(ctx, config.MongodbConfig.Build())
 	if err != nil {
 		return err
@@ -97,25 +126,91 @@ func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryReg
 	if err != nil {
 		return err
 	}
+	msgThreadModel, err := mgo.NewMsgThreadMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	scheduledMsgModel, err := mgo.NewScheduledMsgMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	sensitiveWordModel, err := mgo.NewSensitiveWordMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	pinnedMsgModel, err := mgo.NewPinnedMsgMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	msgRetryDeadLetterModel, err := mgo.NewMsgRetryDeadLetterMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	groupMsgSettingModel, err := mgo.NewGroupMsgSettingMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
 	s := &msgServer{
-		Conversation:           &conversationClient,
-		MsgDatabase:            msgDatabase,
-		RegisterCenter:         client,
-		UserLocalCache:         rpccache.NewUserLocalCache(userRpcClient, &config.LocalCacheConfig, rdb),
-		GroupLocalCache:        rpccache.NewGroupLocalCache(groupRpcClient, &config.LocalCacheConfig, rdb),
-		ConversationLocalCache: rpccache.NewConversationLocalCache(conversationClient, &config.LocalCacheConfig, rdb),
-		FriendLocalCache:       rpccache.NewFriendLocalCache(friendRpcClient, &config.LocalCacheConfig, rdb),
-		config:                 config,
-		webhookClient:          webhook.NewWebhookClient(config.WebhooksConfig.URL),
+		Conversation:            &conversationClient,
+		MsgDatabase:             msgDatabase,
+		ThreadDatabase:          controller.NewMsgThreadDatabase(msgThreadModel, seqModel),
+		ScheduledMsgDatabase:    controller.NewScheduledMsgDatabase(scheduledMsgModel),
+		SensitiveWordDatabase:   controller.NewSensitiveWordDatabase(sensitiveWordModel, redis.NewSensitiveWordCache(rdb)),
+		PinnedMsgDatabase:       controller.NewPinnedMsgDatabase(pinnedMsgModel, redis.NewPinnedMsgCacheRedis(rdb, pinnedMsgModel, redis.GetRocksCacheOptions()), msgModel),
+		GroupMsgSettingDatabase: controller.NewGroupMsgSettingDatabase(groupMsgSettingModel),
+		MsgRetryDatabase:        controller.NewMsgRetryDatabase(redis.NewMsgRetryCache(rdb), msgRetryDeadLetterModel),
+		RegisterCenter:          client,
+		UserLocalCache:          rpccache.NewUserLocalCache(userRpcClient, &config.LocalCacheConfig, rdb),
+		GroupLocalCache:         rpccache.NewGroupLocalCache(groupRpcClient, &config.LocalCacheConfig, rdb),
+		ConversationLocalCache:  rpccache.NewConversationLocalCache(conversationClient, &config.LocalCacheConfig, rdb),
+		FriendLocalCache:        rpccache.NewFriendLocalCache(friendRpcClient, &config.LocalCacheConfig, rdb),
+		config:                  config,
+		webhookClient:           webhook.NewWebhookClient(config.WebhooksConfig.URL),
+		groupReadStates:         lru.NewLayLRU[string, *groupReadState](groupReadStateSize, groupReadStateTTL, time.Second, nil),
+	}
+	if config.RpcConfig.Search.Enable {
+		msgSearchIndex, err := bleveindex.NewMessageSearchIndex(config.RpcConfig.Search.IndexDir)
+		if err != nil {
+			return err
+		}
+		s.SearchDatabase = controller.NewMsgSearchDatabase(msgSearchIndex, msgDocModel, redis.NewMsgSearchCache(rdb))
+		s.searchAddr, err = rpcRegisterAddr(config)
+		if err != nil {
+			return err
+		}
+		// the index is served once its lease is taken, the other instances forward their calls to the holder
+		go s.SearchDatabase.HoldIndex(ctx, s.searchAddr, s.backfillMsgSearchIndex)
 	}
 	go func() {
 		log.Println(http.ListenAndServe("0.0.0.0:6061", nil))
 	}()
-	s.notificationSender = rpcclient.NewNotificationSender(&config.NotificationConfig, rpcclient.WithLocalSendMsg(s.SendMsg))
+	if config.RpcConfig.SensitiveWord.Enable {
+		if err := s.loadSensitiveWords(ctx); err != nil {
+			return err
+		}
+		s.addInterceptorHandler(s.sensitiveWordInterceptor)
+		go s.sensitiveWordsLoop(ctx)
+	}
+	s.notificationSender = NewMsgNotificationSender(config, rpcclient.WithLocalSendMsg(s.SendMsg))
+	go s.burnMsgsLoop(ctx)
 	msg.RegisterMsgServer(server, s)
+	msgext.RegisterMsgExtServer(server, s)
 	return nil
 }
 
+// rpcRegisterAddr returns the address the instance is registered by for the other rpc instances.
+func rpcRegisterAddr(config *Config) (string, error) {
+	registerIP, err := network.GetRpcRegisterIP(config.RpcConfig.RPC.RegisterIP)
+	if err != nil {
+		return "", err
+	}
+	rpcPort, err := datautil.GetElemByIndex(config.RpcConfig.RPC.Ports, config.Index)
+	if err != nil {
+		return "", err
+	}
+	return net.JoinHostPort(registerIP, strconv.Itoa(rpcPort)), nil
+}
+
 func (m *msgServer) conversationAndGetRecvID(conversation *conversation.Conversation, userID string) string {
 	if conversation.ConversationType == constant.SingleChatType ||
 		conversation.ConversationType == constant.NotificationChatType {
@@ -16,6 +16,7 @@ package msg
 
 import (
 	"context"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
 	"github.com/openimsdk/open-im-server/v3/pkg/util/conversationutil"
 	"github.com/openimsdk/tools/utils/datautil"
 	"github.com/openimsdk/tools/utils/timeutil"
@@ -56,6 +57,8 @@ func (m *msgServer) PullMessageBySeqs(ctx context.Context, req *sdkws.PullMessag
 				log.ZWarn(ctx, "not have msgs", nil, "conversationID", seq.ConversationID, "seq", seq)
 				continue
 			}
+			m.fillMsgsReaction(ctx, seq.ConversationID, msgs)
+			m.fillMsgsGroupReadCount(ctx, conversation, msgs)
 			resp.Msgs[seq.ConversationID] = &sdkws.PullMsgs{Msgs: msgs, IsEnd: isEnd}
 		} else {
 			var seqs []int64
@@ -80,6 +83,7 @@ func (m *msgServer) PullMessageBySeqs(ctx context.Context, req *sdkws.PullMessag
 
 				continue
 			}
+			m.fillMsgsReaction(ctx, seq.ConversationID, notificationMsgs)
 			resp.NotificationMsgs[seq.ConversationID] = &sdkws.PullMsgs{Msgs: notificationMsgs, IsEnd: isEnd}
 		}
 	}
@@ -112,11 +116,27 @@ func (m *msgServer) GetMaxSeq(ctx context.Context, req *sdkws.GetMaxSeqReq) (*sd
 func (m *msgServer) SearchMessage(ctx context.Context, req *msg.SearchMessageReq) (resp *msg.SearchMessageResp, err error) {
 	var chatLogs []*sdkws.MsgData
 	var total int32
-	resp = &msg.SearchMessageResp{}
-	if total, chatLogs, err = m.MsgDatabase.SearchMessage(ctx, req); err != nil {
+	if m.SearchDatabase == nil {
+		total, chatLogs, err = m.MsgDatabase.SearchMessage(ctx, req)
+	} else {
+		var filter *model.MsgSearchFilter
+		if filter, err = m.searchMessageFilter(req.SendID, req.RecvID, req.ContentType, req.SessionType, req.SendTime); err != nil {
+			return nil, err
+		}
+		total, chatLogs, err = m.searchIndexedMsgs(ctx, "", filter, req.Pagination)
+	}
+	if err != nil {
 		return nil, err
 	}
+	resp = &msg.SearchMessageResp{ChatLogsNum: total}
+	if resp.ChatLogs, err = m.convertChatLogs(ctx, chatLogs); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
 
+// convertChatLogs fills the msgs with the names of the senders, receivers and groups.
+func (m *msgServer) convertChatLogs(ctx context.Context, chatLogs []*sdkws.MsgData) ([]*msg.ChatLog, error) {
 	var (
 		sendIDs  []string
 		recvIDs  []string
@@ -172,6 +192,7 @@ func (m *msgServer) SearchMessage(ctx context.Context, req *msg.SearchMessageReq
 		}
 	}
 	// Construct response with updated information
+	pbChatLogs := make([]*msg.ChatLog, 0, len(chatLogs))
 	for _, chatLog := range chatLogs {
 		pbchatLog := &msg.ChatLog{}
 		datautil.CopyStructFields(pbchatLog, chatLog)
@@ -192,10 +213,9 @@ func (m *msgServer) SearchMessage(ctx context.Context, req *msg.SearchMessageReq
 			pbchatLog.GroupOwner = groupInfo.OwnerUserID
 			pbchatLog.GroupType = groupInfo.GroupType
 		}
-		resp.ChatLogs = append(resp.ChatLogs, pbchatLog)
+		pbChatLogs = append(pbChatLogs, pbchatLog)
 	}
-	resp.ChatLogsNum = total
-	return resp, nil
+	return pbChatLogs, nil
 }
 
 func (m *msgServer) GetServerTime(ctx context.Context, _ *msg.GetServerTimeReq) (*msg.GetServerTimeResp, error) {
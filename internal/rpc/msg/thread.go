@@ -0,0 +1,189 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgext"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/msg"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+func (m *msgServer) GetThreadReplies(ctx context.Context, req *msgext.GetThreadRepliesReq) (*msgext.GetThreadRepliesResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	if _, err := m.ConversationLocalCache.GetConversation(ctx, req.UserID, req.ConversationID); err != nil {
+		return nil, err
+	}
+	maxSeqs, err := m.ThreadDatabase.GetThreadMaxSeqs(ctx, req.ConversationID, []string{req.RootServerMsgID})
+	if err != nil {
+		return nil, err
+	}
+	hasReadSeqs, err := m.ThreadDatabase.GetThreadHasReadSeqs(ctx, req.UserID, req.ConversationID)
+	if err != nil {
+		return nil, err
+	}
+	resp := &msgext.GetThreadRepliesResp{
+		MaxThreadSeq:     maxSeqs[req.RootServerMsgID],
+		HasReadThreadSeq: hasReadSeqs[req.RootServerMsgID],
+	}
+	_, replies, err := m.ThreadDatabase.GetThreadReplies(ctx, req.ConversationID, req.RootServerMsgID, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	if len(replies) == 0 {
+		return resp, nil
+	}
+	seqs := datautil.Slice(replies, func(r *model.MsgThreadReply) int64 { return r.Seq })
+	_, _, msgs, err := m.MsgDatabase.GetMsgBySeqs(ctx, req.UserID, req.ConversationID, seqs)
+	if err != nil {
+		return nil, err
+	}
+	msgMap := datautil.SliceToMap(msgs, func(msg *sdkws.MsgData) int64 { return msg.GetSeq() })
+	resp.Replies = make([]*msgext.ThreadReply, 0, len(replies))
+	for _, reply := range replies {
+		msg, ok := msgMap[reply.Seq]
+		if !ok {
+			continue
+		}
+		resp.Replies = append(resp.Replies, &msgext.ThreadReply{ThreadSeq: reply.ThreadSeq, Msg: msg})
+	}
+	return resp, nil
+}
+
+func (m *msgServer) MarkThreadAsRead(ctx context.Context, req *msgext.MarkThreadAsReadReq) (*msgext.MarkThreadAsReadResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	if _, err := m.ConversationLocalCache.GetConversation(ctx, req.UserID, req.ConversationID); err != nil {
+		return nil, err
+	}
+	maxSeqs, err := m.ThreadDatabase.GetThreadMaxSeqs(ctx, req.ConversationID, []string{req.RootServerMsgID})
+	if err != nil {
+		return nil, err
+	}
+	maxSeq, ok := maxSeqs[req.RootServerMsgID]
+	if !ok {
+		return nil, errs.ErrRecordNotFound.WrapMsg("thread not found", "rootServerMsgID", req.RootServerMsgID)
+	}
+	hasReadSeq := req.HasReadThreadSeq
+	if hasReadSeq == 0 {
+		hasReadSeq = maxSeq
+	}
+	if hasReadSeq > maxSeq {
+		return nil, errs.ErrArgs.WrapMsg("hasReadThreadSeq must not be bigger than maxThreadSeq")
+	}
+	hasReadSeqs, err := m.ThreadDatabase.GetThreadHasReadSeqs(ctx, req.UserID, req.ConversationID)
+	if err != nil {
+		return nil, err
+	}
+	if current, ok := hasReadSeqs[req.RootServerMsgID]; ok && current >= hasReadSeq {
+		return &msgext.MarkThreadAsReadResp{HasReadThreadSeq: current}, nil
+	}
+	if err := m.ThreadDatabase.SetThreadHasReadSeq(ctx, req.UserID, req.ConversationID, req.RootServerMsgID, hasReadSeq); err != nil {
+		return nil, err
+	}
+	m.notificationSender.ThreadHasReadNotification(ctx, &msgext.ThreadHasReadTips{
+		UserID:           req.UserID,
+		ConversationID:   req.ConversationID,
+		RootServerMsgID:  req.RootServerMsgID,
+		HasReadThreadSeq: hasReadSeq,
+	})
+	return &msgext.MarkThreadAsReadResp{HasReadThreadSeq: hasReadSeq}, nil
+}
+
+func (m *msgServer) GetConversationsHasReadAndMaxSeqWithThread(ctx context.Context, req *msg.GetConversationsHasReadAndMaxSeqReq) (*msgext.GetConversationsHasReadAndMaxSeqWithThreadResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	conversationIDs := req.ConversationIDs
+	if len(conversationIDs) == 0 {
+		var err error
+		conversationIDs, err = m.ConversationLocalCache.GetConversationIDs(ctx, req.UserID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	seqResp, err := m.GetConversationsHasReadAndMaxSeq(ctx, &msg.GetConversationsHasReadAndMaxSeqReq{UserID: req.UserID, ConversationIDs: conversationIDs})
+	if err != nil {
+		return nil, err
+	}
+	resp := &msgext.GetConversationsHasReadAndMaxSeqWithThreadResp{Seqs: seqResp.Seqs}
+	// the seqs are returned without the thread unreads if they are unavailable
+	resp.ThreadUnreads, err = m.getConversationsThreadUnread(ctx, req.UserID, conversationIDs)
+	if err != nil {
+		log.ZWarn(ctx, "get conversations thread unread failed", err, "userID", req.UserID)
+	}
+	return resp, nil
+}
+
+// getConversationsThreadUnread counts the unread replies of the threads followed by the user,
+// the conversations in which the user follows no thread are not in the map.
+func (m *msgServer) getConversationsThreadUnread(ctx context.Context, userID string, conversationIDs []string) (map[string]*msgext.ThreadUnread, error) {
+	hasReadSeqs, maxSeqs, err := m.ThreadDatabase.GetConversationsThreadSeqs(ctx, userID, conversationIDs)
+	if err != nil {
+		return nil, err
+	}
+	unreads := make(map[string]*msgext.ThreadUnread, len(hasReadSeqs))
+	for conversationID, seqs := range hasReadSeqs {
+		unread := &msgext.ThreadUnread{}
+		for rootServerMsgID, hasReadSeq := range seqs {
+			if n := maxSeqs[conversationID][rootServerMsgID] - hasReadSeq; n > 0 {
+				unread.UnreadThreadCount++
+				unread.UnreadReplyCount += n
+			}
+		}
+		unreads[conversationID] = unread
+	}
+	return unreads, nil
+}
+
+// checkThreadRoot checks the root of the thread reply is a msg of its conversation. The root of a new thread is got
+// by the seq carried in the reply, from the msg cache first, so a root not stored in mongo yet can be replied to.
+func (m *msgServer) checkThreadRoot(ctx context.Context, msg *sdkws.MsgData) error {
+	rootServerMsgID, rootSeq := msgprocessor.GetThreadRoot(msg)
+	if rootServerMsgID == "" {
+		return nil
+	}
+	conversationID := msgprocessor.GetConversationIDByMsg(msg)
+	exist, err := m.ThreadDatabase.ExistThread(ctx, conversationID, rootServerMsgID)
+	if err != nil {
+		return err
+	}
+	if exist {
+		return nil
+	}
+	if rootSeq < 1 {
+		return errs.ErrArgs.WrapMsg("threadRootSeq is required by the first reply of a thread", "rootServerMsgID", rootServerMsgID)
+	}
+	_, _, msgs, err := m.MsgDatabase.GetMsgBySeqs(ctx, msg.SendID, conversationID, []int64{rootSeq})
+	if err != nil {
+		return err
+	}
+	if len(msgs) == 0 || msgs[0] == nil || msgs[0].ServerMsgID != rootServerMsgID || msgs[0].Status == constant.MsgDeleted {
+		return errs.ErrRecordNotFound.WrapMsg("thread root msg not found", "conversationID", conversationID,
+			"rootServerMsgID", rootServerMsgID, "rootSeq", rootSeq)
+	}
+	return nil
+}
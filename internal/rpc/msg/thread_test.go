@@ -0,0 +1,51 @@
+package msg
+
+import (
+	"context"
+	"testing"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+)
+
+type fakeThreadDatabase struct {
+	controller.MsgThreadDatabase
+	hasReadSeqs map[string]map[string]int64
+	maxSeqs     map[string]map[string]int64
+	calls       int
+}
+
+func (f *fakeThreadDatabase) GetConversationsThreadSeqs(_ context.Context, _ string, _ []string) (map[string]map[string]int64, map[string]map[string]int64, error) {
+	f.calls++
+	return f.hasReadSeqs, f.maxSeqs, nil
+}
+
+func TestGetConversationsThreadUnread(t *testing.T) {
+	db := &fakeThreadDatabase{
+		hasReadSeqs: map[string]map[string]int64{
+			"sg_g1": {"root1": 2, "root2": 5, "root3": 1},
+			"sg_g2": {"root4": 3},
+		},
+		maxSeqs: map[string]map[string]int64{
+			"sg_g1": {"root1": 4, "root2": 5, "root3": 2},
+			"sg_g2": {"root4": 3},
+		},
+	}
+	m := &msgServer{ThreadDatabase: db}
+	unreads, err := m.getConversationsThreadUnread(context.Background(), "u1", []string{"sg_g1", "sg_g2", "sg_g3"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the seqs of all conversations are got at once
+	if db.calls != 1 {
+		t.Fatalf("got the thread seqs %d times, want 1", db.calls)
+	}
+	if u := unreads["sg_g1"]; u == nil || u.UnreadThreadCount != 2 || u.UnreadReplyCount != 3 {
+		t.Fatalf("sg_g1 unread %v, want 2 threads and 3 replies", u)
+	}
+	if u := unreads["sg_g2"]; u == nil || u.UnreadThreadCount != 0 || u.UnreadReplyCount != 0 {
+		t.Fatalf("sg_g2 unread %v, want none", u)
+	}
+	if _, ok := unreads["sg_g3"]; ok {
+		t.Fatal("conversation without followed threads is in the unreads")
+	}
+}
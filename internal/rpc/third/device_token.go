@@ -0,0 +1,63 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package third
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/thirdext"
+	"github.com/openimsdk/tools/log"
+)
+
+func (t *thirdServer) UpdateDeviceToken(ctx context.Context, req *thirdext.UpdateDeviceTokenReq) (*thirdext.UpdateDeviceTokenResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, t.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	if err := t.thirdDatabase.UpdateDeviceToken(ctx, req.UserID, int(req.PlatformID), req.Vendor, req.Token, req.ExpireTime); err != nil {
+		return nil, err
+	}
+	log.ZDebug(ctx, "device token updated", "userID", req.UserID, "platformID", req.PlatformID, "vendor", req.Vendor)
+	return &thirdext.UpdateDeviceTokenResp{}, nil
+}
+
+func (t *thirdServer) DeleteDeviceToken(ctx context.Context, req *thirdext.DeleteDeviceTokenReq) (*thirdext.DeleteDeviceTokenResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, t.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	if err := t.thirdDatabase.DeleteDeviceToken(ctx, req.UserID, int(req.PlatformID), req.Vendor); err != nil {
+		return nil, err
+	}
+	return &thirdext.DeleteDeviceTokenResp{}, nil
+}
+
+func (t *thirdServer) GetDeviceTokens(ctx context.Context, req *thirdext.GetDeviceTokensReq) (*thirdext.GetDeviceTokensResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, t.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	tokens, err := t.thirdDatabase.GetDeviceTokens(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	resp := &thirdext.GetDeviceTokensResp{Tokens: make([]*thirdext.DeviceToken, 0, len(tokens))}
+	for _, token := range tokens {
+		resp.Tokens = append(resp.Tokens, &thirdext.DeviceToken{
+			PlatformID: int32(token.PlatformID),
+			Vendor:     token.Vendor,
+			Token:      token.Token,
+		})
+	}
+	return resp, nil
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/redis"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database/mgo"
 	"github.com/openimsdk/open-im-server/v3/pkg/localcache"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/thirdext"
 	"time"
 
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
@@ -88,13 +89,15 @@ func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryReg
 		return err
 	}
 	localcache.InitLocalCache(&config.LocalCacheConfig)
-	third.RegisterThirdServer(server, &thirdServer{
+	s := &thirdServer{
 		thirdDatabase: controller.NewThirdDatabase(redis.NewThirdCache(rdb), logdb),
 		userRpcClient: rpcclient.NewUserRpcClient(client, config.Share.RpcRegisterName.User, config.Share.IMAdminUserID),
 		s3dataBase:    controller.NewS3Database(rdb, o, s3db),
 		defaultExpire: time.Hour * 24 * 7,
 		config:        config,
-	})
+	}
+	third.RegisterThirdServer(server, s)
+	thirdext.RegisterThirdExtServer(server, s)
 	return nil
 }
 
@@ -0,0 +1,53 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package user
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/userext"
+)
+
+func (s *userServer) SetUserLocale(ctx context.Context, req *userext.SetUserLocaleReq) (*userext.SetUserLocaleResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	if _, err := s.db.GetUserByID(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+	if err := s.db.UpdateByMap(ctx, req.UserID, map[string]any{"locale": req.Locale}); err != nil {
+		return nil, err
+	}
+	return &userext.SetUserLocaleResp{}, nil
+}
+
+// GetUserLocales is for the app managers and the other services, e.g. the localized offline pushes.
+func (s *userServer) GetUserLocales(ctx context.Context, req *userext.GetUserLocalesReq) (*userext.GetUserLocalesResp, error) {
+	if err := authverify.CheckAdmin(ctx, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	users, err := s.db.Find(ctx, req.UserIDs)
+	if err != nil {
+		return nil, err
+	}
+	locales := make(map[string]string, len(users))
+	for _, user := range users {
+		if user.Locale != "" {
+			locales[user.UserID] = user.Locale
+		}
+	}
+	return &userext.GetUserLocalesResp{Locales: locales}, nil
+}
@@ -36,6 +36,7 @@ import (
 	"github.com/openimsdk/open-im-server/v3/pkg/common/convert"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/userext"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
 	"github.com/openimsdk/protocol/constant"
 	"github.com/openimsdk/protocol/sdkws"
@@ -108,6 +109,7 @@ func Start(ctx context.Context, config *Config, client registry.SvcDiscoveryRegi
 		webhookClient:            webhook.NewWebhookClient(config.WebhooksConfig.URL),
 	}
 	pbuser.RegisterUserServer(server, u)
+	userext.RegisterUserExtServer(server, u)
 	return u.db.InitOnce(context.Background(), users)
 }
 
@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
 	kdisc "github.com/openimsdk/open-im-server/v3/pkg/common/discoveryregister"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgext"
 	"github.com/openimsdk/protocol/msg"
 	"github.com/openimsdk/tools/mcontext"
 	"github.com/openimsdk/tools/mw"
@@ -69,8 +70,40 @@ func Start(ctx context.Context, config *CronTaskConfig) error {
 	if _, err := crontab.AddFunc(config.CronTask.ChatRecordsClearTime, clearFunc); err != nil {
 		return errs.Wrap(err)
 	}
-	log.ZInfo(ctx, "start cron task", "chatRecordsClearTime", config.CronTask.ChatRecordsClearTime)
+	if config.CronTask.ScheduledMsgDeliverTime != "" {
+		deliverFunc := func() {
+			ctx := mcontext.SetOperationID(ctx, fmt.Sprintf("cron_scheduled_%d_%d", os.Getpid(), time.Now().UnixMilli()))
+			deliverScheduledMsgs(ctx, msgext.NewMsgExtClient(conn))
+		}
+		// a delivery may last longer than the interval, the next one is skipped instead of claiming concurrently
+		job := cron.NewChain(cron.SkipIfStillRunning(cron.DiscardLogger)).Then(cron.FuncJob(deliverFunc))
+		if _, err := crontab.AddJob(config.CronTask.ScheduledMsgDeliverTime, job); err != nil {
+			return errs.Wrap(err)
+		}
+	}
+	log.ZInfo(ctx, "start cron task", "chatRecordsClearTime", config.CronTask.ChatRecordsClearTime, "scheduledMsgDeliverTime", config.CronTask.ScheduledMsgDeliverTime)
 	crontab.Start()
 	<-ctx.Done()
 	return nil
 }
+
+// deliverScheduledMsgs sends the due scheduled msgs one at a time, each msg is claimed right before it is sent,
+// so the replicas of the crontask never send the same msg twice. A claim lost before sending (e.g. the crontask
+// restarted) is claimed again after its timeout, only the msg being sent at that time is left for manual review.
+func deliverScheduledMsgs(ctx context.Context, extCli msgext.MsgExtClient) {
+	for {
+		resp, err := extCli.ClaimDueScheduledMsgs(ctx, &msgext.ClaimDueScheduledMsgsReq{Count: 1})
+		if err != nil {
+			log.ZError(ctx, "claim due scheduled msgs failed", err)
+			return
+		}
+		if len(resp.ScheduledMsgs) == 0 {
+			return
+		}
+		for _, scheduledMsg := range resp.ScheduledMsgs {
+			if _, err := extCli.SendScheduledMsg(ctx, &msgext.SendScheduledMsgReq{ScheduleID: scheduledMsg.ScheduleID, ClaimID: resp.ClaimID}); err != nil {
+				log.ZError(ctx, "send scheduled msg failed", err, "scheduleID", scheduledMsg.ScheduleID)
+			}
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgext"
+	"google.golang.org/grpc"
+)
+
+type scheduledMsgClient struct {
+	msgext.MsgExtClient
+	due    []string
+	counts []int32
+	sent   []string
+}
+
+func (c *scheduledMsgClient) ClaimDueScheduledMsgs(_ context.Context, req *msgext.ClaimDueScheduledMsgsReq, _ ...grpc.CallOption) (*msgext.ClaimDueScheduledMsgsResp, error) {
+	c.counts = append(c.counts, req.Count)
+	resp := &msgext.ClaimDueScheduledMsgsResp{ClaimID: "claim"}
+	for len(c.due) > 0 && len(resp.ScheduledMsgs) < int(req.Count) {
+		resp.ScheduledMsgs = append(resp.ScheduledMsgs, &msgext.ScheduledMsg{ScheduleID: c.due[0]})
+		c.due = c.due[1:]
+	}
+	return resp, nil
+}
+
+func (c *scheduledMsgClient) SendScheduledMsg(_ context.Context, req *msgext.SendScheduledMsgReq, _ ...grpc.CallOption) (*msgext.SendScheduledMsgResp, error) {
+	c.sent = append(c.sent, req.ScheduleID)
+	if req.ScheduleID == "failed" {
+		return nil, errors.New("send failed")
+	}
+	return &msgext.SendScheduledMsgResp{ServerMsgID: req.ScheduleID}, nil
+}
+
+func TestDeliverScheduledMsgs(t *testing.T) {
+	cli := &scheduledMsgClient{due: []string{"msg1", "failed", "msg2"}}
+	deliverScheduledMsgs(context.Background(), cli)
+	if want := []string{"msg1", "failed", "msg2"}; !reflect.DeepEqual(cli.sent, want) {
+		t.Fatalf("sent %v, want %v", cli.sent, want)
+	}
+	// each msg is claimed right before it is sent
+	if want := []int32{1, 1, 1, 1}; !reflect.DeepEqual(cli.counts, want) {
+		t.Fatalf("claimed counts %v, want %v", cli.counts, want)
+	}
+}
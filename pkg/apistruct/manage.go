@@ -67,6 +67,14 @@ type SendMsgReq struct {
 	SendMsg
 }
 
+// ScheduleSendMsgReq extends SendMsgReq with the time at which the message is to be sent.
+type ScheduleSendMsgReq struct {
+	SendMsgReq
+
+	// ScheduledTime is the time to send the message in milliseconds, required field.
+	ScheduledTime int64 `json:"scheduledTime" binding:"required"`
+}
+
 type GetConversationListReq struct {
 	// userID uniquely identifies the user.
 	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty" binding:"required"`
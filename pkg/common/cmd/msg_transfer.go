@@ -34,6 +34,7 @@ func NewMsgTransferCmd() *MsgTransferCmd {
 	ret := &MsgTransferCmd{msgTransferConfig: &msgTransferConfig}
 	ret.configMap = map[string]any{
 		OpenIMMsgTransferCfgFileName: &msgTransferConfig.MsgTransfer,
+		OpenIMRPCMsgCfgFileName:      &msgTransferConfig.MsgConfig,
 		RedisConfigFileName:          &msgTransferConfig.RedisConfig,
 		MongodbConfigFileName:        &msgTransferConfig.MongodbConfig,
 		KafkaConfigFileName:          &msgTransferConfig.KafkaConfig,
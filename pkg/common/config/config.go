@@ -105,8 +105,9 @@ type API struct {
 }
 
 type CronTask struct {
-	ChatRecordsClearTime string `mapstructure:"chatRecordsClearTime"`
-	RetainChatRecords    int    `mapstructure:"retainChatRecords"`
+	ChatRecordsClearTime    string `mapstructure:"chatRecordsClearTime"`
+	RetainChatRecords       int    `mapstructure:"retainChatRecords"`
+	ScheduledMsgDeliverTime string `mapstructure:"scheduledMsgDeliverTime"`
 }
 
 type OfflinePushConfig struct {
@@ -176,8 +177,51 @@ type MsgGateway struct {
 		WebsocketMaxConnNum int   `mapstructure:"websocketMaxConnNum"`
 		WebsocketMaxMsgLen  int   `mapstructure:"websocketMaxMsgLen"`
 		WebsocketTimeout    int   `mapstructure:"websocketTimeout"`
+		Compression         struct {
+			PermessageDeflate struct {
+				Enable    bool `mapstructure:"enable"`
+				Level     int  `mapstructure:"level"`
+				Threshold int  `mapstructure:"threshold"`
+			} `mapstructure:"permessageDeflate"`
+			Zstd struct {
+				Enable   bool   `mapstructure:"enable"`
+				Level    string `mapstructure:"level"`
+				DictPath string `mapstructure:"dictPath"`
+			} `mapstructure:"zstd"`
+		} `mapstructure:"compression"`
+		Resume struct {
+			Enable     bool `mapstructure:"enable"`
+			Window     int  `mapstructure:"window"`
+			BufferSize int  `mapstructure:"bufferSize"`
+		} `mapstructure:"resume"`
+		KeepAlive struct {
+			Enable       bool `mapstructure:"enable"`
+			PingInterval int  `mapstructure:"pingInterval"`
+			MissedPongs  int  `mapstructure:"missedPongs"`
+		} `mapstructure:"keepAlive"`
+		Drain struct {
+			Timeout       int `mapstructure:"timeout"`
+			BatchSize     int `mapstructure:"batchSize"`
+			BatchInterval int `mapstructure:"batchInterval"`
+		} `mapstructure:"drain"`
+		PushWorkers   int `mapstructure:"pushWorkers"`
+		OutboundQueue struct {
+			Size           int    `mapstructure:"size"`
+			OverflowPolicy string `mapstructure:"overflowPolicy"`
+		} `mapstructure:"outboundQueue"`
 	} `mapstructure:"longConnSvr"`
+	EphemeralMsg struct {
+		RateLimit TokenBucket `mapstructure:"rateLimit"`
+	} `mapstructure:"ephemeralMsg"`
 	MultiLoginPolicy int `mapstructure:"multiLoginPolicy"`
+	MultiLoginRules  struct {
+		Enable bool `mapstructure:"enable"`
+		Rules  []struct {
+			PlatformClass string `mapstructure:"platformClass"`
+			MaxConns      int    `mapstructure:"maxConns"`
+			OnExceed      string `mapstructure:"onExceed"`
+		} `mapstructure:"rules"`
+	} `mapstructure:"multiLoginRules"`
 }
 
 type MsgTransfer struct {
@@ -211,6 +255,42 @@ type Push struct {
 		PushURL      string `mapstructure:"pushURL"`
 		PushIntent   string `mapstructure:"pushIntent"`
 	} `mapstructure:"jpns"`
+	APNs struct {
+		KeyFilePath string `mapstructure:"keyFilePath"`
+		KeyID       string `mapstructure:"keyID"`
+		TeamID      string `mapstructure:"teamID"`
+		Topic       string `mapstructure:"topic"`
+		Endpoint    string `mapstructure:"endpoint"`
+	} `mapstructure:"apns"`
+	Routing struct {
+		Enable        bool     `mapstructure:"enable"`
+		Vendors       []string `mapstructure:"vendors"`
+		DefaultVendor string   `mapstructure:"defaultVendor"`
+	} `mapstructure:"routing"`
+	Retry struct {
+		Enable         bool `mapstructure:"enable"`
+		MaxAttempts    int  `mapstructure:"maxAttempts"`
+		InitialBackoff int  `mapstructure:"initialBackoff"`
+		MaxBackoff     int  `mapstructure:"maxBackoff"`
+	} `mapstructure:"retry"`
+	StalePush struct {
+		Policy    string `mapstructure:"policy"`
+		Threshold struct {
+			Single       int `mapstructure:"single"`
+			Group        int `mapstructure:"group"`
+			Notification int `mapstructure:"notification"`
+		} `mapstructure:"threshold"`
+		Summary struct {
+			Window  int    `mapstructure:"window"`
+			Title   string `mapstructure:"title"`
+			Content string `mapstructure:"content"`
+		} `mapstructure:"summary"`
+	} `mapstructure:"stalePush"`
+	Templates struct {
+		Enable        bool                           `mapstructure:"enable"`
+		DefaultLocale string                         `mapstructure:"defaultLocale"`
+		Locales       map[string]PushLocaleTemplates `mapstructure:"locales"`
+	} `mapstructure:"templates"`
 	IOSPush struct {
 		PushSound  string `mapstructure:"pushSound"`
 		BadgeCount bool   `mapstructure:"badgeCount"`
@@ -218,6 +298,27 @@ type Push struct {
 	} `mapstructure:"iosPush"`
 }
 
+// PushLocaleTemplates are the offline push templates of a locale by the content type of the msg.
+type PushLocaleTemplates struct {
+	Text    PushTemplate `mapstructure:"text"`
+	Picture PushTemplate `mapstructure:"picture"`
+	Voice   PushTemplate `mapstructure:"voice"`
+	Video   PushTemplate `mapstructure:"video"`
+	File    PushTemplate `mapstructure:"file"`
+	// Mention is for the users mentioned by an @ msg, the others get the Text one.
+	Mention PushTemplate `mapstructure:"mention"`
+	// Common is for the other content types.
+	Common PushTemplate `mapstructure:"common"`
+	// Summary is for the summary pushes of the stale msgs.
+	Summary PushTemplate `mapstructure:"summary"`
+}
+
+// PushTemplate is a text/template of the title and content of an offline push, the content is the title if it is empty.
+type PushTemplate struct {
+	Title   string `mapstructure:"title"`
+	Content string `mapstructure:"content"`
+}
+
 type Auth struct {
 	RPC struct {
 		RegisterIP string `mapstructure:"registerIP"`
@@ -265,6 +366,30 @@ type Msg struct {
 	} `mapstructure:"rpc"`
 	Prometheus   Prometheus `mapstructure:"prometheus"`
 	FriendVerify bool       `mapstructure:"friendVerify"`
+	Search       struct {
+		Enable   bool   `mapstructure:"enable"`
+		IndexDir string `mapstructure:"indexDir"`
+	} `mapstructure:"search"`
+	RateLimit     MsgRateLimit `mapstructure:"rateLimit"`
+	SensitiveWord struct {
+		Enable bool   `mapstructure:"enable"`
+		Mode   string `mapstructure:"mode"`
+		// ReloadCheckInterval is in seconds.
+		ReloadCheckInterval int `mapstructure:"reloadCheckInterval"`
+	} `mapstructure:"sensitiveWord"`
+}
+
+type MsgRateLimit struct {
+	Enable   bool        `mapstructure:"enable"`
+	User     TokenBucket `mapstructure:"user"`
+	Group    TokenBucket `mapstructure:"group"`
+	Platform TokenBucket `mapstructure:"platform"`
+}
+
+// TokenBucket is refilled with Rate tokens per second up to Burst tokens, it is disabled when Rate is 0.
+type TokenBucket struct {
+	Rate  float64 `mapstructure:"rate"`
+	Burst int     `mapstructure:"burst"`
 }
 
 type Third struct {
@@ -16,8 +16,10 @@ package convert
 
 import (
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgext"
 	"github.com/openimsdk/protocol/constant"
 	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/utils/datautil"
 )
 
 func MsgPb2DB(msg *sdkws.MsgData) *model.MsgDataModel {
@@ -96,3 +98,41 @@ func MsgDB2Pb(msgModel *model.MsgDataModel) *sdkws.MsgData {
 	msg.Ex = msgModel.Ex
 	return &msg
 }
+
+func MsgSearchFilterDB2Pb(filter *model.MsgSearchFilter) *msgext.MsgSearchFilter {
+	return &msgext.MsgSearchFilter{
+		Keyword:         filter.Keyword,
+		SendID:          filter.SendID,
+		RecvID:          filter.RecvID,
+		ConversationIDs: filter.ConversationIDs,
+		ContentTypes:    filter.ContentTypes,
+		SessionType:     filter.SessionType,
+		StartTime:       filter.StartTime,
+		EndTime:         filter.EndTime,
+	}
+}
+
+func MsgSearchFilterPb2DB(filter *msgext.MsgSearchFilter) *model.MsgSearchFilter {
+	return &model.MsgSearchFilter{
+		Keyword:         filter.Keyword,
+		SendID:          filter.SendID,
+		RecvID:          filter.RecvID,
+		ConversationIDs: filter.ConversationIDs,
+		ContentTypes:    filter.ContentTypes,
+		SessionType:     filter.SessionType,
+		StartTime:       filter.StartTime,
+		EndTime:         filter.EndTime,
+	}
+}
+
+func MsgSearchHitsDB2Pb(hits []*model.MsgSearchHit) []*msgext.MsgSearchHit {
+	return datautil.Slice(hits, func(hit *model.MsgSearchHit) *msgext.MsgSearchHit {
+		return &msgext.MsgSearchHit{ConversationID: hit.ConversationID, Seq: hit.Seq}
+	})
+}
+
+func MsgSearchHitsPb2DB(hits []*msgext.MsgSearchHit) []*model.MsgSearchHit {
+	return datautil.Slice(hits, func(hit *msgext.MsgSearchHit) *model.MsgSearchHit {
+		return &model.MsgSearchHit{ConversationID: hit.ConversationID, Seq: hit.Seq}
+	})
+}
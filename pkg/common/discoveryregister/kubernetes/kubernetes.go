@@ -103,6 +103,11 @@ func getSelfHost(ctx context.Context, gatewayName string) string {
 	return host
 }
 
+// GetMsgGatewayHosts returns the headless hosts of all the msggateway pods.
+func GetMsgGatewayHosts(ctx context.Context, gatewayName string) []string {
+	return getMsgGatewayHost(ctx, gatewayName)
+}
+
 // like openimserver-openim-msggateway-0.openimserver-openim-msggateway-headless.openim-lin.svc.cluster.local:88.
 // Replica set in kubernetes environment
 func getMsgGatewayHost(ctx context.Context, gatewayName string) []string {
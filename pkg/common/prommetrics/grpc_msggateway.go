@@ -27,4 +27,20 @@ var (
 		Name: "gateway_send_msg_total",
 		Help: "The number of gateway send msg total",
 	})
+	GateWayReapedConnCounter = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "gateway_reaped_conn_total",
+		Help: "The number of the dead connections closed by the gateway keepalive",
+	})
+	GateWayOutboundQueueGauge = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "gateway_outbound_queue_frames",
+		Help: "The number of the frames in the outbound queues of all the connections",
+	})
+	GateWayOutboundDroppedCounter = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "gateway_outbound_dropped_frames_total",
+		Help: "The number of the push frames dropped from the full outbound queues",
+	})
+	GateWaySlowConnCounter = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "gateway_slow_conn_total",
+		Help: "The number of the connections disconnected for their full outbound queues",
+	})
 )
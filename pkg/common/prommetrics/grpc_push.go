@@ -23,4 +23,16 @@ var (
 		Name: "msg_offline_push_failed_total",
 		Help: "The number of msg failed offline pushed",
 	})
+	MsgOfflinePushVendorCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "msg_offline_push_vendor_total",
+		Help: "The number of the offline pushes of each vendor of the push routing by result",
+	}, []string{"vendor", "result"})
+	MsgOfflinePushRetryCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "msg_offline_push_retry_total",
+		Help: "The number of the offline push retries by result, dead is the retries failing the max attempts, permanent is the retries failing by permanent errors",
+	}, []string{"result"})
+	MsgStalePushSkippedCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "msg_stale_push_skipped_total",
+		Help: "The number of the stale msgs whose pushes are skipped by policy",
+	}, []string{"policy"})
 )
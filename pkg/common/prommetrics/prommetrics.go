@@ -34,15 +34,16 @@ func NewGrpcPromObj(cusMetrics []prometheus.Collector) (*prometheus.Registry, *g
 func GetGrpcCusMetrics(registerName string, share *config2.Share) []prometheus.Collector {
 	switch registerName {
 	case share.RpcRegisterName.MessageGateway:
-		return []prometheus.Collector{OnlineUserGauge, GateWaySendMsgTotalCounter}
+		return []prometheus.Collector{OnlineUserGauge, GateWaySendMsgTotalCounter, GateWayReapedConnCounter,
+			GateWayOutboundQueueGauge, GateWayOutboundDroppedCounter, GateWaySlowConnCounter}
 	case share.RpcRegisterName.Msg:
 		return []prometheus.Collector{SingleChatMsgProcessSuccessCounter, SingleChatMsgProcessFailedCounter,
 			GroupChatMsgProcessSuccessCounter, GroupChatMsgProcessFailedCounter, CacheFriendHitsCounter,
 			CacheFriendMissesCounter, CacheFriendMemoryUsageGauge}
 	case "Transfer":
-		return []prometheus.Collector{MsgInsertRedisSuccessCounter, MsgInsertRedisFailedCounter, MsgInsertMongoSuccessCounter, MsgInsertMongoFailedCounter, SeqSetFailedCounter}
+		return []prometheus.Collector{MsgInsertRedisSuccessCounter, MsgInsertRedisFailedCounter, MsgInsertMongoSuccessCounter, MsgInsertMongoFailedCounter, SeqSetFailedCounter, MsgRetryCounter}
 	case share.RpcRegisterName.Push:
-		return []prometheus.Collector{MsgOfflinePushFailedCounter}
+		return []prometheus.Collector{MsgOfflinePushFailedCounter, MsgOfflinePushVendorCounter, MsgOfflinePushRetryCounter, MsgStalePushSkippedCounter}
 	case share.RpcRegisterName.Auth:
 		return []prometheus.Collector{UserLoginCounter}
 	default:
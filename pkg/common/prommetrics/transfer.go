@@ -39,4 +39,8 @@ var (
 		Name: "seq_set_failed_total",
 		Help: "The number of failed set seq",
 	})
+	MsgRetryCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "msg_retry_total",
+		Help: "The number of the retries of the failed processing of the stored msgs by kind and result, dead is the retries failing the max attempts, lost is the retries failing to be scheduled",
+	}, []string{"kind", "result"})
 )
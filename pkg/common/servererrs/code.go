@@ -74,6 +74,9 @@ const (
 	MutedInGroup          = 1402 // Member muted in the group
 	MutedGroup            = 1403 // Group is muted
 	MsgAlreadyRevoke      = 1404 // Message already revoked
+	MsgRateLimited        = 1405 // Messages are sent too frequently
+	MsgHasSensitiveWord   = 1406 // Message contains sensitive words
+	MsgBusy               = 1407 // Messages are being updated by another request, retry later
 
 	// Token error codes.
 	TokenExpiredError     = 1501
@@ -89,6 +92,8 @@ const (
 	ConnArgsErr          = 1602
 	PushMsgErr           = 1603
 	IOSBackgroundPushErr = 1604
+	GatewayDraining      = 1605
+	ConnTerminalLimit    = 1606
 
 	// S3 error codes.
 	FileUploadedExpiredError = 1701 // Upload expired
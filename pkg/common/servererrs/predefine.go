@@ -54,15 +54,20 @@ var (
 	ErrNotPeersFriend      = errs.NewCodeError(NotPeersFriend, "NotPeersFriend")
 	ErrRelationshipAlready = errs.NewCodeError(RelationshipAlreadyError, "RelationshipAlreadyError")
 
-	ErrMutedInGroup     = errs.NewCodeError(MutedInGroup, "MutedInGroup")
-	ErrMutedGroup       = errs.NewCodeError(MutedGroup, "MutedGroup")
-	ErrMsgAlreadyRevoke = errs.NewCodeError(MsgAlreadyRevoke, "MsgAlreadyRevoke")
+	ErrMutedInGroup        = errs.NewCodeError(MutedInGroup, "MutedInGroup")
+	ErrMutedGroup          = errs.NewCodeError(MutedGroup, "MutedGroup")
+	ErrMsgAlreadyRevoke    = errs.NewCodeError(MsgAlreadyRevoke, "MsgAlreadyRevoke")
+	ErrMsgRateLimited      = errs.NewCodeError(MsgRateLimited, "MsgRateLimited")
+	ErrMsgHasSensitiveWord = errs.NewCodeError(MsgHasSensitiveWord, "MsgHasSensitiveWord")
+	ErrMsgBusy             = errs.NewCodeError(MsgBusy, "MsgBusy")
 
 	ErrConnOverMaxNumLimit = errs.NewCodeError(ConnOverMaxNumLimit, "ConnOverMaxNumLimit")
 
 	ErrConnArgsErr          = errs.NewCodeError(ConnArgsErr, "args err, need token, sendID, platformID")
 	ErrPushMsgErr           = errs.NewCodeError(PushMsgErr, "push msg err")
 	ErrIOSBackgroundPushErr = errs.NewCodeError(IOSBackgroundPushErr, "ios background push err")
+	ErrGatewayDraining      = errs.NewCodeError(GatewayDraining, "msg gateway is draining")
+	ErrConnTerminalLimit    = errs.NewCodeError(ConnTerminalLimit, "too many terminals logged in")
 
 	ErrFileUploadedExpired = errs.NewCodeError(FileUploadedExpiredError, "FileUploadedExpiredError")
 )
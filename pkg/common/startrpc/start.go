@@ -47,6 +47,15 @@ import (
 func Start[T any](ctx context.Context, discovery *config2.Discovery, prometheusConfig *config2.Prometheus, listenIP,
 	registerIP string, rpcPorts []int, index int, rpcRegisterName string, share *config2.Share, config T, rpcFn func(ctx context.Context,
 	config T, client discovery.SvcDiscoveryRegistry, server *grpc.Server) error, options ...grpc.ServerOption) error {
+	return StartWithBeforeStop(ctx, discovery, prometheusConfig, listenIP, registerIP, rpcPorts, index, rpcRegisterName, share,
+		config, rpcFn, nil, options...)
+}
+
+// StartWithBeforeStop starts the rpc server as Start, beforeStop is called on SIGTERM while the rpc server still serves,
+// e.g. the msg gateway drains its connections before the pushes to them stop.
+func StartWithBeforeStop[T any](ctx context.Context, discovery *config2.Discovery, prometheusConfig *config2.Prometheus, listenIP,
+	registerIP string, rpcPorts []int, index int, rpcRegisterName string, share *config2.Share, config T, rpcFn func(ctx context.Context,
+	config T, client discovery.SvcDiscoveryRegistry, server *grpc.Server) error, beforeStop func(), options ...grpc.ServerOption) error {
 
 	rpcPort, err := datautil.GetElemByIndex(rpcPorts, index)
 	if err != nil {
@@ -145,6 +154,9 @@ func Start[T any](ctx context.Context, discovery *config2.Discovery, prometheusC
 	select {
 	case <-sigs:
 		program.SIGTERMExit()
+		if beforeStop != nil {
+			beforeStop()
+		}
 		ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
 		defer cancel()
 		if err := gracefulStopWithCtx(ctx, srv.GracefulStop); err != nil {
@@ -29,6 +29,16 @@ const (
 	reactionWriteGroup   = "EX_GROUP_"
 	reactionReadGroup    = "EX_SUPER_GROUP_"
 	reactionNotification = "EX_NOTIFICATION_"
+	burnMsgQueue         = "BURN_MSG_QUEUE"
+	burnMsgAttempt       = "BURN_MSG_ATTEMPT"
+	msgRateLimit         = "MSG_RATE_LIMIT:"
+	msgSearchIndexOwner  = "MSG_SEARCH_INDEX_OWNER"
+	msgSearchIndexServed = "MSG_SEARCH_INDEX_SERVED"
+	pinnedMsgs           = "PINNED_MSGS:"
+	pinnedMsgsLock       = "PINNED_MSGS_LOCK:"
+	// the keys of the msg retry queue share a hash tag so that they are in the same slot of a redis cluster.
+	msgRetryQueue = "{MSG_RETRY}:QUEUE"
+	msgRetryTask  = "{MSG_RETRY}:TASK"
 )
 
 func GetMessageCacheKey(conversationID string, seq int64) string {
@@ -61,6 +71,70 @@ func GetLockMessageTypeKey(clientMsgID string, TypeKey string) string {
 	return exTypeKeyLocker + clientMsgID + "_" + TypeKey
 }
 
+// GetPinnedMsgsKey caches the pins of the conversation.
+func GetPinnedMsgsKey(conversationID string) string {
+	return pinnedMsgs + conversationID
+}
+
+// GetPinnedMsgsLockKey guards the count and the insert of the pinned msgs of the conversation.
+func GetPinnedMsgsLockKey(conversationID string) string {
+	return pinnedMsgsLock + conversationID
+}
+
+// GetBurnMsgQueueKey is a sorted set of the msgs waiting to be burned, member is conversationID:seq, score is the burn time.
+func GetBurnMsgQueueKey() string {
+	return burnMsgQueue
+}
+
+// GetBurnMsgAttemptKey is a hash of the failed attempts to burn the msgs, field is the member of GetBurnMsgQueueKey.
+func GetBurnMsgAttemptKey() string {
+	return burnMsgAttempt
+}
+
+func GetBurnMsgMember(conversationID string, seq int64) string {
+	return conversationID + ":" + strconv.Itoa(int(seq))
+}
+
+// The rate limit keys of a sender are hash tagged by the sender, so that its buckets are taken at once
+// and the buckets of different senders spread over the slots of a redis cluster.
+func GetMsgRateLimitUserKey(userID string) string {
+	return msgRateLimit + "{" + userID + "}:USER"
+}
+
+func GetMsgRateLimitPlatformKey(userID string, platformID int32) string {
+	return msgRateLimit + "{" + userID + "}:PLATFORM:" + strconv.Itoa(int(platformID))
+}
+
+// GetEphemeralRateLimitKey is the token bucket of the ephemeral msgs sent by userID.
+func GetEphemeralRateLimitKey(userID string) string {
+	return msgRateLimit + "{" + userID + "}:EPHEMERAL"
+}
+
+func GetMsgRateLimitGroupKey(groupID string) string {
+	return msgRateLimit + "GROUP:" + groupID
+}
+
 func GetSendMsgKey(id string) string {
 	return sendMsgFailedFlag + id
 }
+
+// GetMsgSearchIndexOwnerKey is the lease of the msg rpc instance holding the msg search index, a hash of the owner
+// and the rpc address of the instance.
+func GetMsgSearchIndexOwnerKey() string {
+	return msgSearchIndexOwner
+}
+
+// GetMsgSearchIndexServedKey is the owner which served the msg search index last, it never expires.
+func GetMsgSearchIndexServedKey() string {
+	return msgSearchIndexServed
+}
+
+// GetMsgRetryQueueKey is the sorted set of the msg retry taskIDs scored by the time to retry in milliseconds.
+func GetMsgRetryQueueKey() string {
+	return msgRetryQueue
+}
+
+// GetMsgRetryTaskKey is the hash of the msg retry tasks by taskID.
+func GetMsgRetryTaskKey() string {
+	return msgRetryTask
+}
@@ -0,0 +1,44 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cachekey
+
+const (
+	// the keys of the retry queue share a hash tag so that they are in the same slot of a redis cluster.
+	offlinePushRetryQueue = "{OFFLINE_PUSH_RETRY}:QUEUE"
+	offlinePushRetryTask  = "{OFFLINE_PUSH_RETRY}:TASK"
+	// the keys of the push summaries share a hash tag as well.
+	pushSummaryDue   = "{PUSH_SUMMARY}:DUE"
+	pushSummaryCount = "{PUSH_SUMMARY}:COUNT"
+)
+
+// GetOfflinePushRetryQueueKey is the sorted set of the taskIDs scored by the time to retry in milliseconds.
+func GetOfflinePushRetryQueueKey() string {
+	return offlinePushRetryQueue
+}
+
+// GetOfflinePushRetryTaskKey is the hash of the tasks by taskID.
+func GetOfflinePushRetryTaskKey() string {
+	return offlinePushRetryTask
+}
+
+// GetPushSummaryDueKey is the sorted set of the userIDs of the summaries scored by the time to push in milliseconds.
+func GetPushSummaryDueKey() string {
+	return pushSummaryDue
+}
+
+// GetPushSummaryCountKey is the hash of the numbers of the msgs of the summaries by userID.
+func GetPushSummaryCountKey() string {
+	return pushSummaryCount
+}
@@ -19,6 +19,9 @@ const (
 	minSeq                 = "MIN_SEQ:"
 	conversationUserMinSeq = "CON_USER_MIN_SEQ:"
 	hasReadSeq             = "HAS_READ_SEQ:"
+	threadMaxSeq           = "THREAD_MAX_SEQ:"
+	threadHasReadSeq       = "THREAD_HAS_READ_SEQ:"
+	threadReplySeq         = "THREAD_REPLY_SEQ:"
 )
 
 func GetMaxSeqKey(conversationID string) string {
@@ -36,3 +39,19 @@ func GetHasReadSeqKey(conversationID string, userID string) string {
 func GetConversationUserMinSeqKey(conversationID, userID string) string {
 	return conversationUserMinSeq + conversationID + "u:" + userID
 }
+
+// GetThreadMaxSeqKey is a hash of the conversation, field is the root serverMsgID of a thread.
+func GetThreadMaxSeqKey(conversationID string) string {
+	return threadMaxSeq + "{" + conversationID + "}"
+}
+
+// GetThreadReplySeqKey is a hash of a thread, field is the serverMsgID of a reply which has got its thread seq,
+// it expires with the thread going quiet and shares the slot with GetThreadMaxSeqKey.
+func GetThreadReplySeqKey(conversationID string, rootServerMsgID string) string {
+	return threadReplySeq + "{" + conversationID + "}:" + rootServerMsgID
+}
+
+// GetThreadHasReadSeqKey is a hash of the user in the conversation, field is the root serverMsgID of a followed thread.
+func GetThreadHasReadSeqKey(conversationID string, userID string) string {
+	return threadHasReadSeq + userID + ":" + conversationID
+}
@@ -22,6 +22,7 @@ const (
 	getuiToken              = "GETUI_TOKEN"
 	getuiTaskID             = "GETUI_TASK_ID"
 	fmcToken                = "FCM_TOKEN:"
+	deviceToken             = "DEVICE_TOKEN:"
 	userBadgeUnreadCountSum = "USER_BADGE_UNREAD_COUNT_SUM:"
 )
 
@@ -29,6 +30,15 @@ func GetFcmAccountTokenKey(account string, platformID int) string {
 	return fmcToken + account + ":" + strconv.Itoa(platformID)
 }
 
+func GetDeviceTokenKey(userID string) string {
+	return deviceToken + userID
+}
+
+// GetDeviceTokenField returns the field of the token of the device for the vendor in the hash of GetDeviceTokenKey.
+func GetDeviceTokenField(platformID int, vendor string) string {
+	return strconv.Itoa(platformID) + ":" + vendor
+}
+
 func GetUserBadgeUnreadCountSumKey(userID string) string {
 	return userBadgeUnreadCountSum + userID
 }
@@ -18,6 +18,7 @@ const (
 	UserInfoKey             = "USER_INFO:"
 	UserGlobalRecvMsgOptKey = "USER_GLOBAL_RECV_MSG_OPT_KEY:"
 	olineStatusKey          = "ONLINE_STATUS:"
+	userLocaleKey           = "USER_LOCALE:"
 )
 
 func GetUserInfoKey(userID string) string {
@@ -28,6 +29,11 @@ func GetUserGlobalRecvMsgOptKey(userID string) string {
 	return UserGlobalRecvMsgOptKey + userID
 }
 
+// GetUserLocaleKey is the key of the locale of the user in the local caches, it is linked to the user info key.
+func GetUserLocaleKey(userID string) string {
+	return userLocaleKey + userID
+}
+
 func GetOnlineStatusKey(modKey string) string {
 	return olineStatusKey + modKey
 }
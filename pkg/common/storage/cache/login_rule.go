@@ -0,0 +1,24 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import "context"
+
+// LoginRuleCache holds the login rules of the msg gateway set by the admin api, they replace the rules of the config.
+type LoginRuleCache interface {
+	// GetLoginRules returns "" if the rules have never been set.
+	GetLoginRules(ctx context.Context) (string, error)
+	SetLoginRules(ctx context.Context, rules string) error
+}
@@ -29,10 +29,53 @@ type MsgCache interface {
 	GetSendMsgStatus(ctx context.Context, id string) (int32, error)
 	JudgeMessageReactionExist(ctx context.Context, clientMsgID string, sessionType int32) (bool, error)
 	GetOneMessageAllReactionList(ctx context.Context, clientMsgID string, sessionType int32) (map[string]string, error)
+	// GetMessagesAllReactionList gets the cached reactions of the msgs by one pipeline, k: clientMsgID,
+	// the msgs whose reactions are not cached are not in the result.
+	GetMessagesAllReactionList(ctx context.Context, msgs []*sdkws.MsgData) (map[string]map[string]string, error)
 	DeleteOneMessageKey(ctx context.Context, clientMsgID string, sessionType int32, subKey string) error
 	SetMessageReactionExpire(ctx context.Context, clientMsgID string, sessionType int32, expiration time.Duration) (bool, error)
 	GetMessageTypeKeyValue(ctx context.Context, clientMsgID string, sessionType int32, typeKey string) (string, error)
 	SetMessageTypeKeyValue(ctx context.Context, clientMsgID string, sessionType int32, typeKey, value string) error
+	// LockMessageTypeKey locks the type key of the msg, it returns ErrDuplicateKey if it is already locked.
 	LockMessageTypeKey(ctx context.Context, clientMsgID string, TypeKey string) error
 	UnLockMessageTypeKey(ctx context.Context, clientMsgID string, TypeKey string) error
+	// LockPinnedMsgs locks the pinned msgs of the conversation for the owner, it returns false if they are already locked.
+	LockPinnedMsgs(ctx context.Context, conversationID string, owner string) (bool, error)
+	// UnLockPinnedMsgs unlocks the pinned msgs of the conversation only if they are locked by the owner.
+	UnLockPinnedMsgs(ctx context.Context, conversationID string, owner string) error
+	// AddBurnMsgs queues the msgs to be burned at burnTime, a msg already queued keeps its burn time.
+	AddBurnMsgs(ctx context.Context, conversationID string, seqs []int64, burnTime time.Time) error
+	// ClaimDueBurnMsgs claims at most count msgs whose burn time has passed by postponing their burn time to now+lease,
+	// so that a msg is claimed by only one instance and is claimed again if it is not burned within lease.
+	// k: conversationID, v: seqs.
+	ClaimDueBurnMsgs(ctx context.Context, now time.Time, count int64, lease time.Duration) (map[string][]int64, error)
+	// DelayBurnMsgs changes the burn time of the msgs still queued.
+	DelayBurnMsgs(ctx context.Context, conversationID string, seqs []int64, burnTime time.Time) error
+	// IncrBurnMsgAttempts counts a failed attempt to burn each msg, k: seq, v: the failed attempts of the msg.
+	IncrBurnMsgAttempts(ctx context.Context, conversationID string, seqs []int64) (map[int64]int64, error)
+	// DelBurnMsgs removes the msgs and their failed attempts from the queue.
+	DelBurnMsgs(ctx context.Context, conversationID string, seqs []int64) error
+	// TakeRateLimitTokens takes a token from each token bucket of keys only if none of them is empty, the bucket of keys[i]
+	// is refilled with rates[i] tokens per second up to bursts[i] tokens. It returns the index of the first empty bucket, or -1.
+	// keys must be in the same slot of a redis cluster.
+	TakeRateLimitTokens(ctx context.Context, keys []string, rates []float64, bursts []int) (int, error)
+	// ReturnRateLimitTokens gives back a token taken by TakeRateLimitTokens to each token bucket of keys, up to bursts[i] tokens.
+	// keys must be in the same slot of a redis cluster.
+	ReturnRateLimitTokens(ctx context.Context, keys []string, bursts []int) error
+	// TakeEphemeralMsgToken takes a token from the bucket of the ephemeral msgs of userID, it returns false if it is empty.
+	TakeEphemeralMsgToken(ctx context.Context, userID string, rate float64, burst int) (bool, error)
+}
+
+// MsgSearchCache holds the lease of the msg search index, which can only be served by one msg rpc instance.
+type MsgSearchCache interface {
+	// LeaseMsgSearchIndex takes or renews the lease for owner serving the index at addr, it returns false if the lease
+	// is held by another owner.
+	LeaseMsgSearchIndex(ctx context.Context, owner string, addr string, expire time.Duration) (bool, error)
+	// ReleaseMsgSearchIndex releases the lease if it is held by owner.
+	ReleaseMsgSearchIndex(ctx context.Context, owner string) error
+	// GetMsgSearchIndexAddr returns the rpc address of the instance holding the lease, "" if the lease is free.
+	GetMsgSearchIndexAddr(ctx context.Context) (string, error)
+	// GetMsgSearchIndexServed returns the owner which served the index last, "" if it has never been served.
+	GetMsgSearchIndexServed(ctx context.Context) (string, error)
+	SetMsgSearchIndexServed(ctx context.Context, owner string) error
 }
@@ -0,0 +1,32 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+)
+
+// MsgRetryCache is the delayed queue of the failed processing of the stored msgs.
+type MsgRetryCache interface {
+	// AddMsgRetryTask schedules the task to be retried at dueTime, it replaces the task of the same taskID.
+	AddMsgRetryTask(ctx context.Context, task *model.MsgRetryTask, dueTime time.Time) error
+	// ClaimDueMsgRetryTasks takes at most count due tasks and postpones them by lease, a claimed task is
+	// due again after lease unless it is rescheduled or deleted, e.g. the claimer restarted.
+	ClaimDueMsgRetryTasks(ctx context.Context, count int, lease time.Duration) ([]*model.MsgRetryTask, error)
+	DelMsgRetryTask(ctx context.Context, taskID string) error
+}
@@ -0,0 +1,30 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+)
+
+type PinnedMsgCache interface {
+	BatchDeleter
+	ClonePinnedMsgCache() PinnedMsgCache
+	// GetPinnedMsgs gets the pins of the conversations, the pins of each conversation are cached together.
+	GetPinnedMsgs(ctx context.Context, conversationIDs []string) ([]*model.PinnedMsg, error)
+	// DelPinnedMsgs deletes the cached pins, exec when a msg of the conversations is pinned or unpinned.
+	DelPinnedMsgs(conversationIDs ...string) PinnedMsgCache
+}
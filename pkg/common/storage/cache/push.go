@@ -0,0 +1,42 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+)
+
+// PushRetryCache is the delayed queue of the failed offline pushes.
+type PushRetryCache interface {
+	// AddPushRetryTask schedules the task to be retried at dueTime, it replaces the task of the same taskID.
+	AddPushRetryTask(ctx context.Context, task *model.OfflinePushTask, dueTime time.Time) error
+	// ClaimDuePushRetryTasks takes at most count due tasks and postpones them by lease, a claimed task is
+	// due again after lease unless it is rescheduled or deleted, e.g. the claimer restarted.
+	ClaimDuePushRetryTasks(ctx context.Context, count int, lease time.Duration) ([]*model.OfflinePushTask, error)
+	DelPushRetryTask(ctx context.Context, taskID string) error
+}
+
+// PushSummaryCache counts the stale msgs of each user collapsed into a summary offline push, the summaries
+// are due to be pushed by time.
+type PushSummaryCache interface {
+	// IncrPushSummaries counts a msg into the summaries of the users, a summary created by its first msg
+	// is due at dueTime.
+	IncrPushSummaries(ctx context.Context, userIDs []string, dueTime time.Time) error
+	// TakeDuePushSummaries removes at most count due summaries and returns their numbers of the msgs by userID.
+	TakeDuePushSummaries(ctx context.Context, count int) (map[string]int64, error)
+}
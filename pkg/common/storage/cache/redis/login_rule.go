@@ -0,0 +1,44 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/cachekey"
+	"github.com/openimsdk/tools/errs"
+	"github.com/redis/go-redis/v9"
+)
+
+func NewLoginRuleCache(rdb redis.UniversalClient) cache.LoginRuleCache {
+	return &loginRuleCache{rdb: rdb}
+}
+
+type loginRuleCache struct {
+	rdb redis.UniversalClient
+}
+
+func (l *loginRuleCache) GetLoginRules(ctx context.Context) (string, error) {
+	rules, err := l.rdb.Get(ctx, cachekey.GetLoginRulesKey()).Result()
+	if err == redis.Nil {
+		return "", nil
+	}
+	return rules, errs.Wrap(err)
+}
+
+func (l *loginRuleCache) SetLoginRules(ctx context.Context, rules string) error {
+	return errs.Wrap(l.rdb.Set(ctx, cachekey.GetLoginRulesKey(), rules, 0).Err())
+}
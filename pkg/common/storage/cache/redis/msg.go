@@ -23,6 +23,8 @@ import (
 	"github.com/openimsdk/tools/errs"
 	"github.com/openimsdk/tools/utils/datautil"
 	"github.com/redis/go-redis/v9"
+	"strconv"
+	"strings"
 	"time"
 ) //
 
@@ -108,7 +110,14 @@ func (c *msgCache) GetSendMsgStatus(ctx context.Context, id string) (int32, erro
 
 func (c *msgCache) LockMessageTypeKey(ctx context.Context, clientMsgID string, TypeKey string) error {
 	key := c.getLockMessageTypeKey(clientMsgID, TypeKey)
-	return errs.Wrap(c.rdb.SetNX(ctx, key, 1, time.Minute).Err())
+	ok, err := c.rdb.SetNX(ctx, key, 1, time.Minute).Result()
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	if !ok {
+		return errs.ErrDuplicateKey.WrapMsg("msg type key is locked", "clientMsgID", clientMsgID, "typeKey", TypeKey)
+	}
+	return nil
 }
 
 func (c *msgCache) UnLockMessageTypeKey(ctx context.Context, clientMsgID string, TypeKey string) error {
@@ -116,6 +125,24 @@ func (c *msgCache) UnLockMessageTypeKey(ctx context.Context, clientMsgID string,
 	return errs.Wrap(c.rdb.Del(ctx, key).Err())
 }
 
+func (c *msgCache) LockPinnedMsgs(ctx context.Context, conversationID string, owner string) (bool, error) {
+	ok, err := c.rdb.SetNX(ctx, cachekey.GetPinnedMsgsLockKey(conversationID), owner, time.Minute).Result()
+	return ok, errs.Wrap(err)
+}
+
+// unlockPinnedMsgsScript deletes the lock only if it is held by the owner, a lock expired meanwhile may be held by another one.
+var unlockPinnedMsgsScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+    return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+func (c *msgCache) UnLockPinnedMsgs(ctx context.Context, conversationID string, owner string) error {
+	_, err := callLua(ctx, c.rdb, unlockPinnedMsgsScript, []string{cachekey.GetPinnedMsgsLockKey(conversationID)}, []any{owner})
+	return err
+}
+
 func (c *msgCache) JudgeMessageReactionExist(ctx context.Context, clientMsgID string, sessionType int32) (bool, error) {
 	n, err := c.rdb.Exists(ctx, c.getMessageReactionExPrefix(clientMsgID, sessionType)).Result()
 	if err != nil {
@@ -144,6 +171,27 @@ func (c *msgCache) GetOneMessageAllReactionList(ctx context.Context, clientMsgID
 	return val, errs.Wrap(err)
 }
 
+func (c *msgCache) GetMessagesAllReactionList(ctx context.Context, msgs []*sdkws.MsgData) (map[string]map[string]string, error) {
+	if len(msgs) == 0 {
+		return nil, nil
+	}
+	pipe := c.rdb.Pipeline()
+	cmds := make([]*redis.MapStringStringCmd, 0, len(msgs))
+	for _, msg := range msgs {
+		cmds = append(cmds, pipe.HGetAll(ctx, c.getMessageReactionExPrefix(msg.ClientMsgID, msg.SessionType)))
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	values := make(map[string]map[string]string, len(msgs))
+	for i, msg := range msgs {
+		if value := cmds[i].Val(); len(value) > 0 {
+			values[msg.ClientMsgID] = value
+		}
+	}
+	return values, nil
+}
+
 func (c *msgCache) DeleteOneMessageKey(ctx context.Context, clientMsgID string, sessionType int32, subKey string) error {
 	return errs.Wrap(c.rdb.HDel(ctx, c.getMessageReactionExPrefix(clientMsgID, sessionType), subKey).Err())
 }
@@ -185,3 +233,182 @@ func (c *msgCache) GetMessagesBySeq(ctx context.Context, conversationID string,
 	return seqMsgs, failedSeqs, nil
 
 }
+
+func (c *msgCache) AddBurnMsgs(ctx context.Context, conversationID string, seqs []int64, burnTime time.Time) error {
+	if len(seqs) == 0 {
+		return nil
+	}
+	members := make([]redis.Z, 0, len(seqs))
+	for _, seq := range seqs {
+		members = append(members, redis.Z{Score: float64(burnTime.UnixMilli()), Member: cachekey.GetBurnMsgMember(conversationID, seq)})
+	}
+	return errs.Wrap(c.rdb.ZAddNX(ctx, cachekey.GetBurnMsgQueueKey(), members...).Err())
+}
+
+// claimBurnMsgsScript postpones the burn time of the due members of the queue KEYS[1] to ARGV[3] and returns them.
+var claimBurnMsgsScript = redis.NewScript(`
+local members = redis.call('ZRANGEBYSCORE', KEYS[1], '-inf', ARGV[1], 'LIMIT', 0, ARGV[2])
+for i, member in ipairs(members) do
+    redis.call('ZADD', KEYS[1], 'XX', ARGV[3], member)
+end
+return members
+`)
+
+func (c *msgCache) ClaimDueBurnMsgs(ctx context.Context, now time.Time, count int64, lease time.Duration) (map[string][]int64, error) {
+	keys := []string{cachekey.GetBurnMsgQueueKey()}
+	v, err := callLua(ctx, c.rdb, claimBurnMsgsScript, keys, []any{now.UnixMilli(), count, now.Add(lease).UnixMilli()})
+	if err != nil {
+		return nil, err
+	}
+	values, ok := v.([]any)
+	if !ok && v != nil {
+		return nil, errs.ErrInternalServer.WrapMsg("invalid claim burn msgs result", "result", v)
+	}
+	burnMsgs := make(map[string][]int64)
+	for _, value := range values {
+		member, ok := value.(string)
+		if !ok {
+			continue
+		}
+		i := strings.LastIndex(member, ":")
+		if i < 0 {
+			continue
+		}
+		seq, err := strconv.ParseInt(member[i+1:], 10, 64)
+		if err != nil {
+			continue
+		}
+		burnMsgs[member[:i]] = append(burnMsgs[member[:i]], seq)
+	}
+	return burnMsgs, nil
+}
+
+func (c *msgCache) IncrBurnMsgAttempts(ctx context.Context, conversationID string, seqs []int64) (map[int64]int64, error) {
+	if len(seqs) == 0 {
+		return nil, nil
+	}
+	pipe := c.rdb.Pipeline()
+	cmds := make([]*redis.IntCmd, 0, len(seqs))
+	for _, seq := range seqs {
+		cmds = append(cmds, pipe.HIncrBy(ctx, cachekey.GetBurnMsgAttemptKey(), cachekey.GetBurnMsgMember(conversationID, seq), 1))
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	attempts := make(map[int64]int64, len(seqs))
+	for i, seq := range seqs {
+		attempts[seq] = cmds[i].Val()
+	}
+	return attempts, nil
+}
+
+func (c *msgCache) DelayBurnMsgs(ctx context.Context, conversationID string, seqs []int64, burnTime time.Time) error {
+	if len(seqs) == 0 {
+		return nil
+	}
+	members := make([]redis.Z, 0, len(seqs))
+	for _, seq := range seqs {
+		members = append(members, redis.Z{Score: float64(burnTime.UnixMilli()), Member: cachekey.GetBurnMsgMember(conversationID, seq)})
+	}
+	return errs.Wrap(c.rdb.ZAddXX(ctx, cachekey.GetBurnMsgQueueKey(), members...).Err())
+}
+
+func (c *msgCache) DelBurnMsgs(ctx context.Context, conversationID string, seqs []int64) error {
+	if len(seqs) == 0 {
+		return nil
+	}
+	members := make([]any, 0, len(seqs))
+	fields := make([]string, 0, len(seqs))
+	for _, seq := range seqs {
+		member := cachekey.GetBurnMsgMember(conversationID, seq)
+		members = append(members, member)
+		fields = append(fields, member)
+	}
+	pipe := c.rdb.Pipeline()
+	pipe.ZRem(ctx, cachekey.GetBurnMsgQueueKey(), members...)
+	pipe.HDel(ctx, cachekey.GetBurnMsgAttemptKey(), fields...)
+	_, err := pipe.Exec(ctx)
+	return errs.Wrap(err)
+}
+
+// takeRateLimitTokensScript uses the time of redis, so that the msg rpc replicas share the same clock.
+// ARGV holds the rate and burst of each key in turn.
+var takeRateLimitTokensScript = redis.NewScript(`
+local t = redis.call('TIME')
+local now = tonumber(t[1]) * 1000 + math.floor(tonumber(t[2]) / 1000)
+local tokens = {}
+for i, key in ipairs(KEYS) do
+    local rate = tonumber(ARGV[i * 2 - 1])
+    local burst = tonumber(ARGV[i * 2])
+    local bucket = redis.call('HMGET', key, 'tokens', 'time')
+    local n = tonumber(bucket[1])
+    local last = tonumber(bucket[2])
+    if n == nil or last == nil then
+        n = burst
+        last = now
+    end
+    n = math.min(burst, n + (now - last) * rate / 1000)
+    if n < 1 then
+        return i - 1
+    end
+    tokens[i] = n
+end
+for i, key in ipairs(KEYS) do
+    local rate = tonumber(ARGV[i * 2 - 1])
+    local burst = tonumber(ARGV[i * 2])
+    redis.call('HSET', key, 'tokens', tostring(tokens[i] - 1), 'time', now)
+    redis.call('PEXPIRE', key, math.ceil(burst * 1000 / rate) + 1000)
+end
+return -1
+`)
+
+func (c *msgCache) TakeRateLimitTokens(ctx context.Context, keys []string, rates []float64, bursts []int) (int, error) {
+	if len(keys) == 0 {
+		return -1, nil
+	}
+	args := make([]any, 0, len(keys)*2)
+	for i := range keys {
+		args = append(args, rates[i], bursts[i])
+	}
+	v, err := callLua(ctx, c.rdb, takeRateLimitTokensScript, keys, args)
+	if err != nil {
+		return 0, err
+	}
+	index, ok := v.(int64)
+	if !ok {
+		return 0, errs.ErrInternalServer.WrapMsg("invalid take rate limit tokens result", "result", v)
+	}
+	return int(index), nil
+}
+
+// returnRateLimitTokensScript keeps the refill time of the buckets, the buckets expired meanwhile are full.
+// ARGV holds the burst of each key.
+var returnRateLimitTokensScript = redis.NewScript(`
+for i, key in ipairs(KEYS) do
+    local n = tonumber(redis.call('HGET', key, 'tokens'))
+    if n ~= nil then
+        redis.call('HSET', key, 'tokens', tostring(math.min(tonumber(ARGV[i]), n + 1)))
+    end
+end
+return 0
+`)
+
+func (c *msgCache) ReturnRateLimitTokens(ctx context.Context, keys []string, bursts []int) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	args := make([]any, 0, len(keys))
+	for i := range keys {
+		args = append(args, bursts[i])
+	}
+	_, err := callLua(ctx, c.rdb, returnRateLimitTokensScript, keys, args)
+	return err
+}
+
+func (c *msgCache) TakeEphemeralMsgToken(ctx context.Context, userID string, rate float64, burst int) (bool, error) {
+	index, err := c.TakeRateLimitTokens(ctx, []string{cachekey.GetEphemeralRateLimitKey(userID)}, []float64{rate}, []int{burst})
+	if err != nil {
+		return false, err
+	}
+	return index < 0, nil
+}
@@ -0,0 +1,43 @@
+package redis
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redismock/v9"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/cachekey"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClaimDueBurnMsgs(t *testing.T) {
+	rdb, mock := redismock.NewClientMock()
+	ctx := context.Background()
+	c := NewMsgCache(rdb)
+	now := time.Now()
+
+	keys := []string{cachekey.GetBurnMsgQueueKey()}
+	mock.ExpectEvalSha(claimBurnMsgsScript.Hash(), keys, []any{now.UnixMilli(), int64(10), now.Add(time.Minute).UnixMilli()}).
+		SetVal([]any{"si_a_b:1", "si_a_b:2", "sg_group:3", "invalid"})
+	burnMsgs, err := c.ClaimDueBurnMsgs(ctx, now, 10, time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]int64{"si_a_b": {1, 2}, "sg_group": {3}}, burnMsgs)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDelBurnMsgs(t *testing.T) {
+	rdb, mock := redismock.NewClientMock()
+	ctx := context.Background()
+	c := NewMsgCache(rdb)
+
+	members := []string{cachekey.GetBurnMsgMember("si_a_b", 1), cachekey.GetBurnMsgMember("si_a_b", 2)}
+	mock.ExpectZRem(cachekey.GetBurnMsgQueueKey(), members[0], members[1]).SetVal(2)
+	mock.ExpectHDel(cachekey.GetBurnMsgAttemptKey(), members...).SetVal(1)
+	require.NoError(t, c.DelBurnMsgs(ctx, "si_a_b", []int64{1, 2}))
+
+	mock.ExpectZAddXX(cachekey.GetBurnMsgQueueKey(), redis.Z{Score: 1000, Member: members[0]}).SetVal(0)
+	require.NoError(t, c.DelayBurnMsgs(ctx, "si_a_b", []int64{1}, time.UnixMilli(1000)))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
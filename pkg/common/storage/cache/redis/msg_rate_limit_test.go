@@ -0,0 +1,74 @@
+package redis
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/go-redis/redismock/v9"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/cachekey"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTakeRateLimitTokens(t *testing.T) {
+	rdb, mock := redismock.NewClientMock()
+	ctx := context.Background()
+	c := NewMsgCache(rdb)
+
+	keys := []string{cachekey.GetMsgRateLimitUserKey("user1"), cachekey.GetMsgRateLimitPlatformKey("user1", 1)}
+	args := []any{float64(5), 10, float64(2), 4}
+
+	// every bucket has a token
+	mock.ExpectEvalSha(takeRateLimitTokensScript.Hash(), keys, args).SetVal(int64(-1))
+	index, err := c.TakeRateLimitTokens(ctx, keys, []float64{5, 2}, []int{10, 4})
+	require.NoError(t, err)
+	assert.Equal(t, -1, index)
+
+	// the platform bucket is empty, none of the tokens are taken
+	mock.ExpectEvalSha(takeRateLimitTokensScript.Hash(), keys, args).SetVal(int64(1))
+	index, err = c.TakeRateLimitTokens(ctx, keys, []float64{5, 2}, []int{10, 4})
+	require.NoError(t, err)
+	assert.Equal(t, 1, index)
+
+	mock.ExpectEvalSha(takeRateLimitTokensScript.Hash(), keys, args).SetVal("invalid")
+	_, err = c.TakeRateLimitTokens(ctx, keys, []float64{5, 2}, []int{10, 4})
+	assert.Error(t, err)
+
+	index, err = c.TakeRateLimitTokens(ctx, nil, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, -1, index)
+
+	ephemeralKeys := []string{cachekey.GetEphemeralRateLimitKey("user1")}
+	mock.ExpectEvalSha(takeRateLimitTokensScript.Hash(), ephemeralKeys, []any{float64(1), 3}).SetVal(int64(0))
+	ok, err := c.TakeEphemeralMsgToken(ctx, "user1", 1, 3)
+	require.NoError(t, err)
+	assert.False(t, ok)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestReturnRateLimitTokens(t *testing.T) {
+	rdb, mock := redismock.NewClientMock()
+	ctx := context.Background()
+	c := NewMsgCache(rdb)
+
+	keys := []string{cachekey.GetMsgRateLimitUserKey("user1"), cachekey.GetMsgRateLimitPlatformKey("user1", 1)}
+	mock.ExpectEvalSha(returnRateLimitTokensScript.Hash(), keys, []any{10, 4}).SetVal(int64(0))
+	require.NoError(t, c.ReturnRateLimitTokens(ctx, keys, []int{10, 4}))
+
+	require.NoError(t, c.ReturnRateLimitTokens(ctx, nil, nil))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMsgRateLimitKeySlot(t *testing.T) {
+	// the buckets of a sender are taken by one script, so they must be in one slot, while senders must not share one
+	for _, key := range []string{
+		cachekey.GetMsgRateLimitUserKey("user1"),
+		cachekey.GetMsgRateLimitPlatformKey("user1", 1),
+		cachekey.GetEphemeralRateLimitKey("user1"),
+	} {
+		assert.True(t, strings.Contains(key, "{user1}"), key)
+	}
+	assert.NotContains(t, cachekey.GetMsgRateLimitUserKey("user2"), "{user1}")
+	assert.NotContains(t, cachekey.GetMsgRateLimitGroupKey("group1"), "{")
+}
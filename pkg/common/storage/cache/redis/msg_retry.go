@@ -0,0 +1,77 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/cachekey"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/redis/go-redis/v9"
+)
+
+func NewMsgRetryCache(rdb redis.UniversalClient) cache.MsgRetryCache {
+	return &msgRetryCache{rdb: rdb}
+}
+
+type msgRetryCache struct {
+	rdb redis.UniversalClient
+}
+
+func (c *msgRetryCache) AddMsgRetryTask(ctx context.Context, task *model.MsgRetryTask, dueTime time.Time) error {
+	data, err := json.Marshal(task)
+	if err != nil {
+		return errs.WrapMsg(err, "json.Marshal failed", "taskID", task.TaskID)
+	}
+	pipe := c.rdb.TxPipeline()
+	pipe.HSet(ctx, cachekey.GetMsgRetryTaskKey(), task.TaskID, data)
+	pipe.ZAdd(ctx, cachekey.GetMsgRetryQueueKey(), redis.Z{Score: float64(dueTime.UnixMilli()), Member: task.TaskID})
+	_, err = pipe.Exec(ctx)
+	return errs.Wrap(err)
+}
+
+func (c *msgRetryCache) ClaimDueMsgRetryTasks(ctx context.Context, count int, lease time.Duration) ([]*model.MsgRetryTask, error) {
+	now := time.Now()
+	keys := []string{cachekey.GetMsgRetryQueueKey(), cachekey.GetMsgRetryTaskKey()}
+	v, err := callLua(ctx, c.rdb, claimRetryTasksScript, keys, []any{now.UnixMilli(), count, now.Add(lease).UnixMilli()})
+	if err != nil {
+		return nil, err
+	}
+	values, _ := v.([]any)
+	tasks := make([]*model.MsgRetryTask, 0, len(values))
+	for _, value := range values {
+		data, _ := value.(string)
+		var task model.MsgRetryTask
+		if err := json.Unmarshal([]byte(data), &task); err != nil {
+			log.ZError(ctx, "invalid msg retry task", err, "task", data)
+			continue
+		}
+		tasks = append(tasks, &task)
+	}
+	return tasks, nil
+}
+
+func (c *msgRetryCache) DelMsgRetryTask(ctx context.Context, taskID string) error {
+	pipe := c.rdb.TxPipeline()
+	pipe.ZRem(ctx, cachekey.GetMsgRetryQueueKey(), taskID)
+	pipe.HDel(ctx, cachekey.GetMsgRetryTaskKey(), taskID)
+	_, err := pipe.Exec(ctx)
+	return errs.Wrap(err)
+}
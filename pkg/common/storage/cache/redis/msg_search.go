@@ -0,0 +1,86 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/cachekey"
+	"github.com/openimsdk/tools/errs"
+	"github.com/redis/go-redis/v9"
+)
+
+// leaseMsgSearchIndexScript sets the owner and its addr if the lease is free or already held by the owner, and extends it.
+var leaseMsgSearchIndexScript = redis.NewScript(`
+local owner = redis.call("HGET", KEYS[1], "owner")
+if owner and owner ~= ARGV[1] then
+    return 0
+end
+redis.call("HSET", KEYS[1], "owner", ARGV[1], "addr", ARGV[2])
+redis.call("PEXPIRE", KEYS[1], ARGV[3])
+return 1
+`)
+
+// releaseMsgSearchIndexScript deletes the lease only if it is held by the owner.
+var releaseMsgSearchIndexScript = redis.NewScript(`
+if redis.call("HGET", KEYS[1], "owner") == ARGV[1] then
+    return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+func NewMsgSearchCache(rdb redis.UniversalClient) cache.MsgSearchCache {
+	return &msgSearchCache{rdb: rdb}
+}
+
+type msgSearchCache struct {
+	rdb redis.UniversalClient
+}
+
+func (c *msgSearchCache) LeaseMsgSearchIndex(ctx context.Context, owner string, addr string, expire time.Duration) (bool, error) {
+	v, err := callLua(ctx, c.rdb, leaseMsgSearchIndexScript, []string{cachekey.GetMsgSearchIndexOwnerKey()}, []any{owner, addr, expire.Milliseconds()})
+	if err != nil {
+		return false, err
+	}
+	n, _ := v.(int64)
+	return n == 1, nil
+}
+
+func (c *msgSearchCache) ReleaseMsgSearchIndex(ctx context.Context, owner string) error {
+	_, err := callLua(ctx, c.rdb, releaseMsgSearchIndexScript, []string{cachekey.GetMsgSearchIndexOwnerKey()}, []any{owner})
+	return err
+}
+
+func (c *msgSearchCache) GetMsgSearchIndexAddr(ctx context.Context) (string, error) {
+	addr, err := c.rdb.HGet(ctx, cachekey.GetMsgSearchIndexOwnerKey(), "addr").Result()
+	if err == redis.Nil {
+		return "", nil
+	}
+	return addr, errs.Wrap(err)
+}
+
+func (c *msgSearchCache) GetMsgSearchIndexServed(ctx context.Context) (string, error) {
+	owner, err := c.rdb.Get(ctx, cachekey.GetMsgSearchIndexServedKey()).Result()
+	if err == redis.Nil {
+		return "", nil
+	}
+	return owner, errs.Wrap(err)
+}
+
+func (c *msgSearchCache) SetMsgSearchIndexServed(ctx context.Context, owner string) error {
+	return errs.Wrap(c.rdb.Set(ctx, cachekey.GetMsgSearchIndexServedKey(), owner, 0).Err())
+}
@@ -0,0 +1,43 @@
+package redis
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redismock/v9"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/cachekey"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSearchIndexLease(t *testing.T) {
+	rdb, mock := redismock.NewClientMock()
+	ctx := context.Background()
+	c := NewMsgSearchCache(rdb)
+	key := cachekey.GetMsgSearchIndexOwnerKey()
+
+	mock.ExpectEvalSha(leaseMsgSearchIndexScript.Hash(), []string{key}, []any{"index", "10.0.0.1:10130", int64(30000)}).SetVal(int64(1))
+	ok, err := c.LeaseMsgSearchIndex(ctx, "index", "10.0.0.1:10130", time.Second*30)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	// the other instances forward their calls to the addr of the holder
+	mock.ExpectHGet(key, "addr").SetVal("10.0.0.1:10130")
+	addr, err := c.GetMsgSearchIndexAddr(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "10.0.0.1:10130", addr)
+	mock.ExpectHGet(key, "addr").RedisNil()
+	addr, err = c.GetMsgSearchIndexAddr(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, addr)
+
+	mock.ExpectGet(cachekey.GetMsgSearchIndexServedKey()).RedisNil()
+	served, err := c.GetMsgSearchIndexServed(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, served)
+	mock.ExpectSet(cachekey.GetMsgSearchIndexServedKey(), "index", 0).SetVal("OK")
+	require.NoError(t, c.SetMsgSearchIndexServed(ctx, "index"))
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,84 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"time"
+
+	"github.com/dtm-labs/rockscache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/cachekey"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	pinnedMsgExpireTime = time.Second * 60 * 60 * 12
+)
+
+type PinnedMsgCacheRedis struct {
+	cache.BatchDeleter
+	expireTime  time.Duration
+	rcClient    *rockscache.Client
+	pinnedMsgDB database.PinnedMsg
+}
+
+func NewPinnedMsgCacheRedis(rdb redis.UniversalClient, pinnedMsgDB database.PinnedMsg, options *rockscache.Options) cache.PinnedMsgCache {
+	return &PinnedMsgCacheRedis{
+		BatchDeleter: NewBatchDeleterRedis(rdb, options, nil),
+		expireTime:   pinnedMsgExpireTime,
+		rcClient:     rockscache.NewClient(rdb, *options),
+		pinnedMsgDB:  pinnedMsgDB,
+	}
+}
+
+func (p *PinnedMsgCacheRedis) ClonePinnedMsgCache() cache.PinnedMsgCache {
+	return &PinnedMsgCacheRedis{
+		BatchDeleter: p.BatchDeleter.Clone(),
+		expireTime:   p.expireTime,
+		rcClient:     p.rcClient,
+		pinnedMsgDB:  p.pinnedMsgDB,
+	}
+}
+
+func (p *PinnedMsgCacheRedis) getPinnedMsgsKey(conversationID string) string {
+	return cachekey.GetPinnedMsgsKey(conversationID)
+}
+
+func (p *PinnedMsgCacheRedis) GetPinnedMsgs(ctx context.Context, conversationIDs []string) ([]*model.PinnedMsg, error) {
+	pins, err := batchGetCache(ctx, p.rcClient, p.expireTime, conversationIDs, p.getPinnedMsgsKey, func(ctx context.Context, conversationID string) ([]*model.PinnedMsg, error) {
+		return p.pinnedMsgDB.Find(ctx, []string{conversationID})
+	})
+	if err != nil {
+		return nil, err
+	}
+	var res []*model.PinnedMsg
+	for _, conversationPins := range pins {
+		res = append(res, conversationPins...)
+	}
+	return res, nil
+}
+
+func (p *PinnedMsgCacheRedis) DelPinnedMsgs(conversationIDs ...string) cache.PinnedMsgCache {
+	keys := make([]string, 0, len(conversationIDs))
+	for _, conversationID := range conversationIDs {
+		keys = append(keys, p.getPinnedMsgsKey(conversationID))
+	}
+	cache := p.ClonePinnedMsgCache()
+	cache.AddKeys(keys...)
+	return cache
+}
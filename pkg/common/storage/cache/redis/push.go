@@ -0,0 +1,166 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/cachekey"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/redis/go-redis/v9"
+)
+
+// claimRetryTasksScript postpones the due taskIDs of the queue KEYS[1] to ARGV[3] and returns their tasks
+// in the hash KEYS[2], the taskIDs without tasks are removed.
+var claimRetryTasksScript = redis.NewScript(`
+local ids = redis.call('ZRANGEBYSCORE', KEYS[1], '-inf', ARGV[1], 'LIMIT', 0, ARGV[2])
+local tasks = {}
+for i, id in ipairs(ids) do
+    local task = redis.call('HGET', KEYS[2], id)
+    if task then
+        redis.call('ZADD', KEYS[1], ARGV[3], id)
+        table.insert(tasks, task)
+    else
+        redis.call('ZREM', KEYS[1], id)
+    end
+end
+return tasks
+`)
+
+// incrPushSummariesScript increases the counts of the userIDs ARGV[2:] in the hash KEYS[2], the summaries
+// created by the increase are due at ARGV[1] in the queue KEYS[1].
+var incrPushSummariesScript = redis.NewScript(`
+for i = 2, #ARGV do
+    if redis.call('HINCRBY', KEYS[2], ARGV[i], 1) == 1 then
+        redis.call('ZADD', KEYS[1], ARGV[1], ARGV[i])
+    end
+end
+return 0
+`)
+
+// takePushSummariesScript removes at most ARGV[2] userIDs due at ARGV[1] from the queue KEYS[1] with their
+// counts in the hash KEYS[2], it returns the userIDs followed by their counts.
+var takePushSummariesScript = redis.NewScript(`
+local ids = redis.call('ZRANGEBYSCORE', KEYS[1], '-inf', ARGV[1], 'LIMIT', 0, ARGV[2])
+local result = {}
+for i, id in ipairs(ids) do
+    local count = redis.call('HGET', KEYS[2], id)
+    redis.call('ZREM', KEYS[1], id)
+    redis.call('HDEL', KEYS[2], id)
+    if count then
+        table.insert(result, id)
+        table.insert(result, count)
+    end
+end
+return result
+`)
+
+func NewPushRetryCache(rdb redis.UniversalClient) cache.PushRetryCache {
+	return &pushRetryCache{rdb: rdb}
+}
+
+type pushRetryCache struct {
+	rdb redis.UniversalClient
+}
+
+func (c *pushRetryCache) AddPushRetryTask(ctx context.Context, task *model.OfflinePushTask, dueTime time.Time) error {
+	data, err := json.Marshal(task)
+	if err != nil {
+		return errs.WrapMsg(err, "json.Marshal failed", "taskID", task.TaskID)
+	}
+	pipe := c.rdb.TxPipeline()
+	pipe.HSet(ctx, cachekey.GetOfflinePushRetryTaskKey(), task.TaskID, data)
+	pipe.ZAdd(ctx, cachekey.GetOfflinePushRetryQueueKey(), redis.Z{Score: float64(dueTime.UnixMilli()), Member: task.TaskID})
+	_, err = pipe.Exec(ctx)
+	return errs.Wrap(err)
+}
+
+func (c *pushRetryCache) ClaimDuePushRetryTasks(ctx context.Context, count int, lease time.Duration) ([]*model.OfflinePushTask, error) {
+	now := time.Now()
+	keys := []string{cachekey.GetOfflinePushRetryQueueKey(), cachekey.GetOfflinePushRetryTaskKey()}
+	v, err := callLua(ctx, c.rdb, claimRetryTasksScript, keys, []any{now.UnixMilli(), count, now.Add(lease).UnixMilli()})
+	if err != nil {
+		return nil, err
+	}
+	values, _ := v.([]any)
+	tasks := make([]*model.OfflinePushTask, 0, len(values))
+	for _, value := range values {
+		data, _ := value.(string)
+		var task model.OfflinePushTask
+		if err := json.Unmarshal([]byte(data), &task); err != nil {
+			log.ZError(ctx, "invalid offline push retry task", err, "task", data)
+			continue
+		}
+		tasks = append(tasks, &task)
+	}
+	return tasks, nil
+}
+
+func (c *pushRetryCache) DelPushRetryTask(ctx context.Context, taskID string) error {
+	pipe := c.rdb.TxPipeline()
+	pipe.ZRem(ctx, cachekey.GetOfflinePushRetryQueueKey(), taskID)
+	pipe.HDel(ctx, cachekey.GetOfflinePushRetryTaskKey(), taskID)
+	_, err := pipe.Exec(ctx)
+	return errs.Wrap(err)
+}
+
+func NewPushSummaryCache(rdb redis.UniversalClient) cache.PushSummaryCache {
+	return &pushSummaryCache{rdb: rdb}
+}
+
+type pushSummaryCache struct {
+	rdb redis.UniversalClient
+}
+
+func (c *pushSummaryCache) IncrPushSummaries(ctx context.Context, userIDs []string, dueTime time.Time) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	args := make([]any, 0, len(userIDs)+1)
+	args = append(args, dueTime.UnixMilli())
+	for _, userID := range userIDs {
+		args = append(args, userID)
+	}
+	keys := []string{cachekey.GetPushSummaryDueKey(), cachekey.GetPushSummaryCountKey()}
+	_, err := callLua(ctx, c.rdb, incrPushSummariesScript, keys, args)
+	return err
+}
+
+func (c *pushSummaryCache) TakeDuePushSummaries(ctx context.Context, count int) (map[string]int64, error) {
+	keys := []string{cachekey.GetPushSummaryDueKey(), cachekey.GetPushSummaryCountKey()}
+	v, err := callLua(ctx, c.rdb, takePushSummariesScript, keys, []any{time.Now().UnixMilli(), count})
+	if err != nil {
+		return nil, err
+	}
+	values, _ := v.([]any)
+	summaries := make(map[string]int64, len(values)/2)
+	for i := 0; i+1 < len(values); i += 2 {
+		userID, _ := values[i].(string)
+		data, _ := values[i+1].(string)
+		count, err := strconv.ParseInt(data, 10, 64)
+		if err != nil {
+			log.ZError(ctx, "invalid push summary count", err, "userID", userID, "count", data)
+			continue
+		}
+		summaries[userID] = count
+	}
+	return summaries, nil
+}
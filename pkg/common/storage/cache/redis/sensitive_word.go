@@ -0,0 +1,45 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/cachekey"
+	"github.com/openimsdk/tools/errs"
+	"github.com/redis/go-redis/v9"
+)
+
+func NewSensitiveWordCache(rdb redis.UniversalClient) cache.SensitiveWordCache {
+	return &sensitiveWordCache{rdb: rdb}
+}
+
+type sensitiveWordCache struct {
+	rdb redis.UniversalClient
+}
+
+func (s *sensitiveWordCache) GetVersion(ctx context.Context) (int64, error) {
+	version, err := s.rdb.Get(ctx, cachekey.GetSensitiveWordVersionKey()).Int64()
+	if err == redis.Nil {
+		return 0, nil
+	}
+	return version, errs.Wrap(err)
+}
+
+func (s *sensitiveWordCache) IncrVersion(ctx context.Context) (int64, error) {
+	version, err := s.rdb.Incr(ctx, cachekey.GetSensitiveWordVersionKey()).Result()
+	return version, errs.Wrap(err)
+}
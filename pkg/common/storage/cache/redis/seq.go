@@ -16,6 +16,8 @@ package redis
 
 import (
 	"context"
+	"time"
+
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/cachekey"
 	"github.com/openimsdk/tools/errs"
@@ -171,3 +173,185 @@ func (c *seqCache) GetHasReadSeq(ctx context.Context, userID string, conversatio
 	}
 	return val, nil
 }
+
+func (c *seqCache) GetUsersHasReadSeqs(ctx context.Context, conversationID string, userIDs []string) (map[string]int64, error) {
+	if len(userIDs) == 0 {
+		return map[string]int64{}, nil
+	}
+	// a group may have many members, so the seqs are got in one round trip
+	pipe := c.rdb.Pipeline()
+	cmds := make([]*redis.StringCmd, 0, len(userIDs))
+	for _, userID := range userIDs {
+		cmds = append(cmds, pipe.Get(ctx, c.getHasReadSeqKey(conversationID, userID)))
+	}
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return nil, errs.Wrap(err)
+	}
+	seqs := make(map[string]int64, len(userIDs))
+	for i, cmd := range cmds {
+		seq, err := cmd.Int64()
+		if err != nil {
+			if err == redis.Nil {
+				continue
+			}
+			return nil, errs.Wrap(err)
+		}
+		seqs[userIDs[i]] = seq
+	}
+	return seqs, nil
+}
+
+// allocThreadSeqScript returns the thread seq already given to the reply, or increases the max seq of the thread for it.
+// It returns 0 if the max seq of the thread is missing, so the seqs do not restart from 1 after it is evicted.
+var allocThreadSeqScript = redis.NewScript(`
+local seq = redis.call("HGET", KEYS[2], ARGV[2])
+if seq then
+    return tonumber(seq)
+end
+if redis.call("HEXISTS", KEYS[1], ARGV[1]) == 0 then
+    return 0
+end
+seq = redis.call("HINCRBY", KEYS[1], ARGV[1], 1)
+redis.call("HSET", KEYS[2], ARGV[2], seq)
+redis.call("PEXPIRE", KEYS[2], ARGV[3])
+return seq
+`)
+
+func (c *seqCache) AllocThreadSeq(ctx context.Context, conversationID string, rootServerMsgID string, serverMsgID string, expire time.Duration) (int64, bool, error) {
+	keys := []string{cachekey.GetThreadMaxSeqKey(conversationID), cachekey.GetThreadReplySeqKey(conversationID, rootServerMsgID)}
+	v, err := callLua(ctx, c.rdb, allocThreadSeqScript, keys, []any{rootServerMsgID, serverMsgID, expire.Milliseconds()})
+	if err != nil {
+		return 0, false, err
+	}
+	seq, ok := v.(int64)
+	if !ok {
+		return 0, false, errs.ErrInternalServer.WrapMsg("invalid alloc thread seq result", "result", v)
+	}
+	return seq, seq > 0, nil
+}
+
+func (c *seqCache) InitThreadMaxSeq(ctx context.Context, conversationID string, rootServerMsgID string, maxSeq int64) error {
+	return errs.Wrap(c.rdb.HSetNX(ctx, cachekey.GetThreadMaxSeqKey(conversationID), rootServerMsgID, maxSeq).Err())
+}
+
+func (c *seqCache) GetThreadMaxSeqs(ctx context.Context, conversationID string, rootServerMsgIDs []string) (map[string]int64, error) {
+	m := make(map[string]int64, len(rootServerMsgIDs))
+	if len(rootServerMsgIDs) == 0 {
+		return m, nil
+	}
+	values, err := c.rdb.HMGet(ctx, cachekey.GetThreadMaxSeqKey(conversationID), rootServerMsgIDs...).Result()
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	for i, value := range values {
+		if value == nil {
+			continue
+		}
+		if val := stringutil.StringToInt64(value.(string)); val != 0 {
+			m[rootServerMsgIDs[i]] = val
+		}
+	}
+	return m, nil
+}
+
+const (
+	// threadHasReadSeqCachedKey is the field marking the has read seqs of the user in the conversation are cached,
+	// so the ones without followed threads are cached too.
+	threadHasReadSeqCachedKey = "."
+	threadHasReadSeqExpire    = time.Hour * 24 * 7
+)
+
+// setThreadHasReadSeqScript increases the has read seq of the thread if the has read seqs are cached.
+var setThreadHasReadSeqScript = redis.NewScript(`
+if redis.call("EXISTS", KEYS[1]) == 0 then
+    return 0
+end
+local seq = redis.call("HGET", KEYS[1], ARGV[1])
+if (not seq) or tonumber(seq) < tonumber(ARGV[2]) then
+    redis.call("HSET", KEYS[1], ARGV[1], ARGV[2])
+end
+return 1
+`)
+
+func (c *seqCache) SetThreadHasReadSeq(ctx context.Context, userID string, conversationID string, rootServerMsgID string, hasReadSeq int64) error {
+	_, err := callLua(ctx, c.rdb, setThreadHasReadSeqScript, []string{cachekey.GetThreadHasReadSeqKey(conversationID, userID)}, []any{rootServerMsgID, hasReadSeq})
+	return err
+}
+
+func (c *seqCache) GetUserThreadHasReadSeqs(ctx context.Context, userID string, conversationIDs []string) (map[string]map[string]int64, error) {
+	m := make(map[string]map[string]int64)
+	if len(conversationIDs) == 0 {
+		return m, nil
+	}
+	pipe := c.rdb.Pipeline()
+	cmds := make([]*redis.MapStringStringCmd, 0, len(conversationIDs))
+	for _, conversationID := range conversationIDs {
+		cmds = append(cmds, pipe.HGetAll(ctx, cachekey.GetThreadHasReadSeqKey(conversationID, userID)))
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	for i, cmd := range cmds {
+		if len(cmd.Val()) == 0 {
+			continue
+		}
+		seqs := make(map[string]int64, len(cmd.Val()))
+		for rootServerMsgID, value := range cmd.Val() {
+			if rootServerMsgID == threadHasReadSeqCachedKey {
+				continue
+			}
+			seqs[rootServerMsgID] = stringutil.StringToInt64(value)
+		}
+		m[conversationIDs[i]] = seqs
+	}
+	return m, nil
+}
+
+func (c *seqCache) SetUserThreadHasReadSeqs(ctx context.Context, userID string, hasReadSeqs map[string]map[string]int64) error {
+	if len(hasReadSeqs) == 0 {
+		return nil
+	}
+	pipe := c.rdb.Pipeline()
+	for conversationID, seqs := range hasReadSeqs {
+		key := cachekey.GetThreadHasReadSeqKey(conversationID, userID)
+		values := make([]any, 0, 2+len(seqs)*2)
+		values = append(values, threadHasReadSeqCachedKey, 1)
+		for rootServerMsgID, seq := range seqs {
+			values = append(values, rootServerMsgID, seq)
+		}
+		pipe.HSet(ctx, key, values...)
+		pipe.Expire(ctx, key, threadHasReadSeqExpire)
+	}
+	_, err := pipe.Exec(ctx)
+	return errs.Wrap(err)
+}
+
+func (c *seqCache) GetConversationsThreadMaxSeqs(ctx context.Context, rootServerMsgIDs map[string][]string) (map[string]map[string]int64, error) {
+	m := make(map[string]map[string]int64, len(rootServerMsgIDs))
+	pipe := c.rdb.Pipeline()
+	cmds := make(map[string]*redis.SliceCmd, len(rootServerMsgIDs))
+	for conversationID, ids := range rootServerMsgIDs {
+		if len(ids) > 0 {
+			cmds[conversationID] = pipe.HMGet(ctx, cachekey.GetThreadMaxSeqKey(conversationID), ids...)
+		}
+	}
+	if len(cmds) == 0 {
+		return m, nil
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	for conversationID, cmd := range cmds {
+		seqs := make(map[string]int64)
+		for i, value := range cmd.Val() {
+			if value == nil {
+				continue
+			}
+			if val := stringutil.StringToInt64(value.(string)); val != 0 {
+				seqs[rootServerMsgIDs[conversationID][i]] = val
+			}
+		}
+		m[conversationID] = seqs
+	}
+	return m, nil
+}
@@ -0,0 +1,78 @@
+package redis
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redismock/v9"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/cachekey"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAllocThreadSeq(t *testing.T) {
+	rdb, mock := redismock.NewClientMock()
+	ctx := context.Background()
+	c := NewSeqCache(rdb)
+
+	keys := []string{cachekey.GetThreadMaxSeqKey("sg_group"), cachekey.GetThreadReplySeqKey("sg_group", "root")}
+	// both keys must be in a slot for the script
+	for _, key := range keys {
+		require.True(t, strings.Contains(key, "{sg_group}"), key)
+	}
+	// the replies of each thread are kept by a key expiring on its own
+	assert.NotEqual(t, keys[1], cachekey.GetThreadReplySeqKey("sg_group", "root2"))
+	args := []any{"root", "reply", int64(time.Hour / time.Millisecond)}
+
+	mock.ExpectEvalSha(allocThreadSeqScript.Hash(), keys, args).SetVal(int64(3))
+	seq, ok, err := c.AllocThreadSeq(ctx, "sg_group", "root", "reply", time.Hour)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, int64(3), seq)
+
+	// the max seq of the thread is missing, it is not increased from 0
+	mock.ExpectEvalSha(allocThreadSeqScript.Hash(), keys, args).SetVal(int64(0))
+	_, ok, err = c.AllocThreadSeq(ctx, "sg_group", "root", "reply", time.Hour)
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	mock.ExpectHSetNX(keys[0], "root", int64(7)).SetVal(true)
+	require.NoError(t, c.InitThreadMaxSeq(ctx, "sg_group", "root", 7))
+
+	mock.ExpectEvalSha(allocThreadSeqScript.Hash(), keys, args).SetVal("3")
+	_, _, err = c.AllocThreadSeq(ctx, "sg_group", "root", "reply", time.Hour)
+	assert.Error(t, err)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestConversationsThreadSeqs(t *testing.T) {
+	rdb, mock := redismock.NewClientMock()
+	ctx := context.Background()
+	c := NewSeqCache(rdb)
+
+	// the has read seqs of all conversations are got in one pipeline, sg_g3 is cached without followed threads
+	mock.ExpectHGetAll(cachekey.GetThreadHasReadSeqKey("sg_g1", "u1")).SetVal(map[string]string{".": "1", "root1": "2", "root2": "5"})
+	mock.ExpectHGetAll(cachekey.GetThreadHasReadSeqKey("sg_g2", "u1")).SetVal(map[string]string{})
+	mock.ExpectHGetAll(cachekey.GetThreadHasReadSeqKey("sg_g3", "u1")).SetVal(map[string]string{".": "1"})
+	hasReadSeqs, err := c.GetUserThreadHasReadSeqs(ctx, "u1", []string{"sg_g1", "sg_g2", "sg_g3"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]map[string]int64{"sg_g1": {"root1": 2, "root2": 5}, "sg_g3": {}}, hasReadSeqs)
+
+	key := cachekey.GetThreadHasReadSeqKey("sg_g2", "u1")
+	mock.ExpectHSet(key, ".", 1).SetVal(1)
+	mock.ExpectExpire(key, threadHasReadSeqExpire).SetVal(true)
+	require.NoError(t, c.SetUserThreadHasReadSeqs(ctx, "u1", map[string]map[string]int64{"sg_g2": {}}))
+
+	mock.ExpectEvalSha(setThreadHasReadSeqScript.Hash(), []string{key}, []any{"root1", int64(3)}).SetVal(int64(0))
+	require.NoError(t, c.SetThreadHasReadSeq(ctx, "u1", "sg_g2", "root1", 3))
+
+	mock.ExpectHMGet(cachekey.GetThreadMaxSeqKey("sg_g1"), "root1", "root2").SetVal([]any{"4", nil})
+	maxSeqs, err := c.GetConversationsThreadMaxSeqs(ctx, map[string][]string{"sg_g1": {"root1", "root2"}, "sg_g2": nil})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]map[string]int64{"sg_g1": {"root1": 4}}, maxSeqs)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
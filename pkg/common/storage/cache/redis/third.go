@@ -16,13 +16,48 @@ package redis
 
 import (
 	"context"
+	"encoding/json"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/cachekey"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
 	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/stringutil"
 	"github.com/redis/go-redis/v9"
+	"strings"
 	"time"
 )
 
+// setDeviceTokenScript sets the field ARGV[1] of the hash KEYS[1] to ARGV[2], the expiration of the hash
+// is only extended to ARGV[3] milliseconds so that it outlives each of its tokens.
+var setDeviceTokenScript = redis.NewScript(`
+redis.call('HSET', KEYS[1], ARGV[1], ARGV[2])
+local ttl = redis.call('PTTL', KEYS[1])
+if ttl < tonumber(ARGV[3]) then
+    redis.call('PEXPIRE', KEYS[1], ARGV[3])
+end
+return 1
+`)
+
+// delPlatformDeviceTokensScript deletes the fields of the hash KEYS[1] starting with ARGV[1].
+var delPlatformDeviceTokensScript = redis.NewScript(`
+local fields = redis.call('HKEYS', KEYS[1])
+local n = 0
+for _, field in ipairs(fields) do
+    if string.sub(field, 1, string.len(ARGV[1])) == ARGV[1] then
+        n = n + redis.call('HDEL', KEYS[1], field)
+    end
+end
+return n
+`)
+
+// deviceToken is the value of a field of GetDeviceTokenKey, each token expires on its own.
+type deviceToken struct {
+	Token string `json:"token"`
+	// ExpireAt is in milliseconds.
+	ExpireAt int64 `json:"expireAt"`
+}
+
 func NewThirdCache(rdb redis.UniversalClient) cache.ThirdCache {
 	return &thirdCache{rdb: rdb}
 }
@@ -63,6 +98,56 @@ func (c *thirdCache) DelFcmToken(ctx context.Context, account string, platformID
 	return errs.Wrap(c.rdb.Del(ctx, c.getFcmAccountTokenKey(account, platformID)).Err())
 }
 
+func (c *thirdCache) SetDeviceToken(ctx context.Context, userID string, platformID int, vendor string, token string, expireTime int64) error {
+	expire := time.Duration(expireTime) * time.Second
+	data, err := json.Marshal(&deviceToken{Token: token, ExpireAt: time.Now().Add(expire).UnixMilli()})
+	if err != nil {
+		return errs.WrapMsg(err, "json.Marshal failed", "userID", userID)
+	}
+	keys := []string{cachekey.GetDeviceTokenKey(userID)}
+	_, err = callLua(ctx, c.rdb, setDeviceTokenScript, keys, []any{cachekey.GetDeviceTokenField(platformID, vendor), data, expire.Milliseconds()})
+	return err
+}
+
+func (c *thirdCache) GetDeviceTokens(ctx context.Context, userID string) ([]*model.DeviceToken, error) {
+	key := cachekey.GetDeviceTokenKey(userID)
+	fields, err := c.rdb.HGetAll(ctx, key).Result()
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	now := time.Now().UnixMilli()
+	tokens := make([]*model.DeviceToken, 0, len(fields))
+	var expired []string
+	for field, value := range fields {
+		platformID, vendor, ok := strings.Cut(field, ":")
+		if !ok {
+			continue
+		}
+		var token deviceToken
+		if err := json.Unmarshal([]byte(value), &token); err != nil || token.ExpireAt <= now {
+			expired = append(expired, field)
+			continue
+		}
+		tokens = append(tokens, &model.DeviceToken{PlatformID: stringutil.StringToInt(platformID), Vendor: vendor, Token: token.Token})
+	}
+	if len(expired) > 0 {
+		if err := c.rdb.HDel(ctx, key, expired...).Err(); err != nil {
+			log.ZWarn(ctx, "delete expired device tokens failed", err, "userID", userID, "fields", expired)
+		}
+	}
+	return tokens, nil
+}
+
+func (c *thirdCache) DelDeviceToken(ctx context.Context, userID string, platformID int, vendor string) error {
+	return errs.Wrap(c.rdb.HDel(ctx, cachekey.GetDeviceTokenKey(userID), cachekey.GetDeviceTokenField(platformID, vendor)).Err())
+}
+
+func (c *thirdCache) DelPlatformDeviceTokens(ctx context.Context, userID string, platformID int) error {
+	keys := []string{cachekey.GetDeviceTokenKey(userID)}
+	_, err := callLua(ctx, c.rdb, delPlatformDeviceTokensScript, keys, []any{cachekey.GetDeviceTokenField(platformID, "")})
+	return err
+}
+
 func (c *thirdCache) IncrUserBadgeUnreadCountSum(ctx context.Context, userID string) (int, error) {
 	seq, err := c.rdb.Incr(ctx, c.getUserBadgeUnreadCountSumKey(userID)).Result()
 
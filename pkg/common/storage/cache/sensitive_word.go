@@ -0,0 +1,24 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import "context"
+
+// SensitiveWordCache holds the version of the sensitive-word dictionary, it changes whenever the dictionary is reloaded.
+type SensitiveWordCache interface {
+	// GetVersion returns 0 if the dictionary has never been reloaded.
+	GetVersion(ctx context.Context) (int64, error)
+	IncrVersion(ctx context.Context) (int64, error)
+}
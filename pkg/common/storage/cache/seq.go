@@ -2,6 +2,7 @@ package cache
 
 import (
 	"context"
+	"time"
 )
 
 type SeqCache interface {
@@ -27,4 +28,25 @@ type SeqCache interface {
 	UserSetHasReadSeqs(ctx context.Context, userID string, hasReadSeqs map[string]int64) error
 	GetHasReadSeqs(ctx context.Context, userID string, conversationIDs []string) (map[string]int64, error)
 	GetHasReadSeq(ctx context.Context, userID string, conversationID string) (int64, error)
+	// k: user, v: seq, the users who have never read the conversation are not in the map
+	GetUsersHasReadSeqs(ctx context.Context, conversationID string, userIDs []string) (map[string]int64, error)
+	// thread seq, a thread is identified by the serverMsgID of its root msg in the conversation
+	// AllocThreadSeq gives the reply the next seq of the thread, the reply allocated again within expire gets the same seq.
+	// ok is false if the max seq of the thread is missing, e.g. evicted, it must be set by InitThreadMaxSeq first.
+	AllocThreadSeq(ctx context.Context, conversationID string, rootServerMsgID string, serverMsgID string, expire time.Duration) (seq int64, ok bool, err error)
+	// InitThreadMaxSeq sets the max seq of the thread unless it is set.
+	InitThreadMaxSeq(ctx context.Context, conversationID string, rootServerMsgID string, maxSeq int64) error
+	GetThreadMaxSeqs(ctx context.Context, conversationID string, rootServerMsgIDs []string) (map[string]int64, error)
+	// SetThreadHasReadSeq increases the cached has read seq of the thread, nothing is done if the has read seqs of
+	// the user in the conversation are not cached.
+	SetThreadHasReadSeq(ctx context.Context, userID string, conversationID string, rootServerMsgID string, hasReadSeq int64) error
+	// GetUserThreadHasReadSeqs gets the has read seqs of the threads followed by the user in the conversations in one round trip,
+	// k: conversationID, v: k: rootServerMsgID, v: seq. The conversations whose has read seqs are not cached are not in the map.
+	GetUserThreadHasReadSeqs(ctx context.Context, userID string, conversationIDs []string) (map[string]map[string]int64, error)
+	// SetUserThreadHasReadSeqs caches the has read seqs of the threads followed by the user, keyed as GetUserThreadHasReadSeqs,
+	// a conversation without followed threads is cached by an empty map.
+	SetUserThreadHasReadSeqs(ctx context.Context, userID string, hasReadSeqs map[string]map[string]int64) error
+	// GetConversationsThreadMaxSeqs is GetThreadMaxSeqs of the conversations in one round trip,
+	// rootServerMsgIDs and the result are keyed by conversationID
+	GetConversationsThreadMaxSeqs(ctx context.Context, rootServerMsgIDs map[string][]string) (map[string]map[string]int64, error)
 }
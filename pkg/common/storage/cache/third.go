@@ -2,12 +2,21 @@ package cache
 
 import (
 	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
 )
 
 type ThirdCache interface {
 	SetFcmToken(ctx context.Context, account string, platformID int, fcmToken string, expireTime int64) (err error)
 	GetFcmToken(ctx context.Context, account string, platformID int) (string, error)
 	DelFcmToken(ctx context.Context, account string, platformID int) error
+	// SetDeviceToken registers the token of the device for the vendor, the token expires expireTime seconds
+	// after its last update.
+	SetDeviceToken(ctx context.Context, userID string, platformID int, vendor string, token string, expireTime int64) error
+	GetDeviceTokens(ctx context.Context, userID string) ([]*model.DeviceToken, error)
+	DelDeviceToken(ctx context.Context, userID string, platformID int, vendor string) error
+	// DelPlatformDeviceTokens deletes the tokens of the platform for all the vendors.
+	DelPlatformDeviceTokens(ctx context.Context, userID string, platformID int) error
 	IncrUserBadgeUnreadCountSum(ctx context.Context, userID string) (int, error)
 	SetUserBadgeUnreadCountSum(ctx context.Context, userID string, value int) error
 	GetUserBadgeUnreadCountSum(ctx context.Context, userID string) (int, error)
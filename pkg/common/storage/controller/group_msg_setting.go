@@ -0,0 +1,53 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"errors"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type GroupMsgSettingDatabase interface {
+	SetGroupReadReceipt(ctx context.Context, groupID string, enable bool) error
+	// IsGroupReadReceiptEnabled reports whether the group enables read receipts, they are disabled by default.
+	IsGroupReadReceiptEnabled(ctx context.Context, groupID string) (bool, error)
+}
+
+func NewGroupMsgSettingDatabase(setting database.GroupMsgSetting) GroupMsgSettingDatabase {
+	return &groupMsgSettingDatabase{setting: setting}
+}
+
+type groupMsgSettingDatabase struct {
+	setting database.GroupMsgSetting
+}
+
+func (g *groupMsgSettingDatabase) SetGroupReadReceipt(ctx context.Context, groupID string, enable bool) error {
+	return g.setting.SetReadReceipt(ctx, groupID, enable)
+}
+
+func (g *groupMsgSettingDatabase) IsGroupReadReceiptEnabled(ctx context.Context, groupID string) (bool, error) {
+	setting, err := g.setting.Take(ctx, groupID)
+	if err != nil {
+		if errors.Is(errs.Unwrap(err), mongo.ErrNoDocuments) {
+			return false, nil
+		}
+		return false, err
+	}
+	return setting.ReadReceipt, nil
+}
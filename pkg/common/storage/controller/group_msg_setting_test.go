@@ -0,0 +1,56 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type memGroupMsgSettings map[string]*model.GroupMsgSetting
+
+func (m memGroupMsgSettings) SetReadReceipt(_ context.Context, groupID string, enable bool) error {
+	m[groupID] = &model.GroupMsgSetting{GroupID: groupID, ReadReceipt: enable}
+	return nil
+}
+
+func (m memGroupMsgSettings) Take(_ context.Context, groupID string) (*model.GroupMsgSetting, error) {
+	setting, ok := m[groupID]
+	if !ok {
+		return nil, errs.Wrap(mongo.ErrNoDocuments)
+	}
+	return setting, nil
+}
+
+func TestGroupReadReceipt(t *testing.T) {
+	ctx := context.Background()
+	db := NewGroupMsgSettingDatabase(memGroupMsgSettings{})
+	if enable, err := db.IsGroupReadReceiptEnabled(ctx, "group1"); err != nil || enable {
+		t.Fatalf("read receipts of a group without settings: %v, %v", enable, err)
+	}
+	if err := db.SetGroupReadReceipt(ctx, "group1", true); err != nil {
+		t.Fatal(err)
+	}
+	if enable, err := db.IsGroupReadReceiptEnabled(ctx, "group1"); err != nil || !enable {
+		t.Fatalf("read receipts enabled: %v, %v", enable, err)
+	}
+	if enable, _ := db.IsGroupReadReceiptEnabled(ctx, "group2"); enable {
+		t.Fatal("read receipts of another group are enabled")
+	}
+}
@@ -27,6 +27,8 @@ import (
 	"github.com/openimsdk/open-im-server/v3/pkg/common/convert"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/prommetrics"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/cachekey"
+	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
 	"github.com/openimsdk/protocol/constant"
 	pbmsg "github.com/openimsdk/protocol/msg"
 	"github.com/openimsdk/protocol/sdkws"
@@ -44,12 +46,34 @@ const (
 	updateKeyRevoke
 )
 
+const (
+	msgReactionLockRetry   = 20
+	msgReactionLockWait    = time.Millisecond * 50
+	msgReactionCacheExpire = time.Hour * 24
+	// msgReactionLockKey is the type key of the lock guarding the reactions of one message.
+	msgReactionLockKey = "reaction"
+	// msgReactionCachedKey is set in the reaction cache of every cached message, so a message without reactions
+	// is cached too. It is not a valid reaction key.
+	msgReactionCachedKey = "."
+)
+
 // CommonMsgDatabase defines the interface for message database operations.
 type CommonMsgDatabase interface {
 	// BatchInsertChat2DB inserts a batch of messages into the database for a specific conversation.
 	BatchInsertChat2DB(ctx context.Context, conversationID string, msgs []*sdkws.MsgData, currentMaxSeq int64) error
 	// RevokeMsg revokes a message in a conversation.
 	RevokeMsg(ctx context.Context, conversationID string, seq int64, revoke *model.RevokeModel) error
+	// ModifyMsg replaces the content of a message, the replaced content is kept in the modify history.
+	ModifyMsg(ctx context.Context, conversationID string, seq int64, content string, history *model.ModifyModel) error
+	// GetMsgModifyHistory gets the latest edits of a message, the oldest first.
+	GetMsgModifyHistory(ctx context.Context, conversationID string, seq int64) ([]*model.ModifyModel, error)
+	// SetMsgReaction adds userID to or removes userID from a reaction of the message and returns all reactions after the update,
+	// a new reaction can not be added if the message has maxReactionKeys reactions.
+	SetMsgReaction(ctx context.Context, conversationID string, msg *sdkws.MsgData, reactionKey string, userID string, isAdd bool,
+		maxReactionKeys int) (map[string][]string, error)
+	// GetMsgsReactions gets the reactions of the messages, from the reaction cache first, k: seq.
+	// The messages missing in the cache are read from mongo by one query per msg document.
+	GetMsgsReactions(ctx context.Context, conversationID string, msgs []*sdkws.MsgData) (map[int64]map[string][]string, error)
 	// MarkSingleChatMsgsAsRead marks messages as read for a single chat by sequence numbers.
 	MarkSingleChatMsgsAsRead(ctx context.Context, userID string, conversationID string, seqs []int64) error
 	// DeleteMessagesFromCache deletes message caches from Redis by sequence numbers.
@@ -69,6 +93,24 @@ type CommonMsgDatabase interface {
 	DeleteUserMsgsBySeqs(ctx context.Context, userID string, conversationID string, seqs []int64) error
 	// DeleteMsgsPhysicalBySeqs physically deletes messages by emptying them based on sequence numbers.
 	DeleteMsgsPhysicalBySeqs(ctx context.Context, conversationID string, seqs []int64) error
+	// AddBurnMsgs starts the burn timer of the messages, they are physically deleted after burnTime.
+	AddBurnMsgs(ctx context.Context, conversationID string, seqs []int64, burnTime time.Time) error
+	// ClaimDueBurnMsgs claims at most count messages whose burn time has passed, they stay queued and are claimed
+	// again after lease unless they are finished or delayed.
+	ClaimDueBurnMsgs(ctx context.Context, count int64, lease time.Duration) (map[string][]int64, error)
+	// DelayBurnMsgs retries burning the claimed messages at burnTime.
+	DelayBurnMsgs(ctx context.Context, conversationID string, seqs []int64, burnTime time.Time) error
+	// IncrBurnMsgAttempts counts a failed attempt to burn each message and returns the failed attempts by seq.
+	IncrBurnMsgAttempts(ctx context.Context, conversationID string, seqs []int64) (map[int64]int64, error)
+	// FinishBurnMsgs removes the messages from the burn queue once they are burned or given up.
+	FinishBurnMsgs(ctx context.Context, conversationID string, seqs []int64) error
+	// GetPersistedMsgs gets the messages of seqs stored in mongo, the seqs of the messages only in the cache
+	// are returned as unpersistedSeqs, the seqs in neither have been deleted.
+	GetPersistedMsgs(ctx context.Context, conversationID string, seqs []int64) (msgs []*model.MsgDataModel, unpersistedSeqs []int64, err error)
+	// TakeSendMsgTokens takes a token from each enabled limit of the sender and the platform of the sender at once, only if
+	// both of them have one, and then from the limit of the group of msg. It returns the name of the first exhausted limit,
+	// or "" if the msg can be sent. A msg rejected by the group limit still costs the tokens of the sender.
+	TakeSendMsgTokens(ctx context.Context, msg *sdkws.MsgData, limit *config.MsgRateLimit) (string, error)
 	SetMaxSeq(ctx context.Context, conversationID string, maxSeq int64) error
 	GetMaxSeqs(ctx context.Context, conversationIDs []string) (map[string]int64, error)
 	GetMaxSeq(ctx context.Context, conversationID string) (int64, error)
@@ -85,6 +127,8 @@ type CommonMsgDatabase interface {
 	SetHasReadSeq(ctx context.Context, userID string, conversationID string, hasReadSeq int64) error
 	GetHasReadSeqs(ctx context.Context, userID string, conversationIDs []string) (map[string]int64, error)
 	GetHasReadSeq(ctx context.Context, userID string, conversationID string) (int64, error)
+	// GetUsersHasReadSeqs gets the has read seqs of the users in the conversation, k: userID, v: seq.
+	GetUsersHasReadSeqs(ctx context.Context, conversationID string, userIDs []string) (map[string]int64, error)
 	UserSetHasReadSeqs(ctx context.Context, userID string, hasReadSeqs map[string]int64) error
 
 	GetMongoMaxAndMinSeq(ctx context.Context, conversationID string) (minSeqMongo, maxSeqMongo int64, err error)
@@ -329,6 +373,212 @@ func (db *commonMsgDatabase) RevokeMsg(ctx context.Context, conversationID strin
 	return db.BatchInsertBlock(ctx, conversationID, []any{revoke}, updateKeyRevoke, seq)
 }
 
+func (db *commonMsgDatabase) ModifyMsg(ctx context.Context, conversationID string, seq int64, content string, history *model.ModifyModel) error {
+	docID := db.msgTable.GetDocID(conversationID, seq)
+	index := db.msgTable.GetMsgIndex(seq)
+	if err := db.msgDocDatabase.UpdateMsgContent(ctx, docID, index, content, history); err != nil {
+		if errors.Is(errs.Unwrap(err), mongo.ErrNoDocuments) {
+			return errs.ErrRecordNotFound.WrapMsg("msg not persisted yet or modified concurrently", "conversationID", conversationID, "seq", seq)
+		}
+		return err
+	}
+	return db.msg.DeleteMessagesFromCache(ctx, conversationID, []int64{seq})
+}
+
+func (db *commonMsgDatabase) GetMsgModifyHistory(ctx context.Context, conversationID string, seq int64) ([]*model.ModifyModel, error) {
+	history, err := db.msgDocDatabase.GetMsgModifyHistory(ctx, db.msgTable.GetDocID(conversationID, seq), db.msgTable.GetMsgIndex(seq))
+	if err != nil {
+		if errors.Is(errs.Unwrap(err), mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return history, nil
+}
+
+func (db *commonMsgDatabase) lockMsgReaction(ctx context.Context, clientMsgID string) error {
+	for i := 0; ; i++ {
+		err := db.msg.LockMessageTypeKey(ctx, clientMsgID, msgReactionLockKey)
+		if err == nil {
+			return nil
+		}
+		if !errs.ErrDuplicateKey.Is(err) || i >= msgReactionLockRetry {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return errs.Wrap(ctx.Err())
+		case <-time.After(msgReactionLockWait):
+		}
+	}
+}
+
+func (db *commonMsgDatabase) unlockMsgReaction(ctx context.Context, clientMsgID string) {
+	if err := db.msg.UnLockMessageTypeKey(ctx, clientMsgID, msgReactionLockKey); err != nil {
+		log.ZError(ctx, "unlock msg reaction failed", err, "clientMsgID", clientMsgID)
+	}
+}
+
+func (db *commonMsgDatabase) SetMsgReaction(ctx context.Context, conversationID string, msg *sdkws.MsgData, reactionKey string, userID string, isAdd bool,
+	maxReactionKeys int) (map[string][]string, error) {
+	if err := db.lockMsgReaction(ctx, msg.ClientMsgID); err != nil {
+		return nil, err
+	}
+	defer db.unlockMsgReaction(ctx, msg.ClientMsgID)
+	docID := db.msgTable.GetDocID(conversationID, msg.Seq)
+	index := db.msgTable.GetMsgIndex(msg.Seq)
+	if isAdd {
+		reactions, err := db.msgDocDatabase.GetMsgReactions(ctx, docID, index)
+		if err != nil {
+			if errors.Is(errs.Unwrap(err), mongo.ErrNoDocuments) {
+				return nil, errs.ErrRecordNotFound.WrapMsg("msg not persisted yet", "conversationID", conversationID, "seq", msg.Seq)
+			}
+			return nil, err
+		}
+		if _, ok := reactions[reactionKey]; !ok && len(reactions) >= maxReactionKeys {
+			return nil, errs.ErrArgs.WrapMsg("too many reactions of the msg", "max", maxReactionKeys)
+		}
+	}
+	reactions, err := db.msgDocDatabase.UpdateMsgReaction(ctx, docID, index, reactionKey, userID, isAdd)
+	if err != nil {
+		if errors.Is(errs.Unwrap(err), mongo.ErrNoDocuments) {
+			return nil, errs.ErrRecordNotFound.WrapMsg("msg not persisted yet", "conversationID", conversationID, "seq", msg.Seq)
+		}
+		return nil, err
+	}
+	// the cached msg does not know it has reactions, reload it from mongo with the reaction option
+	if !msgprocessor.Options(msg.Options).IsReactionFromCache() {
+		if err := db.msg.DeleteMessagesFromCache(ctx, conversationID, []int64{msg.Seq}); err != nil {
+			return nil, err
+		}
+	}
+	exist, err := db.msg.JudgeMessageReactionExist(ctx, msg.ClientMsgID, msg.SessionType)
+	if err != nil {
+		return nil, err
+	}
+	// a missing reaction cache is filled by the next read
+	if exist {
+		data, err := json.Marshal(reactions[reactionKey])
+		if err != nil {
+			return nil, errs.Wrap(err)
+		}
+		if err := db.msg.SetMessageTypeKeyValue(ctx, msg.ClientMsgID, msg.SessionType, reactionKey, string(data)); err != nil {
+			return nil, err
+		}
+	}
+	return reactions, nil
+}
+
+func (db *commonMsgDatabase) GetMsgsReactions(ctx context.Context, conversationID string, msgs []*sdkws.MsgData) (map[int64]map[string][]string, error) {
+	res := make(map[int64]map[string][]string, len(msgs))
+	if len(msgs) == 0 {
+		return res, nil
+	}
+	values, err := db.msg.GetMessagesAllReactionList(ctx, msgs)
+	if err != nil {
+		return nil, err
+	}
+	missMsgs := make(map[int64]*sdkws.MsgData)
+	for _, msg := range msgs {
+		value, ok := values[msg.ClientMsgID]
+		if !ok {
+			missMsgs[msg.Seq] = msg
+			continue
+		}
+		reactions := make(map[string][]string, len(value))
+		for reactionKey, data := range value {
+			if reactionKey == msgReactionCachedKey {
+				continue
+			}
+			var userIDs []string
+			if err := json.Unmarshal([]byte(data), &userIDs); err != nil {
+				return nil, errs.WrapMsg(err, "unmarshal msg reaction failed", "clientMsgID", msg.ClientMsgID, "reactionKey", reactionKey)
+			}
+			if len(userIDs) > 0 {
+				reactions[reactionKey] = userIDs
+			}
+		}
+		if len(reactions) > 0 {
+			res[msg.Seq] = reactions
+		}
+	}
+	if len(missMsgs) == 0 {
+		return res, nil
+	}
+	for docID, seqs := range db.msgTable.GetDocIDSeqsMap(conversationID, datautil.Keys(missMsgs)) {
+		indexes := make([]int64, 0, len(seqs))
+		for _, seq := range seqs {
+			indexes = append(indexes, db.msgTable.GetMsgIndex(seq))
+		}
+		docReactions, err := db.msgDocDatabase.GetMsgsReactions(ctx, docID, indexes)
+		if err != nil {
+			return nil, err
+		}
+		docMsgs := make([]*sdkws.MsgData, 0, len(seqs))
+		for _, seq := range seqs {
+			if reactions, ok := docReactions[seq]; ok {
+				res[seq] = reactions
+			}
+			docMsgs = append(docMsgs, missMsgs[seq])
+		}
+		// the msgs without reactions are cached too, otherwise each pull of them reads mongo again
+		db.fillMsgsReactionCache(ctx, docID, docMsgs)
+	}
+	return res, nil
+}
+
+// fillMsgsReactionCache caches the reactions of the msgs of the doc, an empty cache is set for the msgs without reactions.
+// The reactions are read again after the locks are taken, so an update committed after the first read can not be
+// overwritten by the stale reactions.
+func (db *commonMsgDatabase) fillMsgsReactionCache(ctx context.Context, docID string, msgs []*sdkws.MsgData) {
+	lockedMsgs := make(map[int64]*sdkws.MsgData, len(msgs))
+	indexes := make([]int64, 0, len(msgs))
+	for _, msg := range msgs {
+		// fill the cache only when no reaction is being updated, the next read fills it otherwise
+		if err := db.msg.LockMessageTypeKey(ctx, msg.ClientMsgID, msgReactionLockKey); err != nil {
+			log.ZDebug(ctx, "skip filling msg reaction cache", "clientMsgID", msg.ClientMsgID, "err", err)
+			continue
+		}
+		lockedMsgs[msg.Seq] = msg
+		indexes = append(indexes, db.msgTable.GetMsgIndex(msg.Seq))
+	}
+	defer func() {
+		for _, msg := range lockedMsgs {
+			db.unlockMsgReaction(ctx, msg.ClientMsgID)
+		}
+	}()
+	if len(lockedMsgs) == 0 {
+		return
+	}
+	docReactions, err := db.msgDocDatabase.GetMsgsReactions(ctx, docID, indexes)
+	if err != nil {
+		log.ZError(ctx, "get msgs reactions failed", err, "docID", docID)
+		return
+	}
+	for seq, msg := range lockedMsgs {
+		if err := db.setMsgReactionCache(ctx, msg, docReactions[seq]); err != nil {
+			log.ZError(ctx, "set msg reaction cache failed", err, "clientMsgID", msg.ClientMsgID)
+		}
+	}
+}
+
+func (db *commonMsgDatabase) setMsgReactionCache(ctx context.Context, msg *sdkws.MsgData, reactions map[string][]string) error {
+	if err := db.msg.SetMessageTypeKeyValue(ctx, msg.ClientMsgID, msg.SessionType, msgReactionCachedKey, ""); err != nil {
+		return err
+	}
+	for reactionKey, userIDs := range reactions {
+		data, err := json.Marshal(userIDs)
+		if err != nil {
+			return errs.Wrap(err)
+		}
+		if err := db.msg.SetMessageTypeKeyValue(ctx, msg.ClientMsgID, msg.SessionType, reactionKey, string(data)); err != nil {
+			return err
+		}
+	}
+	_, err := db.msg.SetMessageReactionExpire(ctx, msg.ClientMsgID, msg.SessionType, msgReactionCacheExpire)
+	return err
+}
+
 func (db *commonMsgDatabase) MarkSingleChatMsgsAsRead(ctx context.Context, userID string, conversationID string, totalSeqs []int64) error {
 	for docID, seqs := range db.msgTable.GetDocIDSeqsMap(conversationID, totalSeqs) {
 		var indexes []int64
@@ -412,6 +662,18 @@ func (db *commonMsgDatabase) handlerDBMsg(ctx context.Context, cache map[int64][
 	if msg.IsRead {
 		msg.Msg.IsRead = true
 	}
+	if len(msg.Reactions) > 0 {
+		if msg.Msg.Options == nil {
+			msg.Msg.Options = make(map[string]bool)
+		}
+		msgprocessor.WithReactionFromCache()(msg.Msg.Options)
+	}
+	if len(msg.ModifyHistory) > 0 {
+		if msg.Msg.Options == nil {
+			msg.Msg.Options = make(map[string]bool)
+		}
+		msg.Msg.Options[msgprocessor.IsModified] = true
+	}
 	if msg.Msg.ContentType != constant.Quote {
 		return
 	}
@@ -782,6 +1044,130 @@ func (db *commonMsgDatabase) DeleteMsgsPhysicalBySeqs(ctx context.Context, conve
 	return nil
 }
 
+func (db *commonMsgDatabase) AddBurnMsgs(ctx context.Context, conversationID string, seqs []int64, burnTime time.Time) error {
+	return db.msg.AddBurnMsgs(ctx, conversationID, seqs, burnTime)
+}
+
+func (db *commonMsgDatabase) ClaimDueBurnMsgs(ctx context.Context, count int64, lease time.Duration) (map[string][]int64, error) {
+	return db.msg.ClaimDueBurnMsgs(ctx, time.Now(), count, lease)
+}
+
+func (db *commonMsgDatabase) DelayBurnMsgs(ctx context.Context, conversationID string, seqs []int64, burnTime time.Time) error {
+	return db.msg.DelayBurnMsgs(ctx, conversationID, seqs, burnTime)
+}
+
+func (db *commonMsgDatabase) IncrBurnMsgAttempts(ctx context.Context, conversationID string, seqs []int64) (map[int64]int64, error) {
+	return db.msg.IncrBurnMsgAttempts(ctx, conversationID, seqs)
+}
+
+func (db *commonMsgDatabase) FinishBurnMsgs(ctx context.Context, conversationID string, seqs []int64) error {
+	return db.msg.DelBurnMsgs(ctx, conversationID, seqs)
+}
+
+func (db *commonMsgDatabase) GetPersistedMsgs(ctx context.Context, conversationID string, allSeqs []int64) ([]*model.MsgDataModel, []int64, error) {
+	var msgs []*model.MsgDataModel
+	for docID, seqs := range db.msgTable.GetDocIDSeqsMap(conversationID, allSeqs) {
+		docMsgs, err := db.msgDocDatabase.GetMsgBySeqIndexIn1Doc(ctx, "", docID, seqs)
+		if err != nil {
+			if errors.Is(errs.Unwrap(err), mongo.ErrNoDocuments) {
+				continue
+			}
+			return nil, nil, err
+		}
+		for _, msg := range docMsgs {
+			msgs = append(msgs, msg.Msg)
+		}
+	}
+	missSeqs := datautil.Single(allSeqs, datautil.Slice(msgs, func(msg *model.MsgDataModel) int64 { return msg.Seq }))
+	if len(missSeqs) == 0 {
+		return msgs, nil, nil
+	}
+	cachedMsgs, _, err := db.msg.GetMessagesBySeq(ctx, conversationID, missSeqs)
+	if err != nil {
+		return nil, nil, err
+	}
+	return msgs, datautil.Slice(cachedMsgs, func(msg *sdkws.MsgData) int64 { return msg.Seq }), nil
+}
+
+func (db *commonMsgDatabase) TakeSendMsgTokens(ctx context.Context, msg *sdkws.MsgData, limit *config.MsgRateLimit) (string, error) {
+	// the buckets of the sender are in one slot and taken at once, the bucket of the group is in another slot
+	buckets := []rateLimitBucket{
+		{name: "user", key: cachekey.GetMsgRateLimitUserKey(msg.SendID), bucket: limit.User},
+		{name: "platform", key: cachekey.GetMsgRateLimitPlatformKey(msg.SendID, msg.SenderPlatformID), bucket: limit.Platform},
+	}
+	name, err := db.takeRateLimitTokens(ctx, buckets)
+	if err != nil || name != "" || msg.GroupID == "" {
+		return name, err
+	}
+	name, err = db.takeRateLimitTokens(ctx, []rateLimitBucket{
+		{name: "group", key: cachekey.GetMsgRateLimitGroupKey(msg.GroupID), bucket: limit.Group},
+	})
+	if err != nil || name == "" {
+		return name, err
+	}
+	// the msg rejected by the group costs the sender no tokens
+	if err := db.returnRateLimitTokens(ctx, buckets); err != nil {
+		log.ZWarn(ctx, "return the rate limit tokens of the sender failed", err, "sendID", msg.SendID)
+	}
+	return name, nil
+}
+
+type rateLimitBucket struct {
+	name   string
+	key    string
+	bucket config.TokenBucket
+}
+
+// takeRateLimitTokens takes a token from each enabled bucket only if every one of them has one, so a rejected msg
+// costs none of them. It returns the name of the first empty bucket, or "".
+func (db *commonMsgDatabase) takeRateLimitTokens(ctx context.Context, buckets []rateLimitBucket) (string, error) {
+	var (
+		enabled []rateLimitBucket
+		keys    []string
+		rates   []float64
+		bursts  []int
+	)
+	for _, b := range buckets {
+		if b.bucket.Rate <= 0 {
+			continue
+		}
+		enabled = append(enabled, b)
+		keys = append(keys, b.key)
+		rates = append(rates, b.bucket.Rate)
+		bursts = append(bursts, max(b.bucket.Burst, 1))
+	}
+	if len(enabled) == 0 {
+		return "", nil
+	}
+	index, err := db.msg.TakeRateLimitTokens(ctx, keys, rates, bursts)
+	if err != nil {
+		return "", err
+	}
+	if index < 0 || index >= len(enabled) {
+		return "", nil
+	}
+	return enabled[index].name, nil
+}
+
+// returnRateLimitTokens gives back the tokens taken from the enabled buckets by takeRateLimitTokens.
+func (db *commonMsgDatabase) returnRateLimitTokens(ctx context.Context, buckets []rateLimitBucket) error {
+	var (
+		keys   []string
+		bursts []int
+	)
+	for _, b := range buckets {
+		if b.bucket.Rate <= 0 {
+			continue
+		}
+		keys = append(keys, b.key)
+		bursts = append(bursts, max(b.bucket.Burst, 1))
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	return db.msg.ReturnRateLimitTokens(ctx, keys, bursts)
+}
+
 func (db *commonMsgDatabase) DeleteUserMsgsBySeqs(ctx context.Context, userID string, conversationID string, seqs []int64) error {
 	if err := db.msg.DeleteMessagesFromCache(ctx, conversationID, seqs); err != nil {
 		return err
@@ -873,6 +1259,10 @@ func (db *commonMsgDatabase) SetHasReadSeq(ctx context.Context, userID string, c
 	return db.seq.SetHasReadSeq(ctx, userID, conversationID, hasReadSeq)
 }
 
+func (db *commonMsgDatabase) GetUsersHasReadSeqs(ctx context.Context, conversationID string, userIDs []string) (map[string]int64, error) {
+	return db.seq.GetUsersHasReadSeqs(ctx, conversationID, userIDs)
+}
+
 func (db *commonMsgDatabase) GetHasReadSeqs(ctx context.Context, userID string, conversationIDs []string) (map[string]int64, error) {
 	return db.seq.GetHasReadSeqs(ctx, userID, conversationIDs)
 }
@@ -0,0 +1,165 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/cachekey"
+	"github.com/openimsdk/protocol/sdkws"
+)
+
+type tokenBucket struct {
+	tokens float64
+	time   int64
+}
+
+// rateLimitMsgCache takes the tokens as takeRateLimitTokensScript does, with a clock in milliseconds.
+type rateLimitMsgCache struct {
+	cache.MsgCache
+	now     int64
+	buckets map[string]*tokenBucket
+	calls   [][]string
+}
+
+func (c *rateLimitMsgCache) TakeRateLimitTokens(_ context.Context, keys []string, rates []float64, bursts []int) (int, error) {
+	c.calls = append(c.calls, keys)
+	tokens := make([]float64, len(keys))
+	for i, key := range keys {
+		b, ok := c.buckets[key]
+		if !ok {
+			b = &tokenBucket{tokens: float64(bursts[i]), time: c.now}
+		}
+		tokens[i] = min(float64(bursts[i]), b.tokens+float64(c.now-b.time)*rates[i]/1000)
+		if tokens[i] < 1 {
+			return i, nil
+		}
+	}
+	for i, key := range keys {
+		c.buckets[key] = &tokenBucket{tokens: tokens[i] - 1, time: c.now}
+	}
+	return -1, nil
+}
+
+func (c *rateLimitMsgCache) ReturnRateLimitTokens(_ context.Context, keys []string, bursts []int) error {
+	for i, key := range keys {
+		if b, ok := c.buckets[key]; ok {
+			b.tokens = min(float64(bursts[i]), b.tokens+1)
+		}
+	}
+	return nil
+}
+
+func TestTakeSendMsgTokens(t *testing.T) {
+	ctx := context.Background()
+	msgCache := &rateLimitMsgCache{buckets: make(map[string]*tokenBucket)}
+	db := &commonMsgDatabase{msg: msgCache}
+	limit := &config.MsgRateLimit{
+		Enable:   true,
+		User:     config.TokenBucket{Rate: 2, Burst: 3},
+		Platform: config.TokenBucket{Rate: 10, Burst: 10},
+		Group:    config.TokenBucket{Rate: 1, Burst: 4},
+	}
+	msg := &sdkws.MsgData{SendID: "user1", SenderPlatformID: 1}
+	take := func(msg *sdkws.MsgData) string {
+		t.Helper()
+		name, err := db.TakeSendMsgTokens(ctx, msg, limit)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return name
+	}
+
+	// burst
+	for i := 0; i < 3; i++ {
+		if name := take(msg); name != "" {
+			t.Fatalf("msg %d is limited by %s within the burst", i, name)
+		}
+	}
+	if name := take(msg); name != "user" {
+		t.Fatalf("limited by %q after the burst, want user", name)
+	}
+	// the rejected msg costs no platform token
+	if tokens := msgCache.buckets[msgCache.calls[0][1]].tokens; tokens != 7 {
+		t.Fatalf("platform tokens %v, want 7", tokens)
+	}
+
+	// refill
+	msgCache.now += 500
+	if name := take(msg); name != "" {
+		t.Fatalf("limited by %s after the refill", name)
+	}
+	if name := take(msg); name != "user" {
+		t.Fatalf("limited by %q after the refilled token is taken, want user", name)
+	}
+
+	// the buckets of the sender are taken at once, the bucket of the group by another call
+	msgCache.now += 10_000
+	msgCache.calls = nil
+	groupMsg := &sdkws.MsgData{SendID: "user1", SenderPlatformID: 1, GroupID: "group1"}
+	if name := take(groupMsg); name != "" {
+		t.Fatalf("group msg is limited by %s", name)
+	}
+	if len(msgCache.calls) != 2 || len(msgCache.calls[0]) != 2 || len(msgCache.calls[1]) != 1 {
+		t.Fatalf("calls %v, want the buckets of the sender and then the group", msgCache.calls)
+	}
+	for _, key := range msgCache.calls[0] {
+		if !strings.Contains(key, "{user1}") {
+			t.Fatalf("key %s of the sender is not hash tagged by the sender", key)
+		}
+	}
+
+	// the group bucket is shared by the senders
+	for i, sendID := range []string{"user2", "user3", "user4"} {
+		if name := take(&sdkws.MsgData{SendID: sendID, SenderPlatformID: 1, GroupID: "group1"}); name != "" {
+			t.Fatalf("group msg %d is limited by %s", i, name)
+		}
+	}
+	msgCache.calls = nil
+	user5Msg := &sdkws.MsgData{SendID: "user5", SenderPlatformID: 1, GroupID: "group1"}
+	if name := take(user5Msg); name != "group" {
+		t.Fatalf("limited by %q after the group burst, want group", name)
+	}
+	// the msg rejected by the group costs the sender no tokens
+	for i, key := range msgCache.calls[0] {
+		burst := []float64{3, 10}[i]
+		if tokens := msgCache.buckets[key].tokens; tokens != burst {
+			t.Fatalf("tokens %v of %s after the group rejection, want %v", tokens, key, burst)
+		}
+	}
+
+	// a msg limited by the sender takes no group token
+	groupTokens := msgCache.buckets[msgCache.calls[1][0]].tokens
+	msgCache.buckets[cachekey.GetMsgRateLimitUserKey("user1")].tokens = 0
+	if name := take(groupMsg); name != "user" {
+		t.Fatalf("limited by %q, want user", name)
+	}
+	if tokens := msgCache.buckets[msgCache.calls[1][0]].tokens; tokens != groupTokens {
+		t.Fatalf("group tokens %v, want %v", tokens, groupTokens)
+	}
+
+	// disabled limits are not taken
+	msgCache.calls = nil
+	if name, err := db.TakeSendMsgTokens(ctx, groupMsg, &config.MsgRateLimit{Enable: true}); err != nil || name != "" {
+		t.Fatalf("disabled limits: %q, %v", name, err)
+	}
+	if len(msgCache.calls) != 0 {
+		t.Fatalf("disabled limits are taken: %v", msgCache.calls)
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+)
+
+// MsgRetryDatabase keeps the failed processing of the stored msgs, they are retried from a delayed queue in redis
+// and end in the dead letters in mongodb after failing the max attempts.
+type MsgRetryDatabase interface {
+	// ScheduleMsgRetry adds the task to the retry queue, or reschedules it if it is already in the queue.
+	ScheduleMsgRetry(ctx context.Context, task *model.MsgRetryTask, dueTime time.Time) error
+	// ClaimDueMsgRetries claims at most count due tasks, a task claimed but neither rescheduled nor finished
+	// within lease is considered abandoned and can be claimed again.
+	ClaimDueMsgRetries(ctx context.Context, count int, lease time.Duration) ([]*model.MsgRetryTask, error)
+	// FinishMsgRetry removes the task from the retry queue.
+	FinishMsgRetry(ctx context.Context, taskID string) error
+	// KillMsgRetry moves the task from the retry queue to the dead letters.
+	KillMsgRetry(ctx context.Context, task *model.MsgRetryTask) error
+}
+
+func NewMsgRetryDatabase(cache cache.MsgRetryCache, deadLetter database.MsgRetryDeadLetter) MsgRetryDatabase {
+	return &msgRetryDatabase{cache: cache, deadLetter: deadLetter}
+}
+
+type msgRetryDatabase struct {
+	cache      cache.MsgRetryCache
+	deadLetter database.MsgRetryDeadLetter
+}
+
+func (m *msgRetryDatabase) ScheduleMsgRetry(ctx context.Context, task *model.MsgRetryTask, dueTime time.Time) error {
+	return m.cache.AddMsgRetryTask(ctx, task, dueTime)
+}
+
+func (m *msgRetryDatabase) ClaimDueMsgRetries(ctx context.Context, count int, lease time.Duration) ([]*model.MsgRetryTask, error) {
+	return m.cache.ClaimDueMsgRetryTasks(ctx, count, lease)
+}
+
+func (m *msgRetryDatabase) FinishMsgRetry(ctx context.Context, taskID string) error {
+	return m.cache.DelMsgRetryTask(ctx, taskID)
+}
+
+func (m *msgRetryDatabase) KillMsgRetry(ctx context.Context, task *model.MsgRetryTask) error {
+	// the dead letter is saved first, a task claimed again after a failure in between replaces it
+	if err := m.deadLetter.Save(ctx, task); err != nil {
+		return err
+	}
+	return m.cache.DelMsgRetryTask(ctx, task.TaskID)
+}
@@ -0,0 +1,266 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/convert"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/db/pagination"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+)
+
+// MsgSearchDatabase maintains the full-text search index of the msgs, notifications are not indexed.
+//
+// The index is stored on the local disk of the msg rpc instance while the msgs are indexed by load balanced
+// rpc calls, so it is guarded by a lease in redis: only the instance holding the lease indexes and searches,
+// the others fail with ErrInternalServer and forward the calls to the holder by its address in the lease.
+type MsgSearchDatabase interface {
+	// HoldIndex takes the lease of the index for the instance at addr and renews it until ctx is done. The index
+	// stops serving once the lease is lost and the lease is taken again once it is free, onHeld is called each time
+	// it is taken. The owner of the lease is the id of the index files, so a restarted instance with the same files
+	// takes the lease at once, while an instance with other files waits for the lease to expire.
+	HoldIndex(ctx context.Context, addr string, onHeld func(ctx context.Context))
+	// Held reports whether the index is served by this instance.
+	Held() bool
+	// GetHolderAddr returns the rpc address of the instance serving the index, "" if no instance holds it.
+	GetHolderAddr(ctx context.Context) (string, error)
+	// IndexMsgs indexes the msgs of the conversation, msgs must have got their seqs.
+	IndexMsgs(ctx context.Context, conversationID string, msgs []*sdkws.MsgData) error
+	DeleteMsgs(ctx context.Context, conversationID string, seqs []int64) error
+	// SearchMsgs returns the conversationID and seq of the matched msgs, the latest first.
+	SearchMsgs(ctx context.Context, filter *model.MsgSearchFilter, pagination pagination.Pagination) (int64, []*model.MsgSearchHit, error)
+	// Backfill indexes the msgs stored before the index was created, it resumes from its checkpoint and returns
+	// once all the msg docs are indexed. The msgs indexed meanwhile by IndexMsgs are just indexed again.
+	// The checkpoint is started over when the lease is taken after another index served, as the msgs indexed
+	// by the other index are missing.
+	Backfill(ctx context.Context) error
+	Close() error
+}
+
+const (
+	// msgSearchBackfillDone is the backfill checkpoint once the backfill finished, it is never a docID which has a ":".
+	msgSearchBackfillDone = "done"
+	// msgSearchBackfillBatch is the number of msg docs indexed at a time by the backfill.
+	msgSearchBackfillBatch = 50
+	// msgSearchIndexLease is how long the lease of the msg search index lasts if it is not renewed.
+	msgSearchIndexLease = time.Second * 30
+)
+
+func NewMsgSearchDatabase(index database.MessageSearchIndex, msgDoc database.Msg, lease cache.MsgSearchCache) MsgSearchDatabase {
+	return &msgSearchDatabase{index: index, msgDoc: msgDoc, lease: lease}
+}
+
+type msgSearchDatabase struct {
+	index  database.MessageSearchIndex
+	msgDoc database.Msg
+	lease  cache.MsgSearchCache
+	// held is whether the lease is held, renewTime is when the held lease was renewed last
+	held      atomic.Bool
+	renewTime time.Time
+}
+
+func (m *msgSearchDatabase) HoldIndex(ctx context.Context, addr string, onHeld func(ctx context.Context)) {
+	owner, err := m.index.GetIndexID(ctx)
+	if err != nil {
+		log.ZError(ctx, "get msg search index id failed, the index is not served", err)
+		return
+	}
+	ticker := time.NewTicker(msgSearchIndexLease / 3)
+	defer ticker.Stop()
+	for {
+		if m.renewLease(ctx, owner, addr) {
+			go onHeld(ctx)
+		}
+		select {
+		case <-ctx.Done():
+			if m.held.Swap(false) {
+				ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), time.Second*3)
+				if err := m.lease.ReleaseMsgSearchIndex(ctx, owner); err != nil {
+					log.ZWarn(ctx, "release msg search index lease failed", err, "owner", owner)
+				}
+				cancel()
+			}
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// renewLease takes or renews the lease, it returns true if the lease is newly taken.
+func (m *msgSearchDatabase) renewLease(ctx context.Context, owner string, addr string) bool {
+	now := time.Now()
+	ok, err := m.lease.LeaseMsgSearchIndex(ctx, owner, addr, msgSearchIndexLease)
+	switch {
+	case err != nil:
+		log.ZWarn(ctx, "renew msg search index lease failed", err, "owner", owner)
+		// the lease may expire and be taken by another instance before it is renewed again
+		if m.held.Load() && now.Sub(m.renewTime) > msgSearchIndexLease*2/3 {
+			m.held.Store(false)
+			log.ZError(ctx, "msg search index lease lost, stop serving the index", err, "owner", owner)
+		}
+		return false
+	case ok:
+		m.renewTime = now
+		if m.held.Load() {
+			return false
+		}
+		if err := m.takeOver(ctx, owner); err != nil {
+			log.ZError(ctx, "take over msg search index failed, it is retried by the next renewal", err, "owner", owner)
+			return false
+		}
+		m.held.Store(true)
+		log.ZInfo(ctx, "msg search index lease taken, start serving the index", "owner", owner, "addr", addr)
+		return true
+	default:
+		if m.held.Swap(false) {
+			log.ZError(ctx, "msg search index lease is taken by another msg rpc instance, stop serving the index", nil, "owner", owner)
+		}
+		return false
+	}
+}
+
+// takeOver records owner as the last index served. The backfill of the index is started over if another index
+// has served since this one, as the msgs indexed meanwhile are missing in this one.
+func (m *msgSearchDatabase) takeOver(ctx context.Context, owner string) error {
+	served, err := m.lease.GetMsgSearchIndexServed(ctx)
+	if err != nil {
+		return err
+	}
+	if served == owner {
+		return nil
+	}
+	log.ZInfo(ctx, "msg search index served by another index, backfill from the start", "owner", owner, "served", served)
+	if err := m.index.SetBackfillCheckpoint(ctx, ""); err != nil {
+		return err
+	}
+	return m.lease.SetMsgSearchIndexServed(ctx, owner)
+}
+
+func (m *msgSearchDatabase) Held() bool {
+	return m.held.Load()
+}
+
+func (m *msgSearchDatabase) GetHolderAddr(ctx context.Context) (string, error) {
+	return m.lease.GetMsgSearchIndexAddr(ctx)
+}
+
+func (m *msgSearchDatabase) checkHeld() error {
+	if !m.held.Load() {
+		return errs.ErrInternalServer.WrapMsg("the msg search index is not held by this msg rpc instance")
+	}
+	return nil
+}
+
+func (m *msgSearchDatabase) IndexMsgs(ctx context.Context, conversationID string, msgs []*sdkws.MsgData) error {
+	if err := m.checkHeld(); err != nil {
+		return err
+	}
+	docs := make([]*model.MsgSearchDoc, 0, len(msgs))
+	for _, msg := range msgs {
+		if msg.ContentType >= constant.NotificationBegin && msg.ContentType <= constant.NotificationEnd {
+			continue
+		}
+		recvID := msg.RecvID
+		if msg.GroupID != "" {
+			recvID = msg.GroupID
+		}
+		docs = append(docs, &model.MsgSearchDoc{
+			ConversationID: conversationID,
+			Seq:            msg.Seq,
+			SendID:         msg.SendID,
+			RecvID:         recvID,
+			SessionType:    msg.SessionType,
+			ContentType:    msg.ContentType,
+			SendTime:       msg.SendTime,
+			Text:           msgprocessor.GetMsgSearchText(msg.ContentType, msg.Content),
+		})
+	}
+	return m.index.Index(ctx, docs)
+}
+
+func (m *msgSearchDatabase) DeleteMsgs(ctx context.Context, conversationID string, seqs []int64) error {
+	if err := m.checkHeld(); err != nil {
+		return err
+	}
+	return m.index.Delete(ctx, conversationID, seqs)
+}
+
+func (m *msgSearchDatabase) SearchMsgs(ctx context.Context, filter *model.MsgSearchFilter, pagination pagination.Pagination) (int64, []*model.MsgSearchHit, error) {
+	if err := m.checkHeld(); err != nil {
+		return 0, nil, err
+	}
+	return m.index.Search(ctx, filter, pagination)
+}
+
+func (m *msgSearchDatabase) Backfill(ctx context.Context) error {
+	checkpoint, err := m.index.GetBackfillCheckpoint(ctx)
+	if err != nil {
+		return err
+	}
+	if checkpoint == msgSearchBackfillDone {
+		return nil
+	}
+	log.ZInfo(ctx, "msg search index backfill start", "checkpoint", checkpoint)
+	for {
+		if err := ctx.Err(); err != nil {
+			return errs.Wrap(err)
+		}
+		docs, err := m.msgDoc.FindDocsAfter(ctx, checkpoint, msgSearchBackfillBatch)
+		if err != nil {
+			return err
+		}
+		for _, doc := range docs {
+			i := strings.LastIndex(doc.DocID, ":")
+			if i < 0 {
+				continue
+			}
+			msgs := make([]*sdkws.MsgData, 0, len(doc.Msg))
+			for _, msg := range doc.Msg {
+				if msg == nil || msg.Msg == nil || msg.Revoke != nil {
+					continue
+				}
+				msgs = append(msgs, convert.MsgDB2Pb(msg.Msg))
+			}
+			if err := m.IndexMsgs(ctx, doc.DocID[:i], msgs); err != nil {
+				return err
+			}
+		}
+		if len(docs) > 0 {
+			checkpoint = docs[len(docs)-1].DocID
+		}
+		if len(docs) < msgSearchBackfillBatch {
+			log.ZInfo(ctx, "msg search index backfill done", "checkpoint", checkpoint)
+			return m.index.SetBackfillCheckpoint(ctx, msgSearchBackfillDone)
+		}
+		if err := m.index.SetBackfillCheckpoint(ctx, checkpoint); err != nil {
+			return err
+		}
+	}
+}
+
+func (m *msgSearchDatabase) Close() error {
+	return m.index.Close()
+}
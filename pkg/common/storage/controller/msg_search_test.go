@@ -0,0 +1,183 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/db/pagination"
+)
+
+type msgSearchLease struct {
+	owner     string
+	addr      string
+	served    string
+	err       error
+	servedErr error
+}
+
+func (l *msgSearchLease) LeaseMsgSearchIndex(_ context.Context, owner string, addr string, _ time.Duration) (bool, error) {
+	if l.err != nil {
+		return false, l.err
+	}
+	if l.owner != "" && l.owner != owner {
+		return false, nil
+	}
+	l.owner, l.addr = owner, addr
+	return true, nil
+}
+
+func (l *msgSearchLease) ReleaseMsgSearchIndex(_ context.Context, owner string) error {
+	if l.owner == owner {
+		l.owner, l.addr = "", ""
+	}
+	return nil
+}
+
+func (l *msgSearchLease) GetMsgSearchIndexAddr(context.Context) (string, error) {
+	return l.addr, nil
+}
+
+func (l *msgSearchLease) GetMsgSearchIndexServed(context.Context) (string, error) {
+	return l.served, l.servedErr
+}
+
+func (l *msgSearchLease) SetMsgSearchIndexServed(_ context.Context, owner string) error {
+	l.served = owner
+	return nil
+}
+
+type msgSearchIndex struct {
+	database.MessageSearchIndex
+	indexed    int
+	checkpoint string
+}
+
+func (m *msgSearchIndex) GetBackfillCheckpoint(context.Context) (string, error) {
+	return m.checkpoint, nil
+}
+
+func (m *msgSearchIndex) SetBackfillCheckpoint(_ context.Context, docID string) error {
+	m.checkpoint = docID
+	return nil
+}
+
+func (m *msgSearchIndex) Index(_ context.Context, docs []*model.MsgSearchDoc) error {
+	m.indexed += len(docs)
+	return nil
+}
+
+func (m *msgSearchIndex) Search(context.Context, *model.MsgSearchFilter, pagination.Pagination) (int64, []*model.MsgSearchHit, error) {
+	return 0, nil, nil
+}
+
+func TestMsgSearchIndexLease(t *testing.T) {
+	ctx := context.Background()
+	lease := &msgSearchLease{owner: "other"}
+	index := &msgSearchIndex{}
+	m := NewMsgSearchDatabase(index, nil, lease).(*msgSearchDatabase)
+	msgs := []*sdkws.MsgData{{Seq: 1, ContentType: 101, Content: []byte(`{"content":"hello"}`)}}
+
+	// the lease held by another instance
+	if m.renewLease(ctx, "index", "10.0.0.1:10130") {
+		t.Fatal("the lease held by another instance is taken")
+	}
+	if err := m.IndexMsgs(ctx, "si_a_b", msgs); err == nil {
+		t.Fatal("indexed without the lease")
+	}
+	if _, _, err := m.SearchMsgs(ctx, &model.MsgSearchFilter{}, nil); err == nil {
+		t.Fatal("searched without the lease")
+	}
+
+	// the lease expired
+	lease.owner = ""
+	if !m.renewLease(ctx, "index", "10.0.0.1:10130") {
+		t.Fatal("the free lease is not taken")
+	}
+	if m.renewLease(ctx, "index", "10.0.0.1:10130") {
+		t.Fatal("a renewal is reported as newly taken")
+	}
+	if err := m.IndexMsgs(ctx, "si_a_b", msgs); err != nil || index.indexed != 1 {
+		t.Fatalf("index with the lease: %v, indexed %d", err, index.indexed)
+	}
+
+	// a failed renewal keeps the lease until it may have expired
+	lease.err = errors.New("redis unavailable")
+	m.renewLease(ctx, "index", "10.0.0.1:10130")
+	if err := m.checkHeld(); err != nil {
+		t.Fatalf("the lease is dropped right after a failed renewal: %v", err)
+	}
+	m.renewTime = time.Now().Add(-msgSearchIndexLease)
+	m.renewLease(ctx, "index", "10.0.0.1:10130")
+	if err := m.IndexMsgs(ctx, "si_a_b", msgs); err == nil {
+		t.Fatal("indexed after the lease may have expired")
+	}
+
+	// the lease taken by another instance
+	lease.err = nil
+	if !m.renewLease(ctx, "index", "10.0.0.1:10130") {
+		t.Fatal("the lease is not taken again")
+	}
+	lease.owner = "other"
+	m.renewLease(ctx, "index", "10.0.0.1:10130")
+	if err := m.checkHeld(); err == nil {
+		t.Fatal("the lease taken by another instance is still held")
+	}
+}
+
+func TestMsgSearchIndexTakeOver(t *testing.T) {
+	ctx := context.Background()
+	lease := &msgSearchLease{served: "index"}
+	index := &msgSearchIndex{checkpoint: msgSearchBackfillDone}
+	m := NewMsgSearchDatabase(index, nil, lease).(*msgSearchDatabase)
+
+	// the index served last resumes its backfill
+	if !m.renewLease(ctx, "index", "10.0.0.1:10130") {
+		t.Fatal("the free lease is not taken")
+	}
+	if index.checkpoint != msgSearchBackfillDone {
+		t.Fatalf("checkpoint %q of the index served last, want it kept", index.checkpoint)
+	}
+	if addr, _ := m.GetHolderAddr(ctx); addr != "10.0.0.1:10130" {
+		t.Fatalf("holder addr %q", addr)
+	}
+
+	// another index served meanwhile, the msgs indexed by it are backfilled
+	lease.owner = "other"
+	m.renewLease(ctx, "index", "10.0.0.1:10130")
+	if m.Held() {
+		t.Fatal("the lease taken by another instance is still held")
+	}
+	lease.served = "other"
+	lease.owner = ""
+	lease.servedErr = errors.New("redis unavailable")
+	if m.renewLease(ctx, "index", "10.0.0.1:10130") || m.Held() {
+		t.Fatal("the index is served before it is taken over")
+	}
+	// the takeover is retried by the next renewal of the lease
+	lease.servedErr = nil
+	if !m.renewLease(ctx, "index", "10.0.0.1:10130") {
+		t.Fatal("the lease is not taken over")
+	}
+	if index.checkpoint != "" || lease.served != "index" {
+		t.Fatalf("checkpoint %q and served %q after the takeover, want the backfill started over", index.checkpoint, lease.served)
+	}
+}
@@ -0,0 +1,230 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type modifyMsgDocs struct {
+	database.Msg
+	docID   string
+	index   int64
+	content string
+	err     error
+}
+
+func (m *modifyMsgDocs) GetMsgModifyHistory(_ context.Context, docID string, index int64) ([]*model.ModifyModel, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	m.docID, m.index = docID, index
+	return []*model.ModifyModel{{UserID: "user1", Content: "old"}}, nil
+}
+
+func (m *modifyMsgDocs) UpdateMsgContent(_ context.Context, docID string, index int64, content string, _ *model.ModifyModel) error {
+	m.docID, m.index, m.content = docID, index, content
+	return m.err
+}
+
+type delMsgCache struct {
+	cache.MsgCache
+	seqs []int64
+}
+
+func (c *delMsgCache) DeleteMessagesFromCache(_ context.Context, _ string, seqs []int64) error {
+	c.seqs = append(c.seqs, seqs...)
+	return nil
+}
+
+func TestModifyMsg(t *testing.T) {
+	docs, msgCache := &modifyMsgDocs{}, &delMsgCache{}
+	db := &commonMsgDatabase{msgDocDatabase: docs, msg: msgCache}
+	ctx := context.Background()
+	history := &model.ModifyModel{UserID: "user1", Content: "old"}
+	if err := db.ModifyMsg(ctx, "si_user1_user2", 101, "new", history); err != nil {
+		t.Fatal(err)
+	}
+	if docs.docID != db.msgTable.GetDocID("si_user1_user2", 101) || docs.index != db.msgTable.GetMsgIndex(101) || docs.content != "new" {
+		t.Fatalf("updated doc %s index %d content %s", docs.docID, docs.index, docs.content)
+	}
+	if len(msgCache.seqs) != 1 || msgCache.seqs[0] != 101 {
+		t.Fatalf("deleted cache seqs %v, want [101]", msgCache.seqs)
+	}
+
+	docs.err = errs.Wrap(mongo.ErrNoDocuments)
+	if err := db.ModifyMsg(ctx, "si_user1_user2", 101, "new", history); !errs.ErrRecordNotFound.Is(err) {
+		t.Fatalf("modify of a msg not persisted or modified concurrently: %v, want ErrRecordNotFound", err)
+	}
+	if len(msgCache.seqs) != 1 {
+		t.Fatalf("cache deleted after a failed modify")
+	}
+}
+
+func TestGetMsgModifyHistory(t *testing.T) {
+	docs := &modifyMsgDocs{}
+	db := &commonMsgDatabase{msgDocDatabase: docs}
+	ctx := context.Background()
+	history, err := db.GetMsgModifyHistory(ctx, "si_user1_user2", 101)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(history) != 1 || history[0].Content != "old" || docs.index != db.msgTable.GetMsgIndex(101) {
+		t.Fatalf("history %v of index %d", history, docs.index)
+	}
+
+	docs.err = errs.Wrap(mongo.ErrNoDocuments)
+	history, err = db.GetMsgModifyHistory(ctx, "si_user1_user2", 101)
+	if err != nil || len(history) != 0 {
+		t.Fatalf("history of a msg not persisted: %v %v, want none", history, err)
+	}
+}
+
+func TestHandlerDBMsgModified(t *testing.T) {
+	db := &commonMsgDatabase{}
+	ctx := context.Background()
+	modified := &model.MsgInfoModel{
+		Msg:           &model.MsgDataModel{Seq: 1, ContentType: constant.Text},
+		ModifyHistory: []*model.ModifyModel{{UserID: "user1", Content: "old"}},
+	}
+	db.handlerDBMsg(ctx, nil, "user1", "si_user1_user2", modified)
+	if !modified.Msg.Options[msgprocessor.IsModified] {
+		t.Fatal("modified msg is not marked")
+	}
+	unmodified := &model.MsgInfoModel{Msg: &model.MsgDataModel{Seq: 2, ContentType: constant.Text}}
+	db.handlerDBMsg(ctx, nil, "user1", "si_user1_user2", unmodified)
+	if unmodified.Msg.Options[msgprocessor.IsModified] {
+		t.Fatal("unmodified msg is marked")
+	}
+}
+
+type reactionMsgDocs struct {
+	database.Msg
+	reactions map[int64]map[string][]string
+	batches   [][]int64
+}
+
+func (m *reactionMsgDocs) GetMsgsReactions(_ context.Context, _ string, indexes []int64) (map[int64]map[string][]string, error) {
+	m.batches = append(m.batches, indexes)
+	res := make(map[int64]map[string][]string)
+	for _, index := range indexes {
+		if reactions, ok := m.reactions[index+1]; ok {
+			res[index+1] = reactions
+		}
+	}
+	return res, nil
+}
+
+type reactionMsgCache struct {
+	cache.MsgCache
+	values map[string]map[string]string
+	locked bool
+}
+
+func (c *reactionMsgCache) GetMessagesAllReactionList(_ context.Context, msgs []*sdkws.MsgData) (map[string]map[string]string, error) {
+	res := make(map[string]map[string]string)
+	for _, msg := range msgs {
+		if value, ok := c.values[msg.ClientMsgID]; ok {
+			res[msg.ClientMsgID] = value
+		}
+	}
+	return res, nil
+}
+
+func (c *reactionMsgCache) LockMessageTypeKey(_ context.Context, clientMsgID string, _ string) error {
+	if c.locked {
+		return errs.ErrDuplicateKey.WrapMsg("locked", "clientMsgID", clientMsgID)
+	}
+	return nil
+}
+
+func (c *reactionMsgCache) UnLockMessageTypeKey(context.Context, string, string) error {
+	return nil
+}
+
+func (c *reactionMsgCache) SetMessageTypeKeyValue(_ context.Context, clientMsgID string, _ int32, typeKey, value string) error {
+	if c.values[clientMsgID] == nil {
+		c.values[clientMsgID] = make(map[string]string)
+	}
+	c.values[clientMsgID][typeKey] = value
+	return nil
+}
+
+func (c *reactionMsgCache) SetMessageReactionExpire(context.Context, string, int32, time.Duration) (bool, error) {
+	return true, nil
+}
+
+func TestGetMsgsReactions(t *testing.T) {
+	docs := &reactionMsgDocs{reactions: map[int64]map[string][]string{
+		2: {"like": {"user2"}},
+		3: {"smile": {"user1", "user2"}},
+	}}
+	msgCache := &reactionMsgCache{values: map[string]map[string]string{
+		"msg1": {"like": `["user1"]`, "empty": `[]`},
+	}}
+	db := &commonMsgDatabase{msgDocDatabase: docs, msg: msgCache}
+	msgs := []*sdkws.MsgData{
+		{ClientMsgID: "msg1", Seq: 1},
+		{ClientMsgID: "msg2", Seq: 2},
+		{ClientMsgID: "msg3", Seq: 3},
+		{ClientMsgID: "msg4", Seq: 4},
+	}
+	res, err := db.GetMsgsReactions(context.Background(), "si_user1_user2", msgs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(docs.batches) != 2 || len(docs.batches[0]) != 3 {
+		t.Fatalf("mongo read by batches %v, want one batch of the 3 uncached msgs and one to fill the cache", docs.batches)
+	}
+	if len(res) != 3 || len(res[1]) != 1 || res[1]["like"][0] != "user1" || res[2]["like"][0] != "user2" || len(res[3]["smile"]) != 2 {
+		t.Fatalf("reactions %v", res)
+	}
+	// the reactions are read again under the locks to fill the cache
+	if len(docs.batches[1]) != 3 || msgCache.values["msg2"]["like"] != `["user2"]` || msgCache.values["msg3"] == nil {
+		t.Fatalf("reactions read again by %v, cache %v", docs.batches[1], msgCache.values)
+	}
+	// the msg without reactions is cached empty, its next read does not read mongo
+	if value, ok := msgCache.values["msg4"]; !ok || len(value) != 1 {
+		t.Fatalf("msg without reactions cached as %v", value)
+	}
+	docs.batches = nil
+	res, err = db.GetMsgsReactions(context.Background(), "si_user1_user2", msgs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(docs.batches) != 0 || len(res) != 3 {
+		t.Fatalf("cached reactions %v read mongo by %v", res, docs.batches)
+	}
+
+	msgCache.locked = true
+	delete(msgCache.values, "msg2")
+	if _, err := db.GetMsgsReactions(context.Background(), "si_user1_user2", msgs[1:2]); err != nil {
+		t.Fatal(err)
+	}
+	if len(docs.batches) != 1 || msgCache.values["msg2"] != nil {
+		t.Fatal("cache filled while the reactions are locked")
+	}
+}
@@ -0,0 +1,264 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/db/pagination"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+// threadReplySeqExpire is how long the thread seqs given to the replies are kept after the last reply of the thread,
+// to give a reply consumed again the same seq.
+const threadReplySeqExpire = time.Hour * 24
+
+// MsgThreadDatabase manages the threads of conversations, a thread is identified by the serverMsgID of its root msg
+// and has its own seq space beside the seq of the conversation.
+type MsgThreadDatabase interface {
+	// ExistThread reports whether the thread of the root has any reply.
+	ExistThread(ctx context.Context, conversationID string, rootServerMsgID string) (bool, error)
+	// BatchInsertThreadReplies gives the thread replies in msgs their thread seqs and indexes them, msgs must have got their seqs.
+	// It is idempotent, a reply inserted again keeps its thread seq.
+	BatchInsertThreadReplies(ctx context.Context, conversationID string, msgs []*sdkws.MsgData) error
+	// GetThreadReplies gets the replies of a thread in the order of the thread seq.
+	GetThreadReplies(ctx context.Context, conversationID string, rootServerMsgID string, pagination pagination.Pagination) (int64, []*model.MsgThreadReply, error)
+	// GetThreadMaxSeqs gets the max seqs of the threads, the ones missing in redis are set by the indexed replies,
+	// the threads without a reply are not in the map.
+	GetThreadMaxSeqs(ctx context.Context, conversationID string, rootServerMsgIDs []string) (map[string]int64, error)
+	// SetThreadHasReadSeq follows the thread for the user and increases its has read seq, it is stored in mongo and cached in redis.
+	SetThreadHasReadSeq(ctx context.Context, userID string, conversationID string, rootServerMsgID string, hasReadSeq int64) error
+	// GetThreadHasReadSeqs gets the has read seqs of the threads followed by the user in the conversation.
+	GetThreadHasReadSeqs(ctx context.Context, userID string, conversationID string) (map[string]int64, error)
+	// GetConversationsThreadSeqs gets the has read seqs and the max seqs of the threads followed by the user in the conversations,
+	// both are keyed by conversationID and then rootServerMsgID.
+	GetConversationsThreadSeqs(ctx context.Context, userID string, conversationIDs []string) (hasReadSeqs map[string]map[string]int64, maxSeqs map[string]map[string]int64, err error)
+}
+
+func NewMsgThreadDatabase(thread database.MsgThread, seq cache.SeqCache) MsgThreadDatabase {
+	return &msgThreadDatabase{thread: thread, seq: seq}
+}
+
+type msgThreadDatabase struct {
+	thread database.MsgThread
+	seq    cache.SeqCache
+}
+
+func (m *msgThreadDatabase) ExistThread(ctx context.Context, conversationID string, rootServerMsgID string) (bool, error) {
+	return m.thread.ExistThread(ctx, conversationID, rootServerMsgID)
+}
+
+func (m *msgThreadDatabase) BatchInsertThreadReplies(ctx context.Context, conversationID string, msgs []*sdkws.MsgData) error {
+	var (
+		threadMsgs   []*sdkws.MsgData
+		serverMsgIDs []string
+	)
+	for _, msg := range msgs {
+		if msgprocessor.GetThreadRootServerMsgID(msg) != "" {
+			threadMsgs = append(threadMsgs, msg)
+			serverMsgIDs = append(serverMsgIDs, msg.ServerMsgID)
+		}
+	}
+	if len(threadMsgs) == 0 {
+		return nil
+	}
+	// the replies indexed before are skipped, their thread seqs may have expired
+	indexed, err := m.thread.FindServerMsgIDs(ctx, conversationID, serverMsgIDs)
+	if err != nil {
+		return err
+	}
+	indexedSet := datautil.SliceSet(indexed)
+	var replies []*model.MsgThreadReply
+	for _, msg := range threadMsgs {
+		if _, ok := indexedSet[msg.ServerMsgID]; ok {
+			continue
+		}
+		rootServerMsgID := msgprocessor.GetThreadRootServerMsgID(msg)
+		threadSeq, err := m.allocThreadSeq(ctx, conversationID, rootServerMsgID, msg.ServerMsgID)
+		if err != nil {
+			return err
+		}
+		replies = append(replies, &model.MsgThreadReply{
+			ConversationID:  conversationID,
+			RootServerMsgID: rootServerMsgID,
+			ThreadSeq:       threadSeq,
+			Seq:             msg.Seq,
+			ServerMsgID:     msg.ServerMsgID,
+			SendID:          msg.SendID,
+			SendTime:        msg.SendTime,
+		})
+	}
+	if err := m.thread.Create(ctx, replies); err != nil {
+		return err
+	}
+	// the sender follows the thread and has read its own reply
+	for _, reply := range replies {
+		if err := m.SetThreadHasReadSeq(ctx, reply.SendID, conversationID, reply.RootServerMsgID, reply.ThreadSeq); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// allocThreadSeq allocates the thread seq of the reply, the max seq of the thread missing in redis is set
+// by the max thread seq of the indexed replies first, as the thread seqs are unique.
+func (m *msgThreadDatabase) allocThreadSeq(ctx context.Context, conversationID string, rootServerMsgID string, serverMsgID string) (int64, error) {
+	threadSeq, ok, err := m.seq.AllocThreadSeq(ctx, conversationID, rootServerMsgID, serverMsgID, threadReplySeqExpire)
+	if err != nil || ok {
+		return threadSeq, err
+	}
+	maxSeq, err := m.thread.FindMaxThreadSeq(ctx, conversationID, rootServerMsgID)
+	if err != nil {
+		return 0, err
+	}
+	if err := m.seq.InitThreadMaxSeq(ctx, conversationID, rootServerMsgID, maxSeq); err != nil {
+		return 0, err
+	}
+	threadSeq, ok, err = m.seq.AllocThreadSeq(ctx, conversationID, rootServerMsgID, serverMsgID, threadReplySeqExpire)
+	if err != nil {
+		return 0, err
+	}
+	if !ok {
+		return 0, errs.ErrInternalServer.WrapMsg("thread max seq missing after init", "conversationID", conversationID, "rootServerMsgID", rootServerMsgID)
+	}
+	return threadSeq, nil
+}
+
+func (m *msgThreadDatabase) GetThreadReplies(ctx context.Context, conversationID string, rootServerMsgID string, pagination pagination.Pagination) (int64, []*model.MsgThreadReply, error) {
+	return m.thread.FindReplies(ctx, conversationID, rootServerMsgID, pagination)
+}
+
+func (m *msgThreadDatabase) GetThreadMaxSeqs(ctx context.Context, conversationID string, rootServerMsgIDs []string) (map[string]int64, error) {
+	maxSeqs, err := m.seq.GetThreadMaxSeqs(ctx, conversationID, rootServerMsgIDs)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.fillThreadMaxSeqs(ctx, conversationID, rootServerMsgIDs, maxSeqs); err != nil {
+		return nil, err
+	}
+	return maxSeqs, nil
+}
+
+// fillThreadMaxSeqs sets the max seqs of the threads missing in redis by the max thread seqs of the indexed replies,
+// the threads without a reply are kept missing.
+func (m *msgThreadDatabase) fillThreadMaxSeqs(ctx context.Context, conversationID string, rootServerMsgIDs []string, maxSeqs map[string]int64) error {
+	for _, rootServerMsgID := range rootServerMsgIDs {
+		if _, ok := maxSeqs[rootServerMsgID]; ok {
+			continue
+		}
+		maxSeq, err := m.thread.FindMaxThreadSeq(ctx, conversationID, rootServerMsgID)
+		if err != nil {
+			return err
+		}
+		if maxSeq == 0 {
+			continue
+		}
+		if err := m.seq.InitThreadMaxSeq(ctx, conversationID, rootServerMsgID, maxSeq); err != nil {
+			return err
+		}
+		maxSeqs[rootServerMsgID] = maxSeq
+	}
+	return nil
+}
+
+func (m *msgThreadDatabase) SetThreadHasReadSeq(ctx context.Context, userID string, conversationID string, rootServerMsgID string, hasReadSeq int64) error {
+	if err := m.thread.SetHasReadSeq(ctx, userID, conversationID, rootServerMsgID, hasReadSeq); err != nil {
+		return err
+	}
+	return m.seq.SetThreadHasReadSeq(ctx, userID, conversationID, rootServerMsgID, hasReadSeq)
+}
+
+func (m *msgThreadDatabase) GetThreadHasReadSeqs(ctx context.Context, userID string, conversationID string) (map[string]int64, error) {
+	hasReadSeqs, err := m.getUserThreadHasReadSeqs(ctx, userID, []string{conversationID})
+	if err != nil {
+		return nil, err
+	}
+	if seqs, ok := hasReadSeqs[conversationID]; ok {
+		return seqs, nil
+	}
+	return map[string]int64{}, nil
+}
+
+// getUserThreadHasReadSeqs gets the has read seqs of the followed threads from redis, the ones not cached are loaded from mongo.
+// The conversations without followed threads are not in the map.
+func (m *msgThreadDatabase) getUserThreadHasReadSeqs(ctx context.Context, userID string, conversationIDs []string) (map[string]map[string]int64, error) {
+	hasReadSeqs, err := m.seq.GetUserThreadHasReadSeqs(ctx, userID, conversationIDs)
+	if err != nil {
+		return nil, err
+	}
+	missing := datautil.Filter(conversationIDs, func(conversationID string) (string, bool) {
+		_, ok := hasReadSeqs[conversationID]
+		return conversationID, !ok
+	})
+	if len(missing) > 0 {
+		reads, err := m.thread.FindHasReadSeqs(ctx, userID, missing)
+		if err != nil {
+			return nil, err
+		}
+		loaded := make(map[string]map[string]int64, len(missing))
+		for _, conversationID := range missing {
+			loaded[conversationID] = make(map[string]int64)
+		}
+		for _, read := range reads {
+			loaded[read.ConversationID][read.RootServerMsgID] = read.HasReadSeq
+		}
+		if err := m.seq.SetUserThreadHasReadSeqs(ctx, userID, loaded); err != nil {
+			log.ZWarn(ctx, "cache thread has read seqs failed", err, "userID", userID)
+		}
+		for conversationID, seqs := range loaded {
+			hasReadSeqs[conversationID] = seqs
+		}
+	}
+	for conversationID, seqs := range hasReadSeqs {
+		if len(seqs) == 0 {
+			delete(hasReadSeqs, conversationID)
+		}
+	}
+	return hasReadSeqs, nil
+}
+
+func (m *msgThreadDatabase) GetConversationsThreadSeqs(ctx context.Context, userID string, conversationIDs []string) (map[string]map[string]int64, map[string]map[string]int64, error) {
+	hasReadSeqs, err := m.getUserThreadHasReadSeqs(ctx, userID, conversationIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+	rootServerMsgIDs := make(map[string][]string, len(hasReadSeqs))
+	for conversationID, seqs := range hasReadSeqs {
+		rootServerMsgIDs[conversationID] = datautil.Keys(seqs)
+	}
+	maxSeqs, err := m.seq.GetConversationsThreadMaxSeqs(ctx, rootServerMsgIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+	for conversationID, ids := range rootServerMsgIDs {
+		seqs, ok := maxSeqs[conversationID]
+		if !ok {
+			seqs = make(map[string]int64)
+			maxSeqs[conversationID] = seqs
+		}
+		if err := m.fillThreadMaxSeqs(ctx, conversationID, ids, seqs); err != nil {
+			return nil, nil, err
+		}
+	}
+	return hasReadSeqs, maxSeqs, nil
+}
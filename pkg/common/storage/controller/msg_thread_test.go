@@ -0,0 +1,205 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/protocol/sdkws"
+)
+
+// memThreadSeqs caches the thread seqs of a conversation.
+type memThreadSeqs struct {
+	cache.SeqCache
+	maxSeqs     map[string]int64
+	hasReadSeqs map[string]map[string]int64
+}
+
+func (m *memThreadSeqs) AllocThreadSeq(_ context.Context, _ string, rootServerMsgID string, _ string, _ time.Duration) (int64, bool, error) {
+	seq, ok := m.maxSeqs[rootServerMsgID]
+	if !ok {
+		return 0, false, nil
+	}
+	m.maxSeqs[rootServerMsgID] = seq + 1
+	return seq + 1, true, nil
+}
+
+func (m *memThreadSeqs) InitThreadMaxSeq(_ context.Context, _ string, rootServerMsgID string, maxSeq int64) error {
+	if _, ok := m.maxSeqs[rootServerMsgID]; !ok {
+		m.maxSeqs[rootServerMsgID] = maxSeq
+	}
+	return nil
+}
+
+func (m *memThreadSeqs) GetThreadMaxSeqs(_ context.Context, _ string, rootServerMsgIDs []string) (map[string]int64, error) {
+	maxSeqs := make(map[string]int64)
+	for _, rootServerMsgID := range rootServerMsgIDs {
+		if seq, ok := m.maxSeqs[rootServerMsgID]; ok {
+			maxSeqs[rootServerMsgID] = seq
+		}
+	}
+	return maxSeqs, nil
+}
+
+func (m *memThreadSeqs) GetConversationsThreadMaxSeqs(ctx context.Context, rootServerMsgIDs map[string][]string) (map[string]map[string]int64, error) {
+	maxSeqs := make(map[string]map[string]int64)
+	for conversationID, ids := range rootServerMsgIDs {
+		maxSeqs[conversationID], _ = m.GetThreadMaxSeqs(ctx, conversationID, ids)
+	}
+	return maxSeqs, nil
+}
+
+func (m *memThreadSeqs) SetThreadHasReadSeq(_ context.Context, userID string, _ string, rootServerMsgID string, hasReadSeq int64) error {
+	if seqs, ok := m.hasReadSeqs[userID]; ok {
+		seqs[rootServerMsgID] = hasReadSeq
+	}
+	return nil
+}
+
+func (m *memThreadSeqs) GetUserThreadHasReadSeqs(_ context.Context, userID string, conversationIDs []string) (map[string]map[string]int64, error) {
+	hasReadSeqs := make(map[string]map[string]int64)
+	if seqs, ok := m.hasReadSeqs[userID]; ok {
+		hasReadSeqs[conversationIDs[0]] = seqs
+	}
+	return hasReadSeqs, nil
+}
+
+func (m *memThreadSeqs) SetUserThreadHasReadSeqs(_ context.Context, userID string, hasReadSeqs map[string]map[string]int64) error {
+	for _, seqs := range hasReadSeqs {
+		m.hasReadSeqs[userID] = seqs
+	}
+	return nil
+}
+
+type memMsgThread struct {
+	database.MsgThread
+	replies []*model.MsgThreadReply
+	reads   []*model.MsgThreadRead
+}
+
+func (m *memMsgThread) Create(_ context.Context, replies []*model.MsgThreadReply) error {
+	m.replies = append(m.replies, replies...)
+	return nil
+}
+
+func (m *memMsgThread) FindServerMsgIDs(context.Context, string, []string) ([]string, error) {
+	return nil, nil
+}
+
+func (m *memMsgThread) FindMaxThreadSeq(_ context.Context, _ string, rootServerMsgID string) (int64, error) {
+	var maxSeq int64
+	for _, reply := range m.replies {
+		if reply.RootServerMsgID == rootServerMsgID && reply.ThreadSeq > maxSeq {
+			maxSeq = reply.ThreadSeq
+		}
+	}
+	return maxSeq, nil
+}
+
+func TestBatchInsertThreadRepliesSeedsMaxSeq(t *testing.T) {
+	ctx := context.Background()
+	seqs := &memThreadSeqs{maxSeqs: make(map[string]int64)}
+	thread := &memMsgThread{}
+	db := NewMsgThreadDatabase(thread, seqs)
+	reply := func(serverMsgID string) *sdkws.MsgData {
+		return &sdkws.MsgData{ServerMsgID: serverMsgID, SendID: "u1", AttachedInfo: `{"threadRootServerMsgID":"root"}`}
+	}
+
+	if err := db.BatchInsertThreadReplies(ctx, "sg_g1", []*sdkws.MsgData{reply("r1"), reply("r2")}); err != nil {
+		t.Fatal(err)
+	}
+	// the redis counter is lost, the thread seqs go on from the indexed replies
+	delete(seqs.maxSeqs, "root")
+	if err := db.BatchInsertThreadReplies(ctx, "sg_g1", []*sdkws.MsgData{reply("r3")}); err != nil {
+		t.Fatal(err)
+	}
+	var threadSeqs []int64
+	for _, r := range thread.replies {
+		threadSeqs = append(threadSeqs, r.ThreadSeq)
+	}
+	if len(threadSeqs) != 3 || threadSeqs[0] != 1 || threadSeqs[1] != 2 || threadSeqs[2] != 3 {
+		t.Fatalf("thread seqs %v, want [1 2 3]", threadSeqs)
+	}
+}
+
+func (m *memMsgThread) SetHasReadSeq(_ context.Context, userID string, conversationID string, rootServerMsgID string, hasReadSeq int64) error {
+	for _, read := range m.reads {
+		if read.UserID == userID && read.RootServerMsgID == rootServerMsgID {
+			read.HasReadSeq = max(read.HasReadSeq, hasReadSeq)
+			return nil
+		}
+	}
+	m.reads = append(m.reads, &model.MsgThreadRead{UserID: userID, ConversationID: conversationID, RootServerMsgID: rootServerMsgID, HasReadSeq: hasReadSeq})
+	return nil
+}
+
+func (m *memMsgThread) FindHasReadSeqs(_ context.Context, userID string, _ []string) ([]*model.MsgThreadRead, error) {
+	var reads []*model.MsgThreadRead
+	for _, read := range m.reads {
+		if read.UserID == userID {
+			reads = append(reads, read)
+		}
+	}
+	return reads, nil
+}
+
+func TestThreadSeqsLoadedFromMongo(t *testing.T) {
+	ctx := context.Background()
+	seqs := &memThreadSeqs{maxSeqs: make(map[string]int64), hasReadSeqs: make(map[string]map[string]int64)}
+	thread := &memMsgThread{}
+	db := NewMsgThreadDatabase(thread, seqs)
+	msgs := []*sdkws.MsgData{
+		{ServerMsgID: "r1", SendID: "u1", AttachedInfo: `{"threadRootServerMsgID":"root"}`},
+		{ServerMsgID: "r2", SendID: "u2", AttachedInfo: `{"threadRootServerMsgID":"root"}`},
+	}
+	if err := db.BatchInsertThreadReplies(ctx, "sg_g1", msgs); err != nil {
+		t.Fatal(err)
+	}
+	// redis loses the max seqs and the followed threads
+	seqs.maxSeqs = make(map[string]int64)
+	seqs.hasReadSeqs = make(map[string]map[string]int64)
+
+	maxSeqs, err := db.GetThreadMaxSeqs(ctx, "sg_g1", []string{"root", "none"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(maxSeqs) != 1 || maxSeqs["root"] != 2 {
+		t.Fatalf("max seqs %v, want the thread without a reply missing", maxSeqs)
+	}
+	seqs.maxSeqs = make(map[string]int64)
+	hasReadSeqs, maxConvSeqs, err := db.GetConversationsThreadSeqs(ctx, "u1", []string{"sg_g1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hasReadSeqs["sg_g1"]["root"] != 1 || maxConvSeqs["sg_g1"]["root"] != 2 {
+		t.Fatalf("has read seqs %v, max seqs %v", hasReadSeqs, maxConvSeqs)
+	}
+	// the loaded has read seqs are cached
+	if seqs.hasReadSeqs["u1"]["root"] != 1 || seqs.maxSeqs["root"] != 2 {
+		t.Fatalf("cached has read seqs %v, max seqs %v", seqs.hasReadSeqs, seqs.maxSeqs)
+	}
+	hasReadSeqs, _, err = db.GetConversationsThreadSeqs(ctx, "u3", []string{"sg_g1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(hasReadSeqs) != 0 {
+		t.Fatalf("has read seqs %v of the user following no thread", hasReadSeqs)
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/idutil"
+)
+
+const (
+	pinnedMsgsLockRetry   = 8
+	pinnedMsgsLockWait    = time.Millisecond * 20
+	pinnedMsgsLockMaxWait = time.Millisecond * 200
+)
+
+type PinnedMsgDatabase interface {
+	// PinMsg pins the msg unless the conversation has maxPinned pinned msgs, it returns false if the msg has been pinned.
+	// The pins of a conversation are serialized so that concurrent pins can not exceed maxPinned.
+	PinMsg(ctx context.Context, pin *model.PinnedMsg, maxPinned int64) (bool, error)
+	// UnpinMsgs unpins the msgs, it returns the number of the unpinned msgs.
+	UnpinMsgs(ctx context.Context, conversationID string, seqs []int64) (int64, error)
+	// GetPinnedMsgs returns the cached pins of each conversation, the latest pinned first.
+	GetPinnedMsgs(ctx context.Context, conversationIDs []string) (map[string][]*model.PinnedMsg, error)
+}
+
+func NewPinnedMsgDatabase(pinnedMsg database.PinnedMsg, cache cache.PinnedMsgCache, msgCache cache.MsgCache) PinnedMsgDatabase {
+	return &pinnedMsgDatabase{pinnedMsg: pinnedMsg, cache: cache, msgCache: msgCache}
+}
+
+type pinnedMsgDatabase struct {
+	pinnedMsg database.PinnedMsg
+	cache     cache.PinnedMsgCache
+	msgCache  cache.MsgCache
+}
+
+// lockPinnedMsgs waits for the pins of the conversation being run by the others, it returns ErrMsgBusy
+// if they are not done after the retries.
+func (p *pinnedMsgDatabase) lockPinnedMsgs(ctx context.Context, conversationID string, owner string) error {
+	wait := pinnedMsgsLockWait
+	for i := 0; ; i++ {
+		locked, err := p.msgCache.LockPinnedMsgs(ctx, conversationID, owner)
+		if err != nil {
+			return err
+		}
+		if locked {
+			return nil
+		}
+		if i >= pinnedMsgsLockRetry {
+			return servererrs.ErrMsgBusy.WrapMsg("pinned msgs are being updated", "conversationID", conversationID)
+		}
+		select {
+		case <-ctx.Done():
+			return errs.Wrap(ctx.Err())
+		case <-time.After(wait):
+		}
+		wait = min(wait*2, pinnedMsgsLockMaxWait)
+	}
+}
+
+func (p *pinnedMsgDatabase) PinMsg(ctx context.Context, pin *model.PinnedMsg, maxPinned int64) (bool, error) {
+	owner := idutil.OperationIDGenerator()
+	if err := p.lockPinnedMsgs(ctx, pin.ConversationID, owner); err != nil {
+		return false, err
+	}
+	defer func() {
+		if err := p.msgCache.UnLockPinnedMsgs(ctx, pin.ConversationID, owner); err != nil {
+			log.ZWarn(ctx, "UnLockPinnedMsgs failed", err, "conversationID", pin.ConversationID)
+		}
+	}()
+	pins, err := p.pinnedMsg.Find(ctx, []string{pin.ConversationID})
+	if err != nil {
+		return false, err
+	}
+	// pinning a pinned msg again is not limited
+	for _, pinned := range pins {
+		if pinned.Seq == pin.Seq {
+			return false, nil
+		}
+	}
+	if int64(len(pins)) >= maxPinned {
+		return false, errs.ErrArgs.WrapMsg("too many pinned msgs of the conversation", "max", maxPinned)
+	}
+	pinned, err := p.pinnedMsg.Create(ctx, pin)
+	if err != nil || !pinned {
+		return pinned, err
+	}
+	return true, p.cache.DelPinnedMsgs(pin.ConversationID).ChainExecDel(ctx)
+}
+
+func (p *pinnedMsgDatabase) UnpinMsgs(ctx context.Context, conversationID string, seqs []int64) (int64, error) {
+	n, err := p.pinnedMsg.Delete(ctx, conversationID, seqs)
+	if err != nil || n == 0 {
+		return n, err
+	}
+	return n, p.cache.DelPinnedMsgs(conversationID).ChainExecDel(ctx)
+}
+
+func (p *pinnedMsgDatabase) GetPinnedMsgs(ctx context.Context, conversationIDs []string) (map[string][]*model.PinnedMsg, error) {
+	pins, err := p.cache.GetPinnedMsgs(ctx, conversationIDs)
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[string][]*model.PinnedMsg)
+	for _, pin := range pins {
+		res[pin.ConversationID] = append(res[pin.ConversationID], pin)
+	}
+	return res, nil
+}
@@ -0,0 +1,245 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/errs"
+)
+
+type memPinnedMsgs struct {
+	pins  []*model.PinnedMsg
+	finds int
+}
+
+func (m *memPinnedMsgs) Create(_ context.Context, pin *model.PinnedMsg) (bool, error) {
+	for _, p := range m.pins {
+		if p.ConversationID == pin.ConversationID && p.Seq == pin.Seq {
+			return false, nil
+		}
+	}
+	m.pins = append([]*model.PinnedMsg{pin}, m.pins...)
+	return true, nil
+}
+
+func (m *memPinnedMsgs) Delete(_ context.Context, conversationID string, seqs []int64) (int64, error) {
+	var (
+		n    int64
+		pins []*model.PinnedMsg
+	)
+	for _, p := range m.pins {
+		deleted := false
+		for _, seq := range seqs {
+			if p.ConversationID == conversationID && p.Seq == seq {
+				deleted = true
+			}
+		}
+		if deleted {
+			n++
+		} else {
+			pins = append(pins, p)
+		}
+	}
+	m.pins = pins
+	return n, nil
+}
+
+func (m *memPinnedMsgs) Count(_ context.Context, conversationID string) (int64, error) {
+	var n int64
+	for _, p := range m.pins {
+		if p.ConversationID == conversationID {
+			n++
+		}
+	}
+	return n, nil
+}
+
+func (m *memPinnedMsgs) Find(_ context.Context, conversationIDs []string) ([]*model.PinnedMsg, error) {
+	m.finds++
+	var pins []*model.PinnedMsg
+	for _, p := range m.pins {
+		for _, conversationID := range conversationIDs {
+			if p.ConversationID == conversationID {
+				pins = append(pins, p)
+			}
+		}
+	}
+	return pins, nil
+}
+
+// memPinnedMsgCache caches the pins of each conversation until they are deleted.
+type memPinnedMsgCache struct {
+	cache.BatchDeleter
+	db     *memPinnedMsgs
+	cached map[string][]*model.PinnedMsg
+	keys   []string
+}
+
+func (c *memPinnedMsgCache) ChainExecDel(context.Context) error {
+	for _, conversationID := range c.keys {
+		delete(c.cached, conversationID)
+	}
+	c.keys = nil
+	return nil
+}
+
+func (c *memPinnedMsgCache) ClonePinnedMsgCache() cache.PinnedMsgCache {
+	return c
+}
+
+func (c *memPinnedMsgCache) GetPinnedMsgs(ctx context.Context, conversationIDs []string) ([]*model.PinnedMsg, error) {
+	var res []*model.PinnedMsg
+	for _, conversationID := range conversationIDs {
+		pins, ok := c.cached[conversationID]
+		if !ok {
+			var err error
+			if pins, err = c.db.Find(ctx, []string{conversationID}); err != nil {
+				return nil, err
+			}
+			c.cached[conversationID] = pins
+		}
+		res = append(res, pins...)
+	}
+	return res, nil
+}
+
+func (c *memPinnedMsgCache) DelPinnedMsgs(conversationIDs ...string) cache.PinnedMsgCache {
+	c.keys = append(c.keys, conversationIDs...)
+	return c
+}
+
+type pinnedMsgsLockCache struct {
+	cache.MsgCache
+	locked map[string]string
+	// releaseAfter unlocks the lock of another owner after the number of the lock attempts
+	releaseAfter int
+	attempts     int
+}
+
+func (c *pinnedMsgsLockCache) LockPinnedMsgs(_ context.Context, conversationID string, owner string) (bool, error) {
+	c.attempts++
+	if _, ok := c.locked[conversationID]; ok {
+		if c.releaseAfter == 0 || c.attempts < c.releaseAfter {
+			return false, nil
+		}
+	}
+	c.locked[conversationID] = owner
+	return true, nil
+}
+
+func (c *pinnedMsgsLockCache) UnLockPinnedMsgs(_ context.Context, conversationID string, owner string) error {
+	if c.locked[conversationID] == owner {
+		delete(c.locked, conversationID)
+	}
+	return nil
+}
+
+func TestPinMsg(t *testing.T) {
+	ctx := context.Background()
+	db := &memPinnedMsgs{}
+	pinCache := &memPinnedMsgCache{db: db, cached: make(map[string][]*model.PinnedMsg)}
+	lock := &pinnedMsgsLockCache{locked: make(map[string]string)}
+	p := NewPinnedMsgDatabase(db, pinCache, lock)
+	pin := func(conversationID string, seq int64) (bool, error) {
+		return p.PinMsg(ctx, &model.PinnedMsg{ConversationID: conversationID, Seq: seq, PinTime: time.Now()}, 2)
+	}
+	pinnedSeqs := func(conversationID string) []int64 {
+		t.Helper()
+		pins, err := p.GetPinnedMsgs(ctx, []string{conversationID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var seqs []int64
+		for _, pin := range pins[conversationID] {
+			seqs = append(seqs, pin.Seq)
+		}
+		return seqs
+	}
+
+	if seqs := pinnedSeqs("si_a_b"); len(seqs) != 0 {
+		t.Fatalf("pinned %v before any pin", seqs)
+	}
+	if ok, err := pin("si_a_b", 1); err != nil || !ok {
+		t.Fatalf("pin 1: %v, %v", ok, err)
+	}
+	// the pin is seen at once although the empty pins were cached
+	if seqs := pinnedSeqs("si_a_b"); len(seqs) != 1 || seqs[0] != 1 {
+		t.Fatalf("pinned %v, want [1]", seqs)
+	}
+	if ok, err := pin("si_a_b", 1); err != nil || ok {
+		t.Fatalf("pin 1 again: %v, %v", ok, err)
+	}
+	if ok, err := pin("si_a_b", 2); err != nil || !ok {
+		t.Fatalf("pin 2: %v, %v", ok, err)
+	}
+
+	// limit
+	if _, err := pin("si_a_b", 3); !errs.ErrArgs.Is(err) {
+		t.Fatalf("pin beyond the limit: %v", err)
+	}
+	if ok, err := pin("si_a_b", 1); err != nil || ok {
+		t.Fatalf("pin 1 again at the limit: %v, %v", ok, err)
+	}
+	if ok, err := pin("si_a_c", 3); err != nil || !ok {
+		t.Fatalf("the limit of another conversation: %v, %v", ok, err)
+	}
+	if len(lock.locked) != 0 {
+		t.Fatalf("pinned msgs left locked: %v", lock.locked)
+	}
+	// the pin waits for the lock of another pin and fails retryably if it is not released
+	lock.locked["si_a_b"] = "other"
+	lock.attempts = 0
+	if _, err := pin("si_a_b", 4); !servererrs.ErrMsgBusy.Is(err) {
+		t.Fatalf("pin while locked: %v", err)
+	}
+	if lock.attempts != pinnedMsgsLockRetry+1 || lock.locked["si_a_b"] != "other" {
+		t.Fatalf("lock attempted %d times, the lock of another owner is %q", lock.attempts, lock.locked["si_a_b"])
+	}
+	lock.attempts, lock.releaseAfter = 0, 3
+	if _, err := pin("si_a_b", 4); !errs.ErrArgs.Is(err) {
+		t.Fatalf("pin after the lock is released: %v", err)
+	}
+	if lock.attempts != 3 || len(lock.locked) != 0 {
+		t.Fatalf("lock attempted %d times, left locked %v", lock.attempts, lock.locked)
+	}
+	lock.releaseAfter = 0
+
+	// the cached pins are read without mongo
+	if seqs := pinnedSeqs("si_a_b"); len(seqs) != 2 || seqs[0] != 2 || seqs[1] != 1 {
+		t.Fatalf("pinned %v, want [2 1]", seqs)
+	}
+	finds := db.finds
+	pinnedSeqs("si_a_b")
+	if db.finds != finds {
+		t.Fatal("the cached pins are read from mongo")
+	}
+
+	// unpin
+	if n, err := p.UnpinMsgs(ctx, "si_a_b", []int64{2, 5}); err != nil || n != 1 {
+		t.Fatalf("unpin: %d, %v", n, err)
+	}
+	if seqs := pinnedSeqs("si_a_b"); len(seqs) != 1 || seqs[0] != 1 {
+		t.Fatalf("pinned %v after unpin, want [1]", seqs)
+	}
+	if ok, err := pin("si_a_b", 3); err != nil || !ok {
+		t.Fatalf("pin after unpin: %v, %v", ok, err)
+	}
+}
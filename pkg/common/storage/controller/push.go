@@ -22,6 +22,8 @@ import (
 
 type PushDatabase interface {
 	DelFcmToken(ctx context.Context, userID string, platformID int) error
+	// DelDeviceTokens deletes the device tokens of the platform registered for the push routing.
+	DelDeviceTokens(ctx context.Context, userID string, platformID int) error
 }
 
 type pushDataBase struct {
@@ -35,3 +37,7 @@ func NewPushDatabase(cache cache.ThirdCache) PushDatabase {
 func (p *pushDataBase) DelFcmToken(ctx context.Context, userID string, platformID int) error {
 	return p.cache.DelFcmToken(ctx, userID, platformID)
 }
+
+func (p *pushDataBase) DelDeviceTokens(ctx context.Context, userID string, platformID int) error {
+	return p.cache.DelPlatformDeviceTokens(ctx, userID, platformID)
+}
@@ -0,0 +1,102 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/pagination"
+)
+
+// PushRetryDatabase keeps the failed offline pushes, they are retried from a delayed queue in redis
+// and end in the dead letters in mongodb after failing the max attempts.
+type PushRetryDatabase interface {
+	// SchedulePushRetry adds the task to the retry queue, or reschedules it if it is already in the queue.
+	SchedulePushRetry(ctx context.Context, task *model.OfflinePushTask, dueTime time.Time) error
+	// ClaimDuePushRetries claims at most count due tasks, a task claimed but neither rescheduled nor finished
+	// within lease is considered abandoned and can be claimed again.
+	ClaimDuePushRetries(ctx context.Context, count int, lease time.Duration) ([]*model.OfflinePushTask, error)
+	// FinishPushRetry removes the task from the retry queue.
+	FinishPushRetry(ctx context.Context, taskID string) error
+	// KillPushRetry moves the task from the retry queue to the dead letters.
+	KillPushRetry(ctx context.Context, task *model.OfflinePushTask) error
+	SearchPushDeadLetters(ctx context.Context, userID string, pagination pagination.Pagination) (int64, []*model.OfflinePushTask, error)
+	// ReplayPushDeadLetters moves the dead letters back to the retry queue to be retried at once
+	// with the attempts reset, it returns the dead letters found.
+	ReplayPushDeadLetters(ctx context.Context, taskIDs []string) ([]*model.OfflinePushTask, error)
+}
+
+func NewPushRetryDatabase(cache cache.PushRetryCache, deadLetter database.OfflinePushDeadLetter) PushRetryDatabase {
+	return &pushRetryDatabase{cache: cache, deadLetter: deadLetter}
+}
+
+type pushRetryDatabase struct {
+	cache      cache.PushRetryCache
+	deadLetter database.OfflinePushDeadLetter
+}
+
+func (p *pushRetryDatabase) SchedulePushRetry(ctx context.Context, task *model.OfflinePushTask, dueTime time.Time) error {
+	return p.cache.AddPushRetryTask(ctx, task, dueTime)
+}
+
+func (p *pushRetryDatabase) ClaimDuePushRetries(ctx context.Context, count int, lease time.Duration) ([]*model.OfflinePushTask, error) {
+	return p.cache.ClaimDuePushRetryTasks(ctx, count, lease)
+}
+
+func (p *pushRetryDatabase) FinishPushRetry(ctx context.Context, taskID string) error {
+	return p.cache.DelPushRetryTask(ctx, taskID)
+}
+
+func (p *pushRetryDatabase) KillPushRetry(ctx context.Context, task *model.OfflinePushTask) error {
+	// the dead letter is saved first, a task claimed again after a failure in between replaces it
+	if err := p.deadLetter.Save(ctx, task); err != nil {
+		return err
+	}
+	return p.cache.DelPushRetryTask(ctx, task.TaskID)
+}
+
+func (p *pushRetryDatabase) SearchPushDeadLetters(ctx context.Context, userID string, pagination pagination.Pagination) (int64, []*model.OfflinePushTask, error) {
+	return p.deadLetter.Search(ctx, userID, pagination)
+}
+
+func (p *pushRetryDatabase) ReplayPushDeadLetters(ctx context.Context, taskIDs []string) ([]*model.OfflinePushTask, error) {
+	tasks, err := p.deadLetter.Find(ctx, taskIDs)
+	if err != nil {
+		return nil, err
+	}
+	if len(tasks) == 0 {
+		return nil, nil
+	}
+	now := time.Now()
+	replayed := make([]string, 0, len(tasks))
+	for _, task := range tasks {
+		task.Attempts = 0
+		task.ErrMsg = ""
+		task.DeadTime = time.Time{}
+		// the task is in the queue before its dead letter is deleted, so that it is not lost
+		if err := p.cache.AddPushRetryTask(ctx, task, now); err != nil {
+			return nil, err
+		}
+		replayed = append(replayed, task.TaskID)
+	}
+	if err := p.deadLetter.Delete(ctx, replayed); err != nil {
+		return nil, err
+	}
+	return tasks, nil
+}
@@ -0,0 +1,122 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database/mgo"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgext"
+	"github.com/openimsdk/tools/db/pagination"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+)
+
+// ScheduledMsgDatabase stores the msgs to be sent later. A due msg is claimed before sending,
+// so that it is claimed by only one of the crontask replicas. A claim timed out before the msg started
+// sending is claimed again, a claim timed out after it without result is marked as unknown for manual
+// review as the msg may have been sent.
+type ScheduledMsgDatabase interface {
+	CreateScheduledMsg(ctx context.Context, msg *model.ScheduledMsg) error
+	TakeScheduledMsg(ctx context.Context, scheduleID string) (*model.ScheduledMsg, error)
+	// CancelScheduledMsg cancels a scheduled msg which is still pending.
+	CancelScheduledMsg(ctx context.Context, scheduleID string) error
+	SearchScheduledMsgs(ctx context.Context, sendID string, status int32, pagination pagination.Pagination) (int64, []*model.ScheduledMsg, error)
+	// ClaimDueScheduledMsgs claims at most count due msgs. The msgs claimed longer than claimTimeout ago
+	// (e.g. the claimer restarted) are claimed again if they have not started sending, otherwise they
+	// are marked as unknown.
+	ClaimDueScheduledMsgs(ctx context.Context, claimID string, count int, claimTimeout time.Duration) ([]*model.ScheduledMsg, error)
+	// StartSendingScheduledMsg marks the claimed msg as started sending and returns it, it must be called
+	// right before sending. It fails with errs.ErrRecordNotFound if the claim has been released.
+	StartSendingScheduledMsg(ctx context.Context, scheduleID string, claimID string) (*model.ScheduledMsg, error)
+	// SetScheduledMsgResult finishes a claimed msg, the msg failed to send if errMsg is not empty.
+	SetScheduledMsgResult(ctx context.Context, scheduleID string, claimID string, serverMsgID string, errMsg string) error
+}
+
+func NewScheduledMsgDatabase(scheduledMsg database.ScheduledMsg) ScheduledMsgDatabase {
+	return &scheduledMsgDatabase{scheduledMsg: scheduledMsg}
+}
+
+type scheduledMsgDatabase struct {
+	scheduledMsg database.ScheduledMsg
+}
+
+func (s *scheduledMsgDatabase) CreateScheduledMsg(ctx context.Context, msg *model.ScheduledMsg) error {
+	return s.scheduledMsg.Create(ctx, []*model.ScheduledMsg{msg})
+}
+
+func (s *scheduledMsgDatabase) TakeScheduledMsg(ctx context.Context, scheduleID string) (*model.ScheduledMsg, error) {
+	return s.scheduledMsg.Take(ctx, scheduleID)
+}
+
+func (s *scheduledMsgDatabase) CancelScheduledMsg(ctx context.Context, scheduleID string) error {
+	return s.scheduledMsg.UpdateStatus(ctx, scheduleID, msgext.ScheduledMsgPending, msgext.ScheduledMsgCanceled)
+}
+
+func (s *scheduledMsgDatabase) SearchScheduledMsgs(ctx context.Context, sendID string, status int32, pagination pagination.Pagination) (int64, []*model.ScheduledMsg, error) {
+	return s.scheduledMsg.Search(ctx, sendID, status, pagination)
+}
+
+func (s *scheduledMsgDatabase) ClaimDueScheduledMsgs(ctx context.Context, claimID string, count int, claimTimeout time.Duration) ([]*model.ScheduledMsg, error) {
+	expire := time.Now().Add(-claimTimeout)
+	n, err := s.scheduledMsg.ReleaseClaims(ctx, expire)
+	if err != nil {
+		return nil, err
+	}
+	if n > 0 {
+		log.ZWarn(ctx, "scheduled msgs claim timeout before sending, claim again", nil, "count", n)
+	}
+	n, err = s.scheduledMsg.ExpireClaims(ctx, expire, "claim timeout, the msg may have been sent")
+	if err != nil {
+		return nil, err
+	}
+	if n > 0 {
+		log.ZWarn(ctx, "scheduled msgs claim timeout, marked as unknown", nil, "count", n)
+	}
+	var msgs []*model.ScheduledMsg
+	for len(msgs) < count {
+		msg, err := s.scheduledMsg.Claim(ctx, claimID, time.Now())
+		if err != nil {
+			if mgo.IsNotFound(err) {
+				break
+			}
+			return nil, err
+		}
+		msgs = append(msgs, msg)
+	}
+	return msgs, nil
+}
+
+func (s *scheduledMsgDatabase) StartSendingScheduledMsg(ctx context.Context, scheduleID string, claimID string) (*model.ScheduledMsg, error) {
+	msg, err := s.scheduledMsg.StartSending(ctx, scheduleID, claimID, time.Now())
+	if err != nil {
+		if mgo.IsNotFound(err) {
+			return nil, errs.ErrRecordNotFound.WrapMsg("scheduled msg claim not found", "scheduleID", scheduleID, "claimID", claimID)
+		}
+		return nil, err
+	}
+	return msg, nil
+}
+
+func (s *scheduledMsgDatabase) SetScheduledMsgResult(ctx context.Context, scheduleID string, claimID string, serverMsgID string, errMsg string) error {
+	status := int32(msgext.ScheduledMsgSent)
+	if errMsg != "" {
+		status = msgext.ScheduledMsgFailed
+	}
+	return s.scheduledMsg.SetResult(ctx, scheduleID, claimID, status, serverMsgID, errMsg)
+}
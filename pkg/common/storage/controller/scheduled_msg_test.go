@@ -0,0 +1,120 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgext"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// memScheduledMsgs keeps the scheduled msgs in memory with the filters of the mongo implementation.
+type memScheduledMsgs struct {
+	database.ScheduledMsg
+	msgs []*model.ScheduledMsg
+}
+
+func (m *memScheduledMsgs) Claim(_ context.Context, claimID string, now time.Time) (*model.ScheduledMsg, error) {
+	for _, msg := range m.msgs {
+		if msg.Status == msgext.ScheduledMsgPending && !msg.SendTime.After(now) {
+			msg.Status, msg.ClaimID, msg.ClaimTime, msg.SendingTime = msgext.ScheduledMsgSending, claimID, now, time.Time{}
+			return msg, nil
+		}
+	}
+	return nil, errs.Wrap(mongo.ErrNoDocuments)
+}
+
+func (m *memScheduledMsgs) StartSending(_ context.Context, scheduleID string, claimID string, now time.Time) (*model.ScheduledMsg, error) {
+	for _, msg := range m.msgs {
+		if msg.ScheduleID == scheduleID && msg.ClaimID == claimID && msg.Status == msgext.ScheduledMsgSending && msg.SendingTime.IsZero() {
+			msg.SendingTime = now
+			return msg, nil
+		}
+	}
+	return nil, errs.Wrap(mongo.ErrNoDocuments)
+}
+
+func (m *memScheduledMsgs) ReleaseClaims(_ context.Context, expire time.Time) (int64, error) {
+	var n int64
+	for _, msg := range m.msgs {
+		if msg.Status == msgext.ScheduledMsgSending && msg.ClaimTime.Before(expire) && msg.SendingTime.IsZero() {
+			msg.Status, msg.ClaimID = msgext.ScheduledMsgPending, ""
+			n++
+		}
+	}
+	return n, nil
+}
+
+func (m *memScheduledMsgs) ExpireClaims(_ context.Context, expire time.Time, errMsg string) (int64, error) {
+	var n int64
+	for _, msg := range m.msgs {
+		if msg.Status == msgext.ScheduledMsgSending && msg.ClaimTime.Before(expire) && !msg.SendingTime.IsZero() {
+			msg.Status, msg.ErrMsg = msgext.ScheduledMsgUnknown, errMsg
+			n++
+		}
+	}
+	return n, nil
+}
+
+func TestClaimDueScheduledMsgs(t *testing.T) {
+	now := time.Now()
+	db := &memScheduledMsgs{msgs: []*model.ScheduledMsg{
+		{ScheduleID: "due1", SendTime: now.Add(-time.Minute), Status: msgext.ScheduledMsgPending},
+		{ScheduleID: "due2", SendTime: now.Add(-time.Second), Status: msgext.ScheduledMsgPending},
+		{ScheduleID: "later", SendTime: now.Add(time.Hour), Status: msgext.ScheduledMsgPending},
+	}}
+	s := NewScheduledMsgDatabase(db)
+	ctx := context.Background()
+
+	msgs, err := s.ClaimDueScheduledMsgs(ctx, "claim1", 1, time.Minute)
+	if err != nil || len(msgs) != 1 || msgs[0].ScheduleID != "due1" {
+		t.Fatalf("claim1 %v %v, want due1", msgs, err)
+	}
+	if _, err := s.StartSendingScheduledMsg(ctx, "due1", "claim1"); err != nil {
+		t.Fatal(err)
+	}
+	msgs, err = s.ClaimDueScheduledMsgs(ctx, "claim2", 10, time.Minute)
+	if err != nil || len(msgs) != 1 || msgs[0].ScheduleID != "due2" {
+		t.Fatalf("claim2 %v %v, want due2", msgs, err)
+	}
+
+	// both claimers died, due1 had started sending and due2 had not
+	for _, msg := range db.msgs {
+		msg.ClaimTime = msg.ClaimTime.Add(-2 * time.Minute)
+	}
+	msgs, err = s.ClaimDueScheduledMsgs(ctx, "claim3", 10, time.Minute)
+	if err != nil || len(msgs) != 1 || msgs[0].ScheduleID != "due2" || msgs[0].ClaimID != "claim3" {
+		t.Fatalf("claim3 %v %v, want due2 claimed again", msgs, err)
+	}
+	if db.msgs[0].Status != msgext.ScheduledMsgUnknown {
+		t.Fatalf("due1 status %d, want unknown", db.msgs[0].Status)
+	}
+	// the stale claimer of due2 must not send it
+	if _, err := s.StartSendingScheduledMsg(ctx, "due2", "claim2"); !errs.ErrRecordNotFound.Is(err) {
+		t.Fatalf("start sending by the stale claim: %v", err)
+	}
+	if _, err := s.StartSendingScheduledMsg(ctx, "due2", "claim3"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.StartSendingScheduledMsg(ctx, "due2", "claim3"); !errs.ErrRecordNotFound.Is(err) {
+		t.Fatalf("start sending twice: %v", err)
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/pagination"
+)
+
+// SensitiveWordDatabase stores the dictionary of the sensitive-word filter. The changes of the words take effect
+// after ReloadSensitiveWords, which changes the version, so that every msg rpc replica rebuilds its filter.
+type SensitiveWordDatabase interface {
+	AddSensitiveWords(ctx context.Context, words []string) error
+	DeleteSensitiveWords(ctx context.Context, words []string) error
+	SearchSensitiveWords(ctx context.Context, keyword string, pagination pagination.Pagination) (int64, []*model.SensitiveWord, error)
+	GetAllSensitiveWords(ctx context.Context) ([]string, error)
+	ReloadSensitiveWords(ctx context.Context) error
+	GetSensitiveWordsVersion(ctx context.Context) (int64, error)
+}
+
+func NewSensitiveWordDatabase(sensitiveWord database.SensitiveWord, cache cache.SensitiveWordCache) SensitiveWordDatabase {
+	return &sensitiveWordDatabase{sensitiveWord: sensitiveWord, cache: cache}
+}
+
+type sensitiveWordDatabase struct {
+	sensitiveWord database.SensitiveWord
+	cache         cache.SensitiveWordCache
+}
+
+func (s *sensitiveWordDatabase) AddSensitiveWords(ctx context.Context, words []string) error {
+	now := time.Now()
+	sensitiveWords := make([]*model.SensitiveWord, 0, len(words))
+	for _, word := range words {
+		sensitiveWords = append(sensitiveWords, &model.SensitiveWord{Word: word, CreateTime: now})
+	}
+	return s.sensitiveWord.Create(ctx, sensitiveWords)
+}
+
+func (s *sensitiveWordDatabase) DeleteSensitiveWords(ctx context.Context, words []string) error {
+	return s.sensitiveWord.Delete(ctx, words)
+}
+
+func (s *sensitiveWordDatabase) SearchSensitiveWords(ctx context.Context, keyword string, pagination pagination.Pagination) (int64, []*model.SensitiveWord, error) {
+	return s.sensitiveWord.Search(ctx, keyword, pagination)
+}
+
+func (s *sensitiveWordDatabase) GetAllSensitiveWords(ctx context.Context) ([]string, error) {
+	return s.sensitiveWord.FindAll(ctx)
+}
+
+func (s *sensitiveWordDatabase) ReloadSensitiveWords(ctx context.Context) error {
+	_, err := s.cache.IncrVersion(ctx)
+	return err
+}
+
+func (s *sensitiveWordDatabase) GetSensitiveWordsVersion(ctx context.Context) (int64, error) {
+	return s.cache.GetVersion(ctx)
+}
@@ -27,6 +27,10 @@ import (
 type ThirdDatabase interface {
 	FcmUpdateToken(ctx context.Context, account string, platformID int, fcmToken string, expireTime int64) error
 	SetAppBadge(ctx context.Context, userID string, value int) error
+	// UpdateDeviceToken registers the push token of the device for the vendor in the registry of the push routing.
+	UpdateDeviceToken(ctx context.Context, userID string, platformID int, vendor string, token string, expireTime int64) error
+	DeleteDeviceToken(ctx context.Context, userID string, platformID int, vendor string) error
+	GetDeviceTokens(ctx context.Context, userID string) ([]*model.DeviceToken, error)
 	// about log for debug
 	UploadLogs(ctx context.Context, logs []*model.Log) error
 	DeleteLogs(ctx context.Context, logID []string, userID string) error
@@ -70,3 +74,15 @@ func (t *thirdDatabase) FcmUpdateToken(ctx context.Context, account string, plat
 func (t *thirdDatabase) SetAppBadge(ctx context.Context, userID string, value int) error {
 	return t.cache.SetUserBadgeUnreadCountSum(ctx, userID, value)
 }
+
+func (t *thirdDatabase) UpdateDeviceToken(ctx context.Context, userID string, platformID int, vendor string, token string, expireTime int64) error {
+	return t.cache.SetDeviceToken(ctx, userID, platformID, vendor, token, expireTime)
+}
+
+func (t *thirdDatabase) DeleteDeviceToken(ctx context.Context, userID string, platformID int, vendor string) error {
+	return t.cache.DelDeviceToken(ctx, userID, platformID, vendor)
+}
+
+func (t *thirdDatabase) GetDeviceTokens(ctx context.Context, userID string) ([]*model.DeviceToken, error) {
+	return t.cache.GetDeviceTokens(ctx, userID)
+}
@@ -0,0 +1,273 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bleveindex implements the search indexes with bleve, the index files are stored on the local disk,
+// so an index can only be opened by one process at a time.
+package bleveindex
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/blevesearch/bleve/v2"
+	"github.com/blevesearch/bleve/v2/analysis/analyzer/keyword"
+	"github.com/blevesearch/bleve/v2/analysis/lang/cjk"
+	"github.com/blevesearch/bleve/v2/mapping"
+	"github.com/blevesearch/bleve/v2/search/query"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/pagination"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/utils/idutil"
+)
+
+// msgSearchDoc is the document of a msg in the index, its id is conversationID:seq.
+type msgSearchDoc struct {
+	ConversationID string  `json:"conversation_id"`
+	SendID         string  `json:"send_id"`
+	RecvID         string  `json:"recv_id"`
+	SessionType    float64 `json:"session_type"`
+	ContentType    float64 `json:"content_type"`
+	SendTime       float64 `json:"send_time"`
+	Text           string  `json:"text"`
+}
+
+// NewMessageSearchIndex opens the msg index in dir, the index is created if it does not exist.
+func NewMessageSearchIndex(dir string) (database.MessageSearchIndex, error) {
+	index, err := bleve.Open(dir)
+	if err == nil {
+		return &MsgSearchBleve{index: index}, nil
+	}
+	if err != bleve.ErrorIndexPathDoesNotExist {
+		return nil, errs.WrapMsg(err, "open msg search index failed", "dir", dir)
+	}
+	index, err = bleve.New(dir, newMsgSearchMapping())
+	if err != nil {
+		return nil, errs.WrapMsg(err, "create msg search index failed", "dir", dir)
+	}
+	return &MsgSearchBleve{index: index}, nil
+}
+
+func newMsgSearchMapping() *mapping.IndexMappingImpl {
+	keywordField := bleve.NewKeywordFieldMapping()
+	keywordField.Store = false
+	keywordField.IncludeInAll = false
+	numericField := bleve.NewNumericFieldMapping()
+	numericField.Store = false
+	numericField.IncludeInAll = false
+	// cjk indexes chinese, japanese and korean text as bigrams and other text as words
+	textField := bleve.NewTextFieldMapping()
+	textField.Analyzer = cjk.AnalyzerName
+	textField.Store = false
+	textField.IncludeInAll = false
+
+	doc := bleve.NewDocumentStaticMapping()
+	doc.AddFieldMappingsAt("conversation_id", keywordField)
+	doc.AddFieldMappingsAt("send_id", keywordField)
+	doc.AddFieldMappingsAt("recv_id", keywordField)
+	doc.AddFieldMappingsAt("session_type", numericField)
+	doc.AddFieldMappingsAt("content_type", numericField)
+	doc.AddFieldMappingsAt("send_time", numericField)
+	doc.AddFieldMappingsAt("text", textField)
+
+	indexMapping := bleve.NewIndexMapping()
+	indexMapping.DefaultMapping = doc
+	indexMapping.DefaultAnalyzer = keyword.Name
+	return indexMapping
+}
+
+type MsgSearchBleve struct {
+	index bleve.Index
+}
+
+func (m *MsgSearchBleve) docID(conversationID string, seq int64) string {
+	return conversationID + ":" + strconv.FormatInt(seq, 10)
+}
+
+func (m *MsgSearchBleve) parseDocID(id string) (*model.MsgSearchHit, error) {
+	i := strings.LastIndex(id, ":")
+	if i < 0 {
+		return nil, errs.ErrInternalServer.WrapMsg("invalid msg search doc id", "id", id)
+	}
+	seq, err := strconv.ParseInt(id[i+1:], 10, 64)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "invalid msg search doc id", "id", id)
+	}
+	return &model.MsgSearchHit{ConversationID: id[:i], Seq: seq}, nil
+}
+
+func (m *MsgSearchBleve) Index(ctx context.Context, docs []*model.MsgSearchDoc) error {
+	if len(docs) == 0 {
+		return nil
+	}
+	batch := m.index.NewBatch()
+	for _, doc := range docs {
+		err := batch.Index(m.docID(doc.ConversationID, doc.Seq), &msgSearchDoc{
+			ConversationID: doc.ConversationID,
+			SendID:         doc.SendID,
+			RecvID:         doc.RecvID,
+			SessionType:    float64(doc.SessionType),
+			ContentType:    float64(doc.ContentType),
+			SendTime:       float64(doc.SendTime),
+			Text:           doc.Text,
+		})
+		if err != nil {
+			return errs.Wrap(err)
+		}
+	}
+	return errs.Wrap(m.index.Batch(batch))
+}
+
+func (m *MsgSearchBleve) Delete(ctx context.Context, conversationID string, seqs []int64) error {
+	if len(seqs) == 0 {
+		return nil
+	}
+	batch := m.index.NewBatch()
+	for _, seq := range seqs {
+		batch.Delete(m.docID(conversationID, seq))
+	}
+	return errs.Wrap(m.index.Batch(batch))
+}
+
+func (m *MsgSearchBleve) termsQuery(field string, terms []string) query.Query {
+	queries := make([]query.Query, 0, len(terms))
+	for _, term := range terms {
+		q := bleve.NewTermQuery(term)
+		q.SetField(field)
+		queries = append(queries, q)
+	}
+	if len(queries) == 1 {
+		return queries[0]
+	}
+	return bleve.NewDisjunctionQuery(queries...)
+}
+
+func (m *MsgSearchBleve) rangeQuery(field string, min *float64, max *float64) query.Query {
+	minInclusive, maxInclusive := true, false
+	q := bleve.NewNumericRangeInclusiveQuery(min, max, &minInclusive, &maxInclusive)
+	q.SetField(field)
+	return q
+}
+
+func (m *MsgSearchBleve) valuesQuery(field string, values []int32) query.Query {
+	queries := make([]query.Query, 0, len(values))
+	for _, value := range values {
+		min, max := float64(value), float64(value+1)
+		queries = append(queries, m.rangeQuery(field, &min, &max))
+	}
+	if len(queries) == 1 {
+		return queries[0]
+	}
+	return bleve.NewDisjunctionQuery(queries...)
+}
+
+func (m *MsgSearchBleve) buildQuery(filter *model.MsgSearchFilter) query.Query {
+	var queries []query.Query
+	if filter.Keyword != "" {
+		q := bleve.NewMatchQuery(filter.Keyword)
+		q.SetField("text")
+		q.SetOperator(query.MatchQueryOperatorAnd)
+		queries = append(queries, q)
+	}
+	if filter.SendID != "" {
+		queries = append(queries, m.termsQuery("send_id", []string{filter.SendID}))
+	}
+	if filter.RecvID != "" {
+		queries = append(queries, m.termsQuery("recv_id", []string{filter.RecvID}))
+	}
+	if len(filter.ConversationIDs) > 0 {
+		queries = append(queries, m.termsQuery("conversation_id", filter.ConversationIDs))
+	}
+	if len(filter.ContentTypes) > 0 {
+		queries = append(queries, m.valuesQuery("content_type", filter.ContentTypes))
+	}
+	if filter.SessionType != 0 {
+		queries = append(queries, m.valuesQuery("session_type", []int32{filter.SessionType}))
+	}
+	if filter.StartTime != 0 || filter.EndTime != 0 {
+		var min, max *float64
+		if filter.StartTime != 0 {
+			start := float64(filter.StartTime)
+			min = &start
+		}
+		if filter.EndTime != 0 {
+			end := float64(filter.EndTime)
+			max = &end
+		}
+		queries = append(queries, m.rangeQuery("send_time", min, max))
+	}
+	if len(queries) == 0 {
+		return bleve.NewMatchAllQuery()
+	}
+	return bleve.NewConjunctionQuery(queries...)
+}
+
+func (m *MsgSearchBleve) Search(ctx context.Context, filter *model.MsgSearchFilter, pagination pagination.Pagination) (int64, []*model.MsgSearchHit, error) {
+	size := int(pagination.GetShowNumber())
+	from := int(pagination.GetPageNumber()-1) * size
+	req := bleve.NewSearchRequestOptions(m.buildQuery(filter), size, from, false)
+	req.SortBy([]string{"-send_time", "-_id"})
+	res, err := m.index.SearchInContext(ctx, req)
+	if err != nil {
+		return 0, nil, errs.Wrap(err)
+	}
+	hits := make([]*model.MsgSearchHit, 0, len(res.Hits))
+	for _, doc := range res.Hits {
+		hit, err := m.parseDocID(doc.ID)
+		if err != nil {
+			return 0, nil, err
+		}
+		hits = append(hits, hit)
+	}
+	return int64(res.Total), hits, nil
+}
+
+// backfillCheckpointKey is the internal key of the index holding the backfill checkpoint.
+var backfillCheckpointKey = []byte("backfill_checkpoint")
+
+func (m *MsgSearchBleve) GetBackfillCheckpoint(ctx context.Context) (string, error) {
+	val, err := m.index.GetInternal(backfillCheckpointKey)
+	if err != nil {
+		return "", errs.Wrap(err)
+	}
+	return string(val), nil
+}
+
+func (m *MsgSearchBleve) SetBackfillCheckpoint(ctx context.Context, docID string) error {
+	return errs.Wrap(m.index.SetInternal(backfillCheckpointKey, []byte(docID)))
+}
+
+// indexIDKey is the internal key of the index holding its id.
+var indexIDKey = []byte("index_id")
+
+func (m *MsgSearchBleve) GetIndexID(ctx context.Context) (string, error) {
+	val, err := m.index.GetInternal(indexIDKey)
+	if err != nil {
+		return "", errs.Wrap(err)
+	}
+	if len(val) > 0 {
+		return string(val), nil
+	}
+	// the index opened by one process at a time, the id is set once
+	id := idutil.OperationIDGenerator()
+	if err := m.index.SetInternal(indexIDKey, []byte(id)); err != nil {
+		return "", errs.Wrap(err)
+	}
+	return id, nil
+}
+
+func (m *MsgSearchBleve) Close() error {
+	return errs.Wrap(m.index.Close())
+}
@@ -0,0 +1,107 @@
+package bleveindex
+
+import (
+	"context"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/sdkws"
+)
+
+func hitSeqs(hits []*model.MsgSearchHit) []int64 {
+	seqs := make([]int64, 0, len(hits))
+	for _, hit := range hits {
+		seqs = append(seqs, hit.Seq)
+	}
+	return seqs
+}
+
+func TestMsgSearchBleve(t *testing.T) {
+	ctx := context.Background()
+	dir := filepath.Join(t.TempDir(), "msg-search")
+	index, err := NewMessageSearchIndex(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	docs := []*model.MsgSearchDoc{
+		{ConversationID: "si_a_b", Seq: 1, SendID: "a", RecvID: "b", SessionType: constant.SingleChatType, ContentType: constant.Text, SendTime: 1000, Text: "hello world"},
+		{ConversationID: "si_a_b", Seq: 2, SendID: "b", RecvID: "a", SessionType: constant.SingleChatType, ContentType: constant.Text, SendTime: 2000, Text: "hello again"},
+		{ConversationID: "sg_group", Seq: 1, SendID: "a", RecvID: "group", SessionType: constant.ReadGroupChatType, ContentType: constant.AtText, SendTime: 3000, Text: "你好世界 hello"},
+		{ConversationID: "sg_group", Seq: 2, SendID: "c", RecvID: "group", SessionType: constant.ReadGroupChatType, ContentType: constant.Picture, SendTime: 4000},
+	}
+	if err := index.Index(ctx, docs); err != nil {
+		t.Fatal(err)
+	}
+	page := &sdkws.RequestPagination{PageNumber: 1, ShowNumber: 10}
+	tests := []struct {
+		name   string
+		filter *model.MsgSearchFilter
+		seqs   []int64
+	}{
+		{"keyword latest first", &model.MsgSearchFilter{Keyword: "hello"}, []int64{1, 2, 1}},
+		{"all words", &model.MsgSearchFilter{Keyword: "hello world"}, []int64{1}},
+		{"cjk", &model.MsgSearchFilter{Keyword: "世界"}, []int64{1}},
+		{"conversations", &model.MsgSearchFilter{Keyword: "hello", ConversationIDs: []string{"si_a_b"}}, []int64{2, 1}},
+		{"sender", &model.MsgSearchFilter{SendID: "a"}, []int64{1, 1}},
+		{"content types", &model.MsgSearchFilter{ContentTypes: []int32{constant.AtText, constant.Picture}}, []int64{2, 1}},
+		{"session type", &model.MsgSearchFilter{SessionType: constant.SingleChatType}, []int64{2, 1}},
+		{"time range", &model.MsgSearchFilter{StartTime: 2000, EndTime: 4000}, []int64{1, 2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			total, hits, err := index.Search(ctx, tt.filter, page)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if total != int64(len(tt.seqs)) || !reflect.DeepEqual(hitSeqs(hits), tt.seqs) {
+				t.Fatalf("total %d seqs %v, want %v", total, hitSeqs(hits), tt.seqs)
+			}
+		})
+	}
+
+	total, hits, err := index.Search(ctx, &model.MsgSearchFilter{}, &sdkws.RequestPagination{PageNumber: 2, ShowNumber: 3})
+	if err != nil || total != 4 || len(hits) != 1 || hits[0].ConversationID != "si_a_b" || hits[0].Seq != 1 {
+		t.Fatalf("second page total %d hits %v err %v", total, hits, err)
+	}
+
+	// a msg indexed again is replaced
+	if err := index.Index(ctx, []*model.MsgSearchDoc{{ConversationID: "si_a_b", Seq: 1, SendID: "a", SendTime: 1000, Text: "edited"}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := index.Delete(ctx, "si_a_b", []int64{2}); err != nil {
+		t.Fatal(err)
+	}
+	if total, hits, err := index.Search(ctx, &model.MsgSearchFilter{Keyword: "hello"}, page); err != nil || total != 1 || hits[0].ConversationID != "sg_group" {
+		t.Fatalf("search after edit and delete total %d hits %v err %v", total, hits, err)
+	}
+
+	if err := index.SetBackfillCheckpoint(ctx, "si_a_b:0"); err != nil {
+		t.Fatal(err)
+	}
+	id, err := index.GetIndexID(ctx)
+	if err != nil || id == "" {
+		t.Fatalf("index id %q err %v", id, err)
+	}
+	if err := index.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// the checkpoint and the id are kept by the index files
+	index, err = NewMessageSearchIndex(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer index.Close()
+	if checkpoint, err := index.GetBackfillCheckpoint(ctx); err != nil || checkpoint != "si_a_b:0" {
+		t.Fatalf("checkpoint %q err %v", checkpoint, err)
+	}
+	if reopened, err := index.GetIndexID(ctx); err != nil || reopened != id {
+		t.Fatalf("index id %q after reopen, want %q, err %v", reopened, id, err)
+	}
+	if total, _, err := index.Search(ctx, &model.MsgSearchFilter{Keyword: "edited"}, page); err != nil || total != 1 {
+		t.Fatalf("search after reopen total %d err %v", total, err)
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewGroupMsgSettingMongo(db *mongo.Database) (database.GroupMsgSetting, error) {
+	coll := db.Collection(database.GroupMsgSettingName)
+	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys:    bson.D{{Key: "group_id", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &GroupMsgSettingMgo{coll: coll}, nil
+}
+
+type GroupMsgSettingMgo struct {
+	coll *mongo.Collection
+}
+
+func (g *GroupMsgSettingMgo) SetReadReceipt(ctx context.Context, groupID string, enable bool) error {
+	update := bson.M{"$set": bson.M{"read_receipt": enable, "update_time": time.Now()}}
+	return mongoutil.UpdateOne(ctx, g.coll, bson.M{"group_id": groupID}, update, false, options.Update().SetUpsert(true))
+}
+
+func (g *GroupMsgSettingMgo) Take(ctx context.Context, groupID string) (*model.GroupMsgSetting, error) {
+	return mongoutil.FindOne[*model.GroupMsgSetting](ctx, g.coll, bson.M{"group_id": groupID})
+}
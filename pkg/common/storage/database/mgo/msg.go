@@ -78,10 +78,135 @@ func (m *MsgMgo) PushUnique(ctx context.Context, docID string, index int64, key
 	return mongoutil.UpdateOneResult(ctx, m.coll, filter, update)
 }
 
-func (m *MsgMgo) UpdateMsgContent(ctx context.Context, docID string, index int64, msg []byte) error {
-	filter := bson.M{"doc_id": docID}
-	update := bson.M{"$set": bson.M{fmt.Sprintf("msgs.%d.msg", index): msg}}
-	return mongoutil.UpdateOne(ctx, m.coll, filter, update, false)
+// maxModifyHistory is the number of the latest edits kept in the modify history of a message,
+// older edits are dropped so repeated edits can not grow the msg document without limit.
+const maxModifyHistory = 20
+
+// UpdateMsgContent replaces the content of the message and appends the replaced content to its modify history.
+// The update only matches while the stored content is still history.Content, so concurrent edits can not be lost.
+func (m *MsgMgo) UpdateMsgContent(ctx context.Context, docID string, index int64, content string, history *model.ModifyModel) error {
+	filter := bson.M{
+		"doc_id": docID,
+		fmt.Sprintf("msgs.%d.msg.content", index): history.Content,
+	}
+	update := bson.M{
+		"$set": bson.M{fmt.Sprintf("msgs.%d.msg.content", index): content},
+		"$push": bson.M{fmt.Sprintf("msgs.%d.modify_history", index): bson.M{
+			"$each":  bson.A{history},
+			"$slice": -maxModifyHistory,
+		}},
+	}
+	return mongoutil.UpdateOne(ctx, m.coll, filter, update, true)
+}
+
+func (m *MsgMgo) GetMsgModifyHistory(ctx context.Context, docID string, index int64) ([]*model.ModifyModel, error) {
+	opts := options.FindOne().SetProjection(bson.M{"_id": 0, "doc_id": 1, "msgs": bson.M{"$slice": bson.A{index, 1}}})
+	doc, err := mongoutil.FindOne[*model.MsgDocModel](ctx, m.coll, bson.M{"doc_id": docID}, opts)
+	if err != nil {
+		return nil, err
+	}
+	if len(doc.Msg) == 0 || doc.Msg[0] == nil {
+		return nil, nil
+	}
+	return doc.Msg[0].ModifyHistory, nil
+}
+
+// UpdateMsgReaction adds userID to or removes userID from the reaction of the message,
+// and returns all reactions of the message after the update.
+func (m *MsgMgo) UpdateMsgReaction(ctx context.Context, docID string, index int64, reactionKey string, userID string, isAdd bool) (map[string][]string, error) {
+	field := fmt.Sprintf("msgs.%d.reactions.%s", index, reactionKey)
+	var update bson.M
+	if isAdd {
+		update = bson.M{"$addToSet": bson.M{field: userID}}
+	} else {
+		update = bson.M{"$pull": bson.M{field: userID}}
+	}
+	filter := bson.M{
+		"doc_id":                          docID,
+		fmt.Sprintf("msgs.%d.msg", index): bson.M{"$ne": nil},
+	}
+	opts := options.FindOneAndUpdate().
+		SetReturnDocument(options.After).
+		SetProjection(bson.M{"_id": 0, "doc_id": 1, "msgs": bson.M{"$slice": bson.A{index, 1}}})
+	doc, err := mongoutil.FindOneAndUpdate[*model.MsgDocModel](ctx, m.coll, filter, update, opts)
+	if err != nil {
+		return nil, err
+	}
+	return msgDocReactions(doc), nil
+}
+
+func (m *MsgMgo) GetMsgReactions(ctx context.Context, docID string, index int64) (map[string][]string, error) {
+	opts := options.FindOne().SetProjection(bson.M{"_id": 0, "doc_id": 1, "msgs": bson.M{"$slice": bson.A{index, 1}}})
+	doc, err := mongoutil.FindOne[*model.MsgDocModel](ctx, m.coll, bson.M{"doc_id": docID}, opts)
+	if err != nil {
+		return nil, err
+	}
+	return msgDocReactions(doc), nil
+}
+
+func (m *MsgMgo) GetMsgsReactions(ctx context.Context, docID string, indexes []int64) (map[int64]map[string][]string, error) {
+	pipeline := mongo.Pipeline{
+		bson.D{{Key: "$match", Value: bson.D{
+			{Key: "doc_id", Value: docID},
+		}}},
+		bson.D{{Key: "$project", Value: bson.D{
+			{Key: "_id", Value: 0},
+			{Key: "doc_id", Value: 1},
+			{Key: "msgs", Value: bson.D{
+				{Key: "$map", Value: bson.D{
+					{Key: "input", Value: indexes},
+					{Key: "as", Value: "index"},
+					{Key: "in", Value: bson.D{
+						{Key: "$let", Value: bson.D{
+							{Key: "vars", Value: bson.D{
+								{Key: "m", Value: bson.D{{Key: "$arrayElemAt", Value: bson.A{"$msgs", "$$index"}}}},
+							}},
+							{Key: "in", Value: bson.D{
+								{Key: "msg", Value: bson.D{{Key: "seq", Value: "$$m.msg.seq"}}},
+								{Key: "reactions", Value: "$$m.reactions"},
+							}},
+						}},
+					}},
+				}},
+			}},
+		}}},
+	}
+	docs, err := mongoutil.Aggregate[*model.MsgDocModel](ctx, m.coll, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[int64]map[string][]string)
+	for _, doc := range docs {
+		for _, msg := range doc.Msg {
+			if msg == nil || msg.Msg == nil || len(msg.Reactions) == 0 {
+				continue
+			}
+			reactions := make(map[string][]string, len(msg.Reactions))
+			for key, userIDs := range msg.Reactions {
+				if len(userIDs) > 0 {
+					reactions[key] = userIDs
+				}
+			}
+			if len(reactions) > 0 {
+				res[msg.Msg.Seq] = reactions
+			}
+		}
+	}
+	return res, nil
+}
+
+// msgDocReactions returns the non-empty reactions of the only message of a sliced msg document.
+func msgDocReactions(doc *model.MsgDocModel) map[string][]string {
+	if len(doc.Msg) == 0 || doc.Msg[0] == nil {
+		return nil
+	}
+	reactions := make(map[string][]string, len(doc.Msg[0].Reactions))
+	for key, userIDs := range doc.Msg[0].Reactions {
+		if len(userIDs) > 0 {
+			reactions[key] = userIDs
+		}
+	}
+	return reactions
 }
 
 func (m *MsgMgo) IsExistDocID(ctx context.Context, docID string) (bool, error) {
@@ -92,6 +217,11 @@ func (m *MsgMgo) FindOneByDocID(ctx context.Context, docID string) (*model.MsgDo
 	return mongoutil.FindOne[*model.MsgDocModel](ctx, m.coll, bson.M{"doc_id": docID})
 }
 
+func (m *MsgMgo) FindDocsAfter(ctx context.Context, docID string, limit int64) ([]*model.MsgDocModel, error) {
+	opts := options.Find().SetSort(bson.M{"doc_id": 1}).SetLimit(limit)
+	return mongoutil.Find[*model.MsgDocModel](ctx, m.coll, bson.M{"doc_id": bson.M{"$gt": docID}}, opts)
+}
+
 func (m *MsgMgo) GetMsgBySeqIndexIn1Doc(ctx context.Context, userID, docID string, seqs []int64) ([]*model.MsgInfoModel, error) {
 	indexs := make([]int64, 0, len(seqs))
 	for _, seq := range seqs {
@@ -0,0 +1,130 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/db/pagination"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewMsgThreadMongo(db *mongo.Database) (database.MsgThread, error) {
+	coll := db.Collection(database.MsgThreadName)
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "conversation_id", Value: 1},
+				{Key: "root_server_msg_id", Value: 1},
+				{Key: "thread_seq", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "conversation_id", Value: 1},
+				{Key: "server_msg_id", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	readColl := db.Collection(database.MsgThreadReadName)
+	_, err = readColl.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "user_id", Value: 1},
+			{Key: "conversation_id", Value: 1},
+			{Key: "root_server_msg_id", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &MsgThreadMgo{coll: coll, readColl: readColl}, nil
+}
+
+type MsgThreadMgo struct {
+	coll     *mongo.Collection
+	readColl *mongo.Collection
+}
+
+func (m *MsgThreadMgo) Create(ctx context.Context, replies []*model.MsgThreadReply) error {
+	if len(replies) == 0 {
+		return nil
+	}
+	writes := make([]mongo.WriteModel, 0, len(replies))
+	for _, reply := range replies {
+		writes = append(writes, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"conversation_id": reply.ConversationID, "server_msg_id": reply.ServerMsgID}).
+			SetUpdate(bson.M{"$setOnInsert": reply}).
+			SetUpsert(true))
+	}
+	_, err := m.coll.BulkWrite(ctx, writes, options.BulkWrite().SetOrdered(false))
+	return errs.Wrap(err)
+}
+
+func (m *MsgThreadMgo) FindServerMsgIDs(ctx context.Context, conversationID string, serverMsgIDs []string) ([]string, error) {
+	if len(serverMsgIDs) == 0 {
+		return nil, nil
+	}
+	filter := bson.M{"conversation_id": conversationID, "server_msg_id": bson.M{"$in": serverMsgIDs}}
+	return mongoutil.Find[string](ctx, m.coll, filter, options.Find().SetProjection(bson.M{"_id": 0, "server_msg_id": 1}))
+}
+
+func (m *MsgThreadMgo) FindMaxThreadSeq(ctx context.Context, conversationID string, rootServerMsgID string) (int64, error) {
+	filter := bson.M{"conversation_id": conversationID, "root_server_msg_id": rootServerMsgID}
+	opt := options.FindOne().SetProjection(bson.M{"_id": 0, "thread_seq": 1}).SetSort(bson.M{"thread_seq": -1})
+	seq, err := mongoutil.FindOne[int64](ctx, m.coll, filter, opt)
+	if err != nil {
+		if errors.Is(errs.Unwrap(err), mongo.ErrNoDocuments) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return seq, nil
+}
+
+func (m *MsgThreadMgo) ExistThread(ctx context.Context, conversationID string, rootServerMsgID string) (bool, error) {
+	return mongoutil.Exist(ctx, m.coll, bson.M{"conversation_id": conversationID, "root_server_msg_id": rootServerMsgID})
+}
+
+func (m *MsgThreadMgo) FindReplies(ctx context.Context, conversationID string, rootServerMsgID string, pagination pagination.Pagination) (int64, []*model.MsgThreadReply, error) {
+	filter := bson.M{"conversation_id": conversationID, "root_server_msg_id": rootServerMsgID}
+	return mongoutil.FindPage[*model.MsgThreadReply](ctx, m.coll, filter, pagination, options.Find().SetSort(bson.M{"thread_seq": 1}))
+}
+
+func (m *MsgThreadMgo) SetHasReadSeq(ctx context.Context, userID string, conversationID string, rootServerMsgID string, hasReadSeq int64) error {
+	filter := bson.M{"user_id": userID, "conversation_id": conversationID, "root_server_msg_id": rootServerMsgID}
+	update := bson.M{"$max": bson.M{"has_read_seq": hasReadSeq}}
+	return mongoutil.UpdateOne(ctx, m.readColl, filter, update, false, options.Update().SetUpsert(true))
+}
+
+func (m *MsgThreadMgo) FindHasReadSeqs(ctx context.Context, userID string, conversationIDs []string) ([]*model.MsgThreadRead, error) {
+	if len(conversationIDs) == 0 {
+		return nil, nil
+	}
+	filter := bson.M{"user_id": userID, "conversation_id": bson.M{"$in": conversationIDs}}
+	return mongoutil.Find[*model.MsgThreadRead](ctx, m.readColl, filter)
+}
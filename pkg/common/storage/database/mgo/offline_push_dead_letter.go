@@ -0,0 +1,75 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/db/pagination"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewOfflinePushDeadLetterMongo(db *mongo.Database) (database.OfflinePushDeadLetter, error) {
+	coll := db.Collection(database.OfflinePushDeadLetterName)
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "task_id", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "user_ids", Value: 1},
+				{Key: "dead_time", Value: -1},
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &OfflinePushDeadLetterMgo{coll: coll}, nil
+}
+
+type OfflinePushDeadLetterMgo struct {
+	coll *mongo.Collection
+}
+
+func (o *OfflinePushDeadLetterMgo) Save(ctx context.Context, task *model.OfflinePushTask) error {
+	_, err := o.coll.ReplaceOne(ctx, bson.M{"task_id": task.TaskID}, task, options.Replace().SetUpsert(true))
+	return errs.Wrap(err)
+}
+
+func (o *OfflinePushDeadLetterMgo) Find(ctx context.Context, taskIDs []string) ([]*model.OfflinePushTask, error) {
+	return mongoutil.Find[*model.OfflinePushTask](ctx, o.coll, bson.M{"task_id": bson.M{"$in": taskIDs}})
+}
+
+func (o *OfflinePushDeadLetterMgo) Delete(ctx context.Context, taskIDs []string) error {
+	return mongoutil.DeleteMany(ctx, o.coll, bson.M{"task_id": bson.M{"$in": taskIDs}})
+}
+
+func (o *OfflinePushDeadLetterMgo) Search(ctx context.Context, userID string, pagination pagination.Pagination) (int64, []*model.OfflinePushTask, error) {
+	filter := bson.M{}
+	if userID != "" {
+		filter["user_ids"] = userID
+	}
+	return mongoutil.FindPage[*model.OfflinePushTask](ctx, o.coll, filter, pagination, options.Find().SetSort(bson.M{"dead_time": -1}))
+}
@@ -0,0 +1,78 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewPinnedMsgMongo(db *mongo.Database) (database.PinnedMsg, error) {
+	coll := db.Collection(database.PinnedMsgName)
+	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "conversation_id", Value: 1},
+			{Key: "seq", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &PinnedMsgMgo{coll: coll}, nil
+}
+
+type PinnedMsgMgo struct {
+	coll *mongo.Collection
+}
+
+func (p *PinnedMsgMgo) Create(ctx context.Context, pin *model.PinnedMsg) (bool, error) {
+	filter := bson.M{"conversation_id": pin.ConversationID, "seq": pin.Seq}
+	res, err := mongoutil.UpdateOneResult(ctx, p.coll, filter, bson.M{"$setOnInsert": pin}, options.Update().SetUpsert(true))
+	if err != nil {
+		return false, err
+	}
+	return res.UpsertedCount > 0, nil
+}
+
+func (p *PinnedMsgMgo) Delete(ctx context.Context, conversationID string, seqs []int64) (int64, error) {
+	if len(seqs) == 0 {
+		return 0, nil
+	}
+	res, err := mongoutil.DeleteManyResult(ctx, p.coll, bson.M{"conversation_id": conversationID, "seq": bson.M{"$in": seqs}})
+	if err != nil {
+		return 0, err
+	}
+	return res.DeletedCount, nil
+}
+
+func (p *PinnedMsgMgo) Count(ctx context.Context, conversationID string) (int64, error) {
+	return mongoutil.Count(ctx, p.coll, bson.M{"conversation_id": conversationID})
+}
+
+func (p *PinnedMsgMgo) Find(ctx context.Context, conversationIDs []string) ([]*model.PinnedMsg, error) {
+	if len(conversationIDs) == 0 {
+		return nil, nil
+	}
+	filter := bson.M{"conversation_id": bson.M{"$in": conversationIDs}}
+	return mongoutil.Find[*model.PinnedMsg](ctx, p.coll, filter, options.Find().SetSort(bson.D{{Key: "pin_time", Value: -1}, {Key: "seq", Value: -1}}))
+}
@@ -0,0 +1,124 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgext"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/db/pagination"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewScheduledMsgMongo(db *mongo.Database) (database.ScheduledMsg, error) {
+	coll := db.Collection(database.ScheduledMsgName)
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "schedule_id", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "status", Value: 1},
+				{Key: "send_time", Value: 1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{Key: "msg.send_id", Value: 1},
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &ScheduledMsgMgo{coll: coll}, nil
+}
+
+type ScheduledMsgMgo struct {
+	coll *mongo.Collection
+}
+
+func (s *ScheduledMsgMgo) Create(ctx context.Context, msgs []*model.ScheduledMsg) error {
+	return mongoutil.InsertMany(ctx, s.coll, msgs)
+}
+
+func (s *ScheduledMsgMgo) Take(ctx context.Context, scheduleID string) (*model.ScheduledMsg, error) {
+	return mongoutil.FindOne[*model.ScheduledMsg](ctx, s.coll, bson.M{"schedule_id": scheduleID})
+}
+
+func (s *ScheduledMsgMgo) UpdateStatus(ctx context.Context, scheduleID string, from int32, to int32) error {
+	return mongoutil.UpdateOne(ctx, s.coll, bson.M{"schedule_id": scheduleID, "status": from}, bson.M{"$set": bson.M{"status": to}}, true)
+}
+
+func (s *ScheduledMsgMgo) Claim(ctx context.Context, claimID string, now time.Time) (*model.ScheduledMsg, error) {
+	filter := bson.M{"status": msgext.ScheduledMsgPending, "send_time": bson.M{"$lte": now}}
+	update := bson.M{"$set": bson.M{"status": msgext.ScheduledMsgSending, "claim_id": claimID, "claim_time": now, "sending_time": time.Time{}}}
+	opts := options.FindOneAndUpdate().SetSort(bson.M{"send_time": 1}).SetReturnDocument(options.After)
+	return mongoutil.FindOneAndUpdate[*model.ScheduledMsg](ctx, s.coll, filter, update, opts)
+}
+
+func (s *ScheduledMsgMgo) StartSending(ctx context.Context, scheduleID string, claimID string, now time.Time) (*model.ScheduledMsg, error) {
+	filter := bson.M{"schedule_id": scheduleID, "claim_id": claimID, "status": msgext.ScheduledMsgSending, "sending_time": time.Time{}}
+	update := bson.M{"$set": bson.M{"sending_time": now}}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	return mongoutil.FindOneAndUpdate[*model.ScheduledMsg](ctx, s.coll, filter, update, opts)
+}
+
+func (s *ScheduledMsgMgo) ReleaseClaims(ctx context.Context, expire time.Time) (int64, error) {
+	filter := bson.M{"status": msgext.ScheduledMsgSending, "claim_time": bson.M{"$lt": expire}, "sending_time": time.Time{}}
+	update := bson.M{"$set": bson.M{"status": msgext.ScheduledMsgPending, "claim_id": ""}}
+	res, err := mongoutil.UpdateMany(ctx, s.coll, filter, update)
+	if err != nil {
+		return 0, err
+	}
+	return res.ModifiedCount, nil
+}
+
+func (s *ScheduledMsgMgo) ExpireClaims(ctx context.Context, expire time.Time, errMsg string) (int64, error) {
+	// the msgs stored before sending_time was added have no such field and are treated as started
+	filter := bson.M{"status": msgext.ScheduledMsgSending, "claim_time": bson.M{"$lt": expire}, "sending_time": bson.M{"$ne": time.Time{}}}
+	update := bson.M{"$set": bson.M{"status": msgext.ScheduledMsgUnknown, "err_msg": errMsg}}
+	res, err := mongoutil.UpdateMany(ctx, s.coll, filter, update)
+	if err != nil {
+		return 0, err
+	}
+	return res.ModifiedCount, nil
+}
+
+func (s *ScheduledMsgMgo) SetResult(ctx context.Context, scheduleID string, claimID string, status int32, serverMsgID string, errMsg string) error {
+	filter := bson.M{"schedule_id": scheduleID, "claim_id": claimID, "status": msgext.ScheduledMsgSending}
+	update := bson.M{"$set": bson.M{"status": status, "server_msg_id": serverMsgID, "err_msg": errMsg}}
+	return mongoutil.UpdateOne(ctx, s.coll, filter, update, true)
+}
+
+func (s *ScheduledMsgMgo) Search(ctx context.Context, sendID string, status int32, pagination pagination.Pagination) (int64, []*model.ScheduledMsg, error) {
+	filter := bson.M{}
+	if sendID != "" {
+		filter["msg.send_id"] = sendID
+	}
+	if status != 0 {
+		filter["status"] = status
+	}
+	return mongoutil.FindPage[*model.ScheduledMsg](ctx, s.coll, filter, pagination, options.Find().SetSort(bson.M{"send_time": 1}))
+}
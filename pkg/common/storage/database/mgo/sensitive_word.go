@@ -0,0 +1,89 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/db/pagination"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewSensitiveWordMongo(db *mongo.Database) (database.SensitiveWord, error) {
+	coll := db.Collection(database.SensitiveWordName)
+	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "word", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &SensitiveWordMgo{coll: coll}, nil
+}
+
+type SensitiveWordMgo struct {
+	coll *mongo.Collection
+}
+
+func (s *SensitiveWordMgo) Create(ctx context.Context, words []*model.SensitiveWord) error {
+	if len(words) == 0 {
+		return nil
+	}
+	writes := make([]mongo.WriteModel, 0, len(words))
+	for _, word := range words {
+		writes = append(writes, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"word": word.Word}).
+			SetUpdate(bson.M{"$setOnInsert": word}).
+			SetUpsert(true))
+	}
+	_, err := s.coll.BulkWrite(ctx, writes, options.BulkWrite().SetOrdered(false))
+	return errs.Wrap(err)
+}
+
+func (s *SensitiveWordMgo) Delete(ctx context.Context, words []string) error {
+	if len(words) == 0 {
+		return nil
+	}
+	return mongoutil.DeleteMany(ctx, s.coll, bson.M{"word": bson.M{"$in": words}})
+}
+
+func (s *SensitiveWordMgo) FindAll(ctx context.Context) ([]string, error) {
+	words, err := mongoutil.Find[*model.SensitiveWord](ctx, s.coll, bson.M{}, options.Find().SetProjection(bson.M{"word": 1}))
+	if err != nil {
+		return nil, err
+	}
+	res := make([]string, 0, len(words))
+	for _, word := range words {
+		res = append(res, word.Word)
+	}
+	return res, nil
+}
+
+func (s *SensitiveWordMgo) Search(ctx context.Context, keyword string, pagination pagination.Pagination) (int64, []*model.SensitiveWord, error) {
+	filter := bson.M{}
+	if keyword != "" {
+		filter["word"] = bson.M{"$regex": regexp.QuoteMeta(keyword)}
+	}
+	return mongoutil.FindPage[*model.SensitiveWord](ctx, s.coll, filter, pagination, options.Find().SetSort(bson.M{"create_time": -1}))
+}
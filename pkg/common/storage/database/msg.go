@@ -27,9 +27,17 @@ type Msg interface {
 	Create(ctx context.Context, model *model.MsgDocModel) error
 	UpdateMsg(ctx context.Context, docID string, index int64, key string, value any) (*mongo.UpdateResult, error)
 	PushUnique(ctx context.Context, docID string, index int64, key string, value any) (*mongo.UpdateResult, error)
-	UpdateMsgContent(ctx context.Context, docID string, index int64, msg []byte) error
+	UpdateMsgContent(ctx context.Context, docID string, index int64, content string, history *model.ModifyModel) error
+	// GetMsgModifyHistory gets the latest edits of the message, the oldest first.
+	GetMsgModifyHistory(ctx context.Context, docID string, index int64) ([]*model.ModifyModel, error)
+	UpdateMsgReaction(ctx context.Context, docID string, index int64, reactionKey string, userID string, isAdd bool) (map[string][]string, error)
+	GetMsgReactions(ctx context.Context, docID string, index int64) (map[string][]string, error)
+	// GetMsgsReactions gets the non-empty reactions of the msgs of indexes in one doc, k: seq.
+	GetMsgsReactions(ctx context.Context, docID string, indexes []int64) (map[int64]map[string][]string, error)
 	IsExistDocID(ctx context.Context, docID string) (bool, error)
 	FindOneByDocID(ctx context.Context, docID string) (*model.MsgDocModel, error)
+	// FindDocsAfter finds at most limit msg docs whose docID is greater than docID in the order of docID.
+	FindDocsAfter(ctx context.Context, docID string, limit int64) ([]*model.MsgDocModel, error)
 	GetMsgBySeqIndexIn1Doc(ctx context.Context, userID, docID string, seqs []int64) ([]*model.MsgInfoModel, error)
 	GetNewestMsg(ctx context.Context, conversationID string) (*model.MsgInfoModel, error)
 	GetOldestMsg(ctx context.Context, conversationID string) (*model.MsgInfoModel, error)
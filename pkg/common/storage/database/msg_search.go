@@ -0,0 +1,38 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/pagination"
+)
+
+// MessageSearchIndex is the full-text index of the msgs, it only holds what is needed to find a msg,
+// the msgs themselves are loaded from the msg storage.
+type MessageSearchIndex interface {
+	// Index adds the msgs to the index, a msg already indexed with the same conversationID and seq is replaced.
+	Index(ctx context.Context, docs []*model.MsgSearchDoc) error
+	Delete(ctx context.Context, conversationID string, seqs []int64) error
+	// Search returns the matched msgs ordered by send time descending.
+	Search(ctx context.Context, filter *model.MsgSearchFilter, pagination pagination.Pagination) (int64, []*model.MsgSearchHit, error)
+	// GetBackfillCheckpoint returns the docID of the last msg doc backfilled into the index, "" if the backfill has not started.
+	GetBackfillCheckpoint(ctx context.Context) (string, error)
+	SetBackfillCheckpoint(ctx context.Context, docID string) error
+	// GetIndexID returns the id of the index files, it is kept across the restarts of the process opening them.
+	GetIndexID(ctx context.Context) (string, error)
+	Close() error
+}
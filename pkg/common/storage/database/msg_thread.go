@@ -0,0 +1,38 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/pagination"
+)
+
+type MsgThread interface {
+	// Create indexes the replies, a reply already indexed by its serverMsgID is kept as it is.
+	Create(ctx context.Context, replies []*model.MsgThreadReply) error
+	// FindServerMsgIDs returns the serverMsgIDs of the replies already indexed among serverMsgIDs.
+	FindServerMsgIDs(ctx context.Context, conversationID string, serverMsgIDs []string) ([]string, error)
+	// FindMaxThreadSeq returns the max thread seq of the replies of the thread, 0 if it has no reply.
+	FindMaxThreadSeq(ctx context.Context, conversationID string, rootServerMsgID string) (int64, error)
+	// ExistThread reports whether the thread has any reply.
+	ExistThread(ctx context.Context, conversationID string, rootServerMsgID string) (bool, error)
+	FindReplies(ctx context.Context, conversationID string, rootServerMsgID string, pagination pagination.Pagination) (int64, []*model.MsgThreadReply, error)
+	// SetHasReadSeq follows the thread for the user, the has read seq is only increased.
+	SetHasReadSeq(ctx context.Context, userID string, conversationID string, rootServerMsgID string, hasReadSeq int64) error
+	// FindHasReadSeqs returns the has read seqs of the threads followed by the user in the conversations.
+	FindHasReadSeqs(ctx context.Context, userID string, conversationIDs []string) ([]*model.MsgThreadRead, error)
+}
@@ -1,17 +1,25 @@
 package database
 
 const (
-	BlackName              = "black"
-	ConversationName       = "conversation"
-	FriendName             = "friend"
-	FriendVersionName      = "friend_version"
-	FriendRequestName      = "friend_request"
-	GroupName              = "group"
-	GroupMemberName        = "group_member"
-	GroupMemberVersionName = "group_member_version"
-	GroupJoinVersionName   = "group_join_version"
-	GroupRequestName       = "group_request"
-	LogName                = "log"
-	ObjectName             = "s3"
-	UserName               = "user"
+	BlackName                 = "black"
+	ConversationName          = "conversation"
+	FriendName                = "friend"
+	FriendVersionName         = "friend_version"
+	FriendRequestName         = "friend_request"
+	GroupName                 = "group"
+	GroupMemberName           = "group_member"
+	GroupMsgSettingName       = "group_msg_setting"
+	GroupMemberVersionName    = "group_member_version"
+	GroupJoinVersionName      = "group_join_version"
+	GroupRequestName          = "group_request"
+	LogName                   = "log"
+	MsgRetryDeadLetterName    = "msg_retry_dead_letter"
+	MsgThreadName             = "msg_thread"
+	MsgThreadReadName         = "msg_thread_read"
+	ObjectName                = "s3"
+	OfflinePushDeadLetterName = "offline_push_dead_letter"
+	PinnedMsgName             = "pinned_msg"
+	ScheduledMsgName          = "scheduled_msg"
+	SensitiveWordName         = "sensitive_word"
+	UserName                  = "user"
 )
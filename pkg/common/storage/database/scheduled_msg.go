@@ -0,0 +1,41 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/pagination"
+)
+
+type ScheduledMsg interface {
+	Create(ctx context.Context, msgs []*model.ScheduledMsg) error
+	Take(ctx context.Context, scheduleID string) (*model.ScheduledMsg, error)
+	// UpdateStatus changes the status of the scheduled msg only if it is in status from.
+	UpdateStatus(ctx context.Context, scheduleID string, from int32, to int32) error
+	// Claim takes one due msg that is pending.
+	Claim(ctx context.Context, claimID string, now time.Time) (*model.ScheduledMsg, error)
+	// StartSending marks the msg claimed by claimID as started sending and returns it, it fails with
+	// mongo.ErrNoDocuments if the claim has been released.
+	StartSending(ctx context.Context, scheduleID string, claimID string, now time.Time) (*model.ScheduledMsg, error)
+	// ReleaseClaims puts the msgs claimed before expire which have not started sending back to pending.
+	ReleaseClaims(ctx context.Context, expire time.Time) (int64, error)
+	// ExpireClaims marks the msgs claimed before expire which have started sending as unknown.
+	ExpireClaims(ctx context.Context, expire time.Time, errMsg string) (int64, error)
+	SetResult(ctx context.Context, scheduleID string, claimID string, status int32, serverMsgID string, errMsg string) error
+	Search(ctx context.Context, sendID string, status int32, pagination pagination.Pagination) (int64, []*model.ScheduledMsg, error)
+}
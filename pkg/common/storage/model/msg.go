@@ -42,6 +42,14 @@ type RevokeModel struct {
 	Time     int64  `bson:"time"`
 }
 
+// ModifyModel is one entry of the edit history of a message, it keeps the content that was replaced.
+type ModifyModel struct {
+	UserID   string `bson:"user_id"`
+	Nickname string `bson:"nickname"`
+	Content  string `bson:"content"`
+	Time     int64  `bson:"time"`
+}
+
 type OfflinePushModel struct {
 	Title         string `bson:"title"`
 	Desc          string `bson:"desc"`
@@ -76,10 +84,13 @@ type MsgDataModel struct {
 }
 
 type MsgInfoModel struct {
-	Msg     *MsgDataModel `bson:"msg"`
-	Revoke  *RevokeModel  `bson:"revoke"`
-	DelList []string      `bson:"del_list"`
-	IsRead  bool          `bson:"is_read"`
+	Msg           *MsgDataModel  `bson:"msg"`
+	Revoke        *RevokeModel   `bson:"revoke"`
+	DelList       []string       `bson:"del_list"`
+	IsRead        bool           `bson:"is_read"`
+	ModifyHistory []*ModifyModel `bson:"modify_history,omitempty"`
+	// Reactions maps the reaction key to the users who reacted with it.
+	Reactions map[string][]string `bson:"reactions,omitempty"`
 }
 
 type UserCount struct {
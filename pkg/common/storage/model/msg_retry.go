@@ -0,0 +1,42 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "time"
+
+// Kinds of the msg retry tasks.
+const (
+	// MsgRetryThread indexes the thread replies of the msgs.
+	MsgRetryThread = "thread"
+	// MsgRetryIndex indexes the msgs for search.
+	MsgRetryIndex = "index"
+	// MsgRetryBurn burns the msgs of Seqs, it is retried from the burn queue and only its dead letters are kept.
+	MsgRetryBurn = "burn"
+)
+
+// MsgRetryTask is the processing of a batch of stored msgs which failed and is retried from a delayed queue,
+// it becomes a dead letter after failing the max attempts.
+type MsgRetryTask struct {
+	TaskID         string          `bson:"task_id" json:"taskID"`
+	Kind           string          `bson:"kind" json:"kind"`
+	ConversationID string          `bson:"conversation_id" json:"conversationID"`
+	Msgs           []*MsgDataModel `bson:"msgs" json:"msgs"`
+	Seqs           []int64         `bson:"seqs" json:"seqs"`
+	Attempts       int32           `bson:"attempts" json:"attempts"`
+	ErrMsg         string          `bson:"err_msg" json:"errMsg"`
+	OperationID    string          `bson:"operation_id" json:"operationID"`
+	CreateTime     time.Time       `bson:"create_time" json:"createTime"`
+	DeadTime       time.Time       `bson:"dead_time" json:"deadTime"`
+}
@@ -0,0 +1,47 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+// MsgSearchDoc is a msg in the full-text search index.
+type MsgSearchDoc struct {
+	ConversationID string
+	Seq            int64
+	SendID         string
+	// RecvID is the groupID for group msgs.
+	RecvID      string
+	SessionType int32
+	ContentType int32
+	SendTime    int64
+	Text        string
+}
+
+// MsgSearchFilter limits the msgs matched by the full-text search, empty fields are not limited.
+type MsgSearchFilter struct {
+	Keyword         string
+	SendID          string
+	RecvID          string
+	ConversationIDs []string
+	ContentTypes    []int32
+	SessionType     int32
+	// StartTime is inclusive and EndTime is exclusive, in milliseconds.
+	StartTime int64
+	EndTime   int64
+}
+
+// MsgSearchHit is a msg matched by the full-text search.
+type MsgSearchHit struct {
+	ConversationID string
+	Seq            int64
+}
@@ -0,0 +1,34 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+// MsgThreadReply indexes a reply msg of a thread, the msg itself is stored in the conversation.
+type MsgThreadReply struct {
+	ConversationID  string `bson:"conversation_id"`
+	RootServerMsgID string `bson:"root_server_msg_id"`
+	ThreadSeq       int64  `bson:"thread_seq"`
+	Seq             int64  `bson:"seq"`
+	ServerMsgID     string `bson:"server_msg_id"`
+	SendID          string `bson:"send_id"`
+	SendTime        int64  `bson:"send_time"`
+}
+
+// MsgThreadRead is the has read thread seq of a thread followed by the user.
+type MsgThreadRead struct {
+	UserID          string `bson:"user_id"`
+	ConversationID  string `bson:"conversation_id"`
+	RootServerMsgID string `bson:"root_server_msg_id"`
+	HasReadSeq      int64  `bson:"has_read_seq"`
+}
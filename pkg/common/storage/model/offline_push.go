@@ -0,0 +1,34 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"time"
+)
+
+// OfflinePushTask is an offline push which failed and is retried later, it becomes a dead letter
+// after failing the max attempts. Summary is the number of the stale msgs of a summary push, Msg is nil
+// for the summary pushes.
+type OfflinePushTask struct {
+	TaskID      string        `bson:"task_id" json:"taskID"`
+	UserIDs     []string      `bson:"user_ids" json:"userIDs"`
+	Msg         *MsgDataModel `bson:"msg" json:"msg"`
+	Attempts    int32         `bson:"attempts" json:"attempts"`
+	ErrMsg      string        `bson:"err_msg" json:"errMsg"`
+	OperationID string        `bson:"operation_id" json:"operationID"`
+	CreateTime  time.Time     `bson:"create_time" json:"createTime"`
+	DeadTime    time.Time     `bson:"dead_time" json:"deadTime"`
+	Summary     int64         `bson:"summary" json:"summary,omitempty"`
+}
@@ -0,0 +1,34 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"time"
+)
+
+// ScheduledMsg is a msg waiting to be sent at SendTime, ClaimID and ClaimTime are set when a crontask claims it,
+// SendingTime is set when the claimed msg starts to be sent and is zero before.
+type ScheduledMsg struct {
+	ScheduleID  string        `bson:"schedule_id"`
+	Msg         *MsgDataModel `bson:"msg"`
+	SendTime    time.Time     `bson:"send_time"`
+	Status      int32         `bson:"status"`
+	ClaimID     string        `bson:"claim_id"`
+	ClaimTime   time.Time     `bson:"claim_time"`
+	SendingTime time.Time     `bson:"sending_time"`
+	ServerMsgID string        `bson:"server_msg_id"`
+	ErrMsg      string        `bson:"err_msg"`
+	CreateTime  time.Time     `bson:"create_time"`
+}
@@ -25,6 +25,7 @@ type User struct {
 	Ex               string    `bson:"ex"`
 	AppMangerLevel   int32     `bson:"app_manger_level"`
 	GlobalRecvMsgOpt int32     `bson:"global_recv_msg_opt"`
+	Locale           string    `bson:"locale"`
 	CreateTime       time.Time `bson:"create_time"`
 }
 
@@ -0,0 +1,44 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgprocessor
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// The fields of the group ex switching the msg features of the group, e.g. {"allowMemberPinMsg": true},
+// they are set by the owner or admins through SetGroupInfo.
+const (
+	// GroupAllowMemberPinMsgExKey allows the ordinary members to pin msgs, only the owner and admins can by default.
+	GroupAllowMemberPinMsgExKey = "allowMemberPinMsg"
+)
+
+// IsGroupMemberPinMsgAllowed reports whether the group with the ex allows the ordinary members to pin msgs.
+func IsGroupMemberPinMsgAllowed(groupEx string) bool {
+	return getGroupExSwitch(groupEx, GroupAllowMemberPinMsgExKey)
+}
+
+func getGroupExSwitch(groupEx string, key string) bool {
+	if groupEx == "" || !strings.Contains(groupEx, key) {
+		return false
+	}
+	var ex map[string]any
+	if err := json.Unmarshal([]byte(groupEx), &ex); err != nil {
+		return false
+	}
+	on, _ := ex[key].(bool)
+	return on
+}
@@ -171,3 +171,9 @@ func (o Options) IsSenderConversationUpdate() bool {
 func (o Options) IsReactionFromCache() bool {
 	return o.Is(constant.IsReactionFromCache)
 }
+
+// IsModified is set in the options of a pulled msg whose content has been modified after sending.
+const IsModified = "modified"
+
+// IsSensitiveWord is set in the options of a msg containing sensitive words when the sensitive-word filter only flags msgs.
+const IsSensitiveWord = "sensitiveWord"
@@ -0,0 +1,46 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgprocessor
+
+import (
+	"encoding/json"
+
+	"github.com/openimsdk/protocol/constant"
+)
+
+// GetMsgSearchText returns the text of the msg content matched by the full-text search, "" if the content type has no text.
+func GetMsgSearchText(contentType int32, content []byte) string {
+	var field string
+	switch contentType {
+	case constant.Text:
+		field = "content"
+	case constant.AtText, constant.Quote, constant.AdvancedText:
+		field = "text"
+	case constant.File:
+		field = "fileName"
+	case constant.Card:
+		field = "nickname"
+	case constant.Location, constant.Custom:
+		field = "description"
+	default:
+		return ""
+	}
+	var elem map[string]any
+	if err := json.Unmarshal(content, &elem); err != nil {
+		return ""
+	}
+	text, _ := elem[field].(string)
+	return text
+}
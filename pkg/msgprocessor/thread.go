@@ -0,0 +1,58 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgprocessor
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/sdkws"
+)
+
+// ThreadRootServerMsgIDKey is the attachedInfo field of a thread reply, it holds the serverMsgID of the thread root msg.
+const ThreadRootServerMsgIDKey = "threadRootServerMsgID"
+
+// ThreadRootSeqKey is the attachedInfo field of a thread reply, it holds the seq of the thread root msg.
+// It is required by the first reply of a thread, the root is checked by it.
+const ThreadRootSeqKey = "threadRootSeq"
+
+// GetThreadRootServerMsgID returns the serverMsgID of the thread root if the msg is a thread reply, otherwise "".
+func GetThreadRootServerMsgID(msg *sdkws.MsgData) string {
+	rootServerMsgID, _ := GetThreadRoot(msg)
+	return rootServerMsgID
+}
+
+// GetThreadRoot returns the serverMsgID and the seq of the thread root if the msg is a thread reply, otherwise "" and 0.
+// The seq is 0 if the reply does not carry it.
+func GetThreadRoot(msg *sdkws.MsgData) (string, int64) {
+	if msg.AttachedInfo == "" || !strings.Contains(msg.AttachedInfo, ThreadRootServerMsgIDKey) {
+		return "", 0
+	}
+	if msg.ContentType >= constant.NotificationBegin && msg.ContentType <= constant.NotificationEnd {
+		return "", 0
+	}
+	var info struct {
+		ThreadRootServerMsgID string `json:"threadRootServerMsgID"`
+		ThreadRootSeq         int64  `json:"threadRootSeq"`
+	}
+	if err := json.Unmarshal([]byte(msg.AttachedInfo), &info); err != nil {
+		return "", 0
+	}
+	if info.ThreadRootServerMsgID == "" || info.ThreadRootServerMsgID == msg.ServerMsgID {
+		return "", 0
+	}
+	return info.ThreadRootServerMsgID, info.ThreadRootSeq
+}
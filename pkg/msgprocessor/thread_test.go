@@ -0,0 +1,32 @@
+package msgprocessor
+
+import (
+	"testing"
+
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/sdkws"
+)
+
+func TestGetThreadRoot(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *sdkws.MsgData
+		root string
+		seq  int64
+	}{
+		{"reply", &sdkws.MsgData{ServerMsgID: "reply", ContentType: constant.Text, AttachedInfo: `{"threadRootServerMsgID":"root","threadRootSeq":7}`}, "root", 7},
+		{"reply without seq", &sdkws.MsgData{ServerMsgID: "reply", ContentType: constant.Text, AttachedInfo: `{"threadRootServerMsgID":"root"}`}, "root", 0},
+		{"root itself", &sdkws.MsgData{ServerMsgID: "root", ContentType: constant.Text, AttachedInfo: `{"threadRootServerMsgID":"root","threadRootSeq":7}`}, "", 0},
+		{"notification", &sdkws.MsgData{ServerMsgID: "reply", ContentType: constant.NotificationBegin, AttachedInfo: `{"threadRootServerMsgID":"root"}`}, "", 0},
+		{"invalid", &sdkws.MsgData{ServerMsgID: "reply", ContentType: constant.Text, AttachedInfo: `threadRootServerMsgID`}, "", 0},
+		{"plain", &sdkws.MsgData{ServerMsgID: "reply", ContentType: constant.Text}, "", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root, seq := GetThreadRoot(tt.msg)
+			if root != tt.root || seq != tt.seq {
+				t.Errorf("GetThreadRoot() = %s %d, want %s %d", root, seq, tt.root, tt.seq)
+			}
+		})
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/openimsdk/protocol/constant"
+)
+
+// OnExceed of the login rules.
+const (
+	KickOldest   = "kickOldest"
+	RejectNewest = "rejectNewest"
+)
+
+// Types of the ephemeral msgs.
+const (
+	EphemeralTyping         = 1
+	EphemeralRecordingVoice = 2
+	EphemeralCustom         = 3
+)
+
+// MaxEphemeralContentLen is the max length of the content of an ephemeral msg.
+const MaxEphemeralContentLen = 1024
+
+func (x *EphemeralMsg) Check() error {
+	switch x.SessionType {
+	case constant.SingleChatType:
+		if x.RecvID == "" {
+			return errors.New("recvID is empty")
+		}
+	case constant.ReadGroupChatType:
+		if x.GroupID == "" {
+			return errors.New("groupID is empty")
+		}
+	default:
+		return errors.New("sessionType is invalid")
+	}
+	if x.Type < EphemeralTyping || x.Type > EphemeralCustom {
+		return errors.New("type is invalid")
+	}
+	if len(x.Content) > MaxEphemeralContentLen {
+		return errors.New("content is too long")
+	}
+	return nil
+}
+
+func (x *GetUserConnsReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	return nil
+}
+
+// MaxListConnsShowNumber is the max showNumber of ListConnsReq.
+const MaxListConnsShowNumber = 1000
+
+// MaxListConnsDepth is the max pageNumber * showNumber of ListConnsReq, each node returns the connections
+// up to the end of the page, the deeper connections are listed by filtering userIDs.
+const MaxListConnsDepth = 10000
+
+func (x *ListConnsReq) Check() error {
+	if x.PageNumber <= 0 {
+		return errors.New("pageNumber is invalid")
+	}
+	if x.ShowNumber <= 0 || x.ShowNumber > MaxListConnsShowNumber {
+		return errors.New("showNumber is invalid")
+	}
+	if x.End() > MaxListConnsDepth {
+		return errors.New("page is too deep, filter the connections by userIDs")
+	}
+	return nil
+}
+
+// End returns the number of the connections up to the end of the page.
+func (x *ListConnsReq) End() int {
+	return int(x.PageNumber) * int(x.ShowNumber)
+}
+
+func (x *KickConnReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.ConnID == "" {
+		return errors.New("connID is empty")
+	}
+	return nil
+}
+
+// SortConnInfos orders the connections by connectTime and connID.
+func SortConnInfos(conns []*ConnInfo) {
+	sort.Slice(conns, func(i, j int) bool {
+		if conns[i].ConnectTime == conns[j].ConnectTime {
+			return conns[i].ConnID < conns[j].ConnID
+		}
+		return conns[i].ConnectTime < conns[j].ConnectTime
+	})
+}
+
+func (x *SetLoginRulesReq) Check() error {
+	if x.LoginRules == nil {
+		return errors.New("loginRules is empty")
+	}
+	return x.LoginRules.Check()
+}
+
+func (x *LoginRules) Check() error {
+	classes := make(map[string]struct{}, len(x.Rules))
+	for _, rule := range x.Rules {
+		if rule.PlatformClass == "" {
+			return errors.New("platformClass is empty")
+		}
+		if _, ok := classes[rule.PlatformClass]; ok {
+			return errors.New("platformClass is repeated")
+		}
+		classes[rule.PlatformClass] = struct{}{}
+		if rule.MaxConns < 0 {
+			return errors.New("maxConns is invalid")
+		}
+		if rule.OnExceed != KickOldest && rule.OnExceed != RejectNewest {
+			return errors.New("onExceed is invalid")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,322 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: gateway/gateway.proto
+
+package gateway
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	GatewayAdmin_Drain_FullMethodName         = "/openim.gateway.GatewayAdmin/Drain"
+	GatewayAdmin_GetUserConns_FullMethodName  = "/openim.gateway.GatewayAdmin/GetUserConns"
+	GatewayAdmin_ListConns_FullMethodName     = "/openim.gateway.GatewayAdmin/ListConns"
+	GatewayAdmin_KickConn_FullMethodName      = "/openim.gateway.GatewayAdmin/KickConn"
+	GatewayAdmin_GetLoginRules_FullMethodName = "/openim.gateway.GatewayAdmin/GetLoginRules"
+	GatewayAdmin_SetLoginRules_FullMethodName = "/openim.gateway.GatewayAdmin/SetLoginRules"
+)
+
+// GatewayAdminClient is the client API for GatewayAdmin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GatewayAdminClient interface {
+	// Drain stops accepting connections, deregisters the node from discovery and asks the connected
+	// clients to reconnect to the other nodes, the node exits once they are gone or the timeout fires.
+	Drain(ctx context.Context, in *DrainReq, opts ...grpc.CallOption) (*DrainResp, error)
+	// GetUserConns returns the connections of the user on the node.
+	GetUserConns(ctx context.Context, in *GetUserConnsReq, opts ...grpc.CallOption) (*GetUserConnsResp, error)
+	// ListConns returns the connections of the node ordered by connectTime and connID, up to the end of the page,
+	// so the page of the connections of all the nodes is in the merged lists of the nodes.
+	ListConns(ctx context.Context, in *ListConnsReq, opts ...grpc.CallOption) (*ListConnsResp, error)
+	// KickConn closes the connection of the user on the node and asks the client to reconnect, the user is not
+	// logged out and the connection cannot resume.
+	KickConn(ctx context.Context, in *KickConnReq, opts ...grpc.CallOption) (*KickConnResp, error)
+	GetLoginRules(ctx context.Context, in *GetLoginRulesReq, opts ...grpc.CallOption) (*GetLoginRulesResp, error)
+	// SetLoginRules replaces the login rules of all the nodes, they are saved in redis and replace the rules of the config.
+	SetLoginRules(ctx context.Context, in *SetLoginRulesReq, opts ...grpc.CallOption) (*SetLoginRulesResp, error)
+}
+
+type gatewayAdminClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGatewayAdminClient(cc grpc.ClientConnInterface) GatewayAdminClient {
+	return &gatewayAdminClient{cc}
+}
+
+func (c *gatewayAdminClient) Drain(ctx context.Context, in *DrainReq, opts ...grpc.CallOption) (*DrainResp, error) {
+	out := new(DrainResp)
+	err := c.cc.Invoke(ctx, GatewayAdmin_Drain_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayAdminClient) GetUserConns(ctx context.Context, in *GetUserConnsReq, opts ...grpc.CallOption) (*GetUserConnsResp, error) {
+	out := new(GetUserConnsResp)
+	err := c.cc.Invoke(ctx, GatewayAdmin_GetUserConns_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayAdminClient) ListConns(ctx context.Context, in *ListConnsReq, opts ...grpc.CallOption) (*ListConnsResp, error) {
+	out := new(ListConnsResp)
+	err := c.cc.Invoke(ctx, GatewayAdmin_ListConns_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayAdminClient) KickConn(ctx context.Context, in *KickConnReq, opts ...grpc.CallOption) (*KickConnResp, error) {
+	out := new(KickConnResp)
+	err := c.cc.Invoke(ctx, GatewayAdmin_KickConn_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayAdminClient) GetLoginRules(ctx context.Context, in *GetLoginRulesReq, opts ...grpc.CallOption) (*GetLoginRulesResp, error) {
+	out := new(GetLoginRulesResp)
+	err := c.cc.Invoke(ctx, GatewayAdmin_GetLoginRules_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayAdminClient) SetLoginRules(ctx context.Context, in *SetLoginRulesReq, opts ...grpc.CallOption) (*SetLoginRulesResp, error) {
+	out := new(SetLoginRulesResp)
+	err := c.cc.Invoke(ctx, GatewayAdmin_SetLoginRules_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GatewayAdminServer is the server API for GatewayAdmin service.
+// All implementations should embed UnimplementedGatewayAdminServer
+// for forward compatibility
+type GatewayAdminServer interface {
+	// Drain stops accepting connections, deregisters the node from discovery and asks the connected
+	// clients to reconnect to the other nodes, the node exits once they are gone or the timeout fires.
+	Drain(context.Context, *DrainReq) (*DrainResp, error)
+	// GetUserConns returns the connections of the user on the node.
+	GetUserConns(context.Context, *GetUserConnsReq) (*GetUserConnsResp, error)
+	// ListConns returns the connections of the node ordered by connectTime and connID, up to the end of the page,
+	// so the page of the connections of all the nodes is in the merged lists of the nodes.
+	ListConns(context.Context, *ListConnsReq) (*ListConnsResp, error)
+	// KickConn closes the connection of the user on the node and asks the client to reconnect, the user is not
+	// logged out and the connection cannot resume.
+	KickConn(context.Context, *KickConnReq) (*KickConnResp, error)
+	GetLoginRules(context.Context, *GetLoginRulesReq) (*GetLoginRulesResp, error)
+	// SetLoginRules replaces the login rules of all the nodes, they are saved in redis and replace the rules of the config.
+	SetLoginRules(context.Context, *SetLoginRulesReq) (*SetLoginRulesResp, error)
+}
+
+// UnimplementedGatewayAdminServer should be embedded to have forward compatible implementations.
+type UnimplementedGatewayAdminServer struct {
+}
+
+func (UnimplementedGatewayAdminServer) Drain(context.Context, *DrainReq) (*DrainResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Drain not implemented")
+}
+func (UnimplementedGatewayAdminServer) GetUserConns(context.Context, *GetUserConnsReq) (*GetUserConnsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserConns not implemented")
+}
+func (UnimplementedGatewayAdminServer) ListConns(context.Context, *ListConnsReq) (*ListConnsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConns not implemented")
+}
+func (UnimplementedGatewayAdminServer) KickConn(context.Context, *KickConnReq) (*KickConnResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KickConn not implemented")
+}
+func (UnimplementedGatewayAdminServer) GetLoginRules(context.Context, *GetLoginRulesReq) (*GetLoginRulesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLoginRules not implemented")
+}
+func (UnimplementedGatewayAdminServer) SetLoginRules(context.Context, *SetLoginRulesReq) (*SetLoginRulesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLoginRules not implemented")
+}
+
+// UnsafeGatewayAdminServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GatewayAdminServer will
+// result in compilation errors.
+type UnsafeGatewayAdminServer interface {
+	mustEmbedUnimplementedGatewayAdminServer()
+}
+
+func RegisterGatewayAdminServer(s grpc.ServiceRegistrar, srv GatewayAdminServer) {
+	s.RegisterService(&GatewayAdmin_ServiceDesc, srv)
+}
+
+func _GatewayAdmin_Drain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrainReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayAdminServer).Drain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayAdmin_Drain_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayAdminServer).Drain(ctx, req.(*DrainReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayAdmin_GetUserConns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserConnsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayAdminServer).GetUserConns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayAdmin_GetUserConns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayAdminServer).GetUserConns(ctx, req.(*GetUserConnsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayAdmin_ListConns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConnsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayAdminServer).ListConns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayAdmin_ListConns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayAdminServer).ListConns(ctx, req.(*ListConnsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayAdmin_KickConn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KickConnReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayAdminServer).KickConn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayAdmin_KickConn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayAdminServer).KickConn(ctx, req.(*KickConnReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayAdmin_GetLoginRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLoginRulesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayAdminServer).GetLoginRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayAdmin_GetLoginRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayAdminServer).GetLoginRules(ctx, req.(*GetLoginRulesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayAdmin_SetLoginRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLoginRulesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayAdminServer).SetLoginRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayAdmin_SetLoginRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayAdminServer).SetLoginRules(ctx, req.(*SetLoginRulesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GatewayAdmin_ServiceDesc is the grpc.ServiceDesc for GatewayAdmin service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GatewayAdmin_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "openim.gateway.GatewayAdmin",
+	HandlerType: (*GatewayAdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Drain",
+			Handler:    _GatewayAdmin_Drain_Handler,
+		},
+		{
+			MethodName: "GetUserConns",
+			Handler:    _GatewayAdmin_GetUserConns_Handler,
+		},
+		{
+			MethodName: "ListConns",
+			Handler:    _GatewayAdmin_ListConns_Handler,
+		},
+		{
+			MethodName: "KickConn",
+			Handler:    _GatewayAdmin_KickConn_Handler,
+		},
+		{
+			MethodName: "GetLoginRules",
+			Handler:    _GatewayAdmin_GetLoginRules_Handler,
+		},
+		{
+			MethodName: "SetLoginRules",
+			Handler:    _GatewayAdmin_SetLoginRules_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "gateway/gateway.proto",
+}
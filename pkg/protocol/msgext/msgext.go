@@ -0,0 +1,391 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgext
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/openimsdk/protocol/sdkws"
+)
+
+// Notification content types of the message extensions, they follow the msg notifications of
+// github.com/openimsdk/protocol/constant (ClearConversationNotification, DeleteMsgsNotification).
+const (
+	MsgModifiedNotification        = 2103
+	MsgReactionChangedNotification = 2104
+	ThreadHasReadNotification      = 2105
+	MsgPinChangedNotification      = 2106
+)
+
+// Status of the scheduled msgs.
+const (
+	ScheduledMsgPending  = 1
+	ScheduledMsgSending  = 2
+	ScheduledMsgSent     = 3
+	ScheduledMsgFailed   = 4
+	ScheduledMsgCanceled = 5
+	// ScheduledMsgUnknown is a msg whose claim timed out after its sending started, it may or may not have been sent
+	// and is left for manual review instead of being sent again. A claim timed out before sending is claimed again.
+	ScheduledMsgUnknown = 6
+)
+
+// MaxPinnedMsgs is the max number of the pinned msgs of a conversation.
+const MaxPinnedMsgs = 100
+
+// MaxReactionKeyLen is the max length of a reaction key, e.g. an emoji or a custom reaction name.
+const MaxReactionKeyLen = 64
+
+func (x *ModifyMsgReq) Check() error {
+	if x.ConversationID == "" {
+		return errors.New("conversationID is empty")
+	}
+	if x.Seq < 1 {
+		return errors.New("seq is invalid")
+	}
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.Content == "" {
+		return errors.New("content is empty")
+	}
+	return nil
+}
+
+func (x *GetMsgModifyHistoryReq) Check() error {
+	if x.ConversationID == "" {
+		return errors.New("conversationID is empty")
+	}
+	if x.Seq < 1 {
+		return errors.New("seq is invalid")
+	}
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	return nil
+}
+
+func checkReactionKey(reactionKey string) error {
+	if reactionKey == "" {
+		return errors.New("reactionKey is empty")
+	}
+	if len(reactionKey) > MaxReactionKeyLen {
+		return errors.New("reactionKey is too long")
+	}
+	// the reaction key is used as a field name of the msg document
+	if strings.ContainsAny(reactionKey, ".$") {
+		return errors.New("reactionKey must not contain '.' or '$'")
+	}
+	return nil
+}
+
+func (x *AddMessageReactionReq) Check() error {
+	if x.ConversationID == "" {
+		return errors.New("conversationID is empty")
+	}
+	if x.Seq < 1 {
+		return errors.New("seq is invalid")
+	}
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	return checkReactionKey(x.ReactionKey)
+}
+
+func (x *RemoveMessageReactionReq) Check() error {
+	if x.ConversationID == "" {
+		return errors.New("conversationID is empty")
+	}
+	if x.Seq < 1 {
+		return errors.New("seq is invalid")
+	}
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	return checkReactionKey(x.ReactionKey)
+}
+
+func (x *GetMessagesReactionReq) Check() error {
+	if x.ConversationID == "" {
+		return errors.New("conversationID is empty")
+	}
+	if len(x.Seqs) == 0 {
+		return errors.New("seqs is empty")
+	}
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	return nil
+}
+
+func (x *GetThreadRepliesReq) Check() error {
+	if x.ConversationID == "" {
+		return errors.New("conversationID is empty")
+	}
+	if x.RootServerMsgID == "" {
+		return errors.New("rootServerMsgID is empty")
+	}
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.Pagination == nil {
+		return errors.New("pagination is empty")
+	}
+	if x.Pagination.PageNumber < 1 {
+		return errors.New("pageNumber is invalid")
+	}
+	return nil
+}
+
+func (x *MarkThreadAsReadReq) Check() error {
+	if x.ConversationID == "" {
+		return errors.New("conversationID is empty")
+	}
+	if x.RootServerMsgID == "" {
+		return errors.New("rootServerMsgID is empty")
+	}
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.HasReadThreadSeq < 0 {
+		return errors.New("hasReadThreadSeq is invalid")
+	}
+	return nil
+}
+
+func (x *ScheduleSendMsgReq) Check() error {
+	if x.MsgData == nil {
+		return errors.New("msgData is empty")
+	}
+	if x.MsgData.SendID == "" {
+		return errors.New("sendID is empty")
+	}
+	if x.SendTime <= 0 {
+		return errors.New("sendTime is invalid")
+	}
+	return nil
+}
+
+func (x *CancelScheduledMsgReq) Check() error {
+	if x.ScheduleID == "" {
+		return errors.New("scheduleID is empty")
+	}
+	return nil
+}
+
+func (x *GetScheduledMsgsReq) Check() error {
+	if x.Pagination == nil {
+		return errors.New("pagination is empty")
+	}
+	if x.Pagination.PageNumber < 1 {
+		return errors.New("pageNumber is invalid")
+	}
+	if x.Status < 0 || x.Status > ScheduledMsgUnknown {
+		return errors.New("status is invalid")
+	}
+	return nil
+}
+
+func (x *ClaimDueScheduledMsgsReq) Check() error {
+	if x.Count < 1 {
+		return errors.New("count is invalid")
+	}
+	return nil
+}
+
+func (x *SendScheduledMsgReq) Check() error {
+	if x.ScheduleID == "" {
+		return errors.New("scheduleID is empty")
+	}
+	if x.ClaimID == "" {
+		return errors.New("claimID is empty")
+	}
+	return nil
+}
+
+func (x *IndexMsgsReq) Check() error {
+	if x.ConversationID == "" {
+		return errors.New("conversationID is empty")
+	}
+	return nil
+}
+
+func (x *DeleteIndexedMsgsReq) Check() error {
+	if x.ConversationID == "" {
+		return errors.New("conversationID is empty")
+	}
+	return nil
+}
+
+// MaxSearchMsgShowNumber is the max showNumber of the msg searches.
+const MaxSearchMsgShowNumber = 100
+
+// MaxSearchMsgDepth is the max pageNumber * showNumber of the msg searches, the index collects and sorts
+// the hits up to the end of the page, the deeper msgs are searched by narrowing the filter.
+const MaxSearchMsgDepth = 1000
+
+func checkSearchMsgPagination(pagination *sdkws.RequestPagination) error {
+	if pagination == nil {
+		return errors.New("pagination is empty")
+	}
+	if pagination.PageNumber < 1 {
+		return errors.New("pageNumber is invalid")
+	}
+	if pagination.ShowNumber < 1 || pagination.ShowNumber > MaxSearchMsgShowNumber {
+		return errors.New("showNumber is invalid")
+	}
+	if int(pagination.PageNumber)*int(pagination.ShowNumber) > MaxSearchMsgDepth {
+		return errors.New("page is too deep, narrow the search by the time range or the conversation")
+	}
+	return nil
+}
+
+func (x *SearchMsgIndexReq) Check() error {
+	if x.Filter == nil {
+		return errors.New("filter is empty")
+	}
+	return checkSearchMsgPagination(x.Pagination)
+}
+
+func (x *SearchMsgReq) Check() error {
+	if err := checkSearchMsgPagination(x.Pagination); err != nil {
+		return err
+	}
+	if x.StartTime < 0 || x.EndTime < 0 {
+		return errors.New("time range is invalid")
+	}
+	if x.EndTime != 0 && x.StartTime > x.EndTime {
+		return errors.New("startTime is after endTime")
+	}
+	return nil
+}
+
+// MaxSensitiveWordLen is the max length of a sensitive word.
+const MaxSensitiveWordLen = 128
+
+func checkSensitiveWords(words []string) error {
+	if len(words) == 0 {
+		return errors.New("words is empty")
+	}
+	for _, word := range words {
+		if strings.TrimSpace(word) == "" {
+			return errors.New("word is empty")
+		}
+		if len(word) > MaxSensitiveWordLen {
+			return errors.New("word is too long")
+		}
+	}
+	return nil
+}
+
+func (x *AddSensitiveWordsReq) Check() error {
+	return checkSensitiveWords(x.Words)
+}
+
+func (x *DeleteSensitiveWordsReq) Check() error {
+	return checkSensitiveWords(x.Words)
+}
+
+func (x *SearchSensitiveWordsReq) Check() error {
+	if x.Pagination == nil {
+		return errors.New("pagination is empty")
+	}
+	if x.Pagination.PageNumber < 1 {
+		return errors.New("pageNumber is invalid")
+	}
+	return nil
+}
+
+func (x *SetGroupReadReceiptReq) Check() error {
+	if x.GroupID == "" {
+		return errors.New("groupID is empty")
+	}
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	return nil
+}
+
+func (x *GetGroupReadReceiptReq) Check() error {
+	if x.GroupID == "" {
+		return errors.New("groupID is empty")
+	}
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	return nil
+}
+
+func (x *GetGroupMsgReadMembersReq) Check() error {
+	if x.ConversationID == "" {
+		return errors.New("conversationID is empty")
+	}
+	if x.Seq < 1 {
+		return errors.New("seq is invalid")
+	}
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.Pagination == nil {
+		return errors.New("pagination is empty")
+	}
+	if x.Pagination.PageNumber < 1 {
+		return errors.New("pageNumber is invalid")
+	}
+	return nil
+}
+
+func (x *PinMsgReq) Check() error {
+	if x.ConversationID == "" {
+		return errors.New("conversationID is empty")
+	}
+	if x.Seq < 1 {
+		return errors.New("seq is invalid")
+	}
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	return nil
+}
+
+func (x *UnpinMsgReq) Check() error {
+	if x.ConversationID == "" {
+		return errors.New("conversationID is empty")
+	}
+	if x.Seq < 1 {
+		return errors.New("seq is invalid")
+	}
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	return nil
+}
+
+func (x *GetPinnedMsgsReq) Check() error {
+	if x.ConversationID == "" {
+		return errors.New("conversationID is empty")
+	}
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	return nil
+}
+
+func (x *GetConversationsPinnedMsgsReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	return nil
+}
@@ -0,0 +1,5924 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        (unknown)
+// source: msgext/msgext.proto
+
+package msgext
+
+import (
+	msg "github.com/openimsdk/protocol/msg"
+	sdkws "github.com/openimsdk/protocol/sdkws"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ModifyMsgReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConversationID string `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID"`
+	Seq            int64  `protobuf:"varint,2,opt,name=seq,proto3" json:"seq"`
+	UserID         string `protobuf:"bytes,3,opt,name=userID,proto3" json:"userID"`
+	Content        string `protobuf:"bytes,4,opt,name=content,proto3" json:"content"`
+}
+
+func (x *ModifyMsgReq) Reset() {
+	*x = ModifyMsgReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ModifyMsgReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModifyMsgReq) ProtoMessage() {}
+
+func (x *ModifyMsgReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModifyMsgReq.ProtoReflect.Descriptor instead.
+func (*ModifyMsgReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ModifyMsgReq) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *ModifyMsgReq) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *ModifyMsgReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *ModifyMsgReq) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type ModifyMsgResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ModifyTime int64 `protobuf:"varint,1,opt,name=modifyTime,proto3" json:"modifyTime"`
+}
+
+func (x *ModifyMsgResp) Reset() {
+	*x = ModifyMsgResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ModifyMsgResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModifyMsgResp) ProtoMessage() {}
+
+func (x *ModifyMsgResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModifyMsgResp.ProtoReflect.Descriptor instead.
+func (*ModifyMsgResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ModifyMsgResp) GetModifyTime() int64 {
+	if x != nil {
+		return x.ModifyTime
+	}
+	return 0
+}
+
+type MsgModifiedTips struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ModifierUserID string `protobuf:"bytes,1,opt,name=modifierUserID,proto3" json:"modifierUserID"`
+	ConversationID string `protobuf:"bytes,2,opt,name=conversationID,proto3" json:"conversationID"`
+	Seq            int64  `protobuf:"varint,3,opt,name=seq,proto3" json:"seq"`
+	ClientMsgID    string `protobuf:"bytes,4,opt,name=clientMsgID,proto3" json:"clientMsgID"`
+	SessionType    int32  `protobuf:"varint,5,opt,name=sessionType,proto3" json:"sessionType"`
+	Content        string `protobuf:"bytes,6,opt,name=content,proto3" json:"content"`
+	ModifyTime     int64  `protobuf:"varint,7,opt,name=modifyTime,proto3" json:"modifyTime"`
+	IsAdminModify  bool   `protobuf:"varint,8,opt,name=isAdminModify,proto3" json:"isAdminModify"`
+}
+
+func (x *MsgModifiedTips) Reset() {
+	*x = MsgModifiedTips{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgModifiedTips) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgModifiedTips) ProtoMessage() {}
+
+func (x *MsgModifiedTips) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsgModifiedTips.ProtoReflect.Descriptor instead.
+func (*MsgModifiedTips) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MsgModifiedTips) GetModifierUserID() string {
+	if x != nil {
+		return x.ModifierUserID
+	}
+	return ""
+}
+
+func (x *MsgModifiedTips) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *MsgModifiedTips) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *MsgModifiedTips) GetClientMsgID() string {
+	if x != nil {
+		return x.ClientMsgID
+	}
+	return ""
+}
+
+func (x *MsgModifiedTips) GetSessionType() int32 {
+	if x != nil {
+		return x.SessionType
+	}
+	return 0
+}
+
+func (x *MsgModifiedTips) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *MsgModifiedTips) GetModifyTime() int64 {
+	if x != nil {
+		return x.ModifyTime
+	}
+	return 0
+}
+
+func (x *MsgModifiedTips) GetIsAdminModify() bool {
+	if x != nil {
+		return x.IsAdminModify
+	}
+	return false
+}
+
+// MsgModifyRecord is one edit of a message, it keeps the content that was replaced.
+type MsgModifyRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID   string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	Nickname string `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname"`
+	Content  string `protobuf:"bytes,3,opt,name=content,proto3" json:"content"`
+	// in milliseconds
+	ModifyTime int64 `protobuf:"varint,4,opt,name=modifyTime,proto3" json:"modifyTime"`
+}
+
+func (x *MsgModifyRecord) Reset() {
+	*x = MsgModifyRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgModifyRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgModifyRecord) ProtoMessage() {}
+
+func (x *MsgModifyRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsgModifyRecord.ProtoReflect.Descriptor instead.
+func (*MsgModifyRecord) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MsgModifyRecord) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *MsgModifyRecord) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *MsgModifyRecord) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *MsgModifyRecord) GetModifyTime() int64 {
+	if x != nil {
+		return x.ModifyTime
+	}
+	return 0
+}
+
+type GetMsgModifyHistoryReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConversationID string `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID"`
+	Seq            int64  `protobuf:"varint,2,opt,name=seq,proto3" json:"seq"`
+	UserID         string `protobuf:"bytes,3,opt,name=userID,proto3" json:"userID"`
+}
+
+func (x *GetMsgModifyHistoryReq) Reset() {
+	*x = GetMsgModifyHistoryReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMsgModifyHistoryReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMsgModifyHistoryReq) ProtoMessage() {}
+
+func (x *GetMsgModifyHistoryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMsgModifyHistoryReq.ProtoReflect.Descriptor instead.
+func (*GetMsgModifyHistoryReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetMsgModifyHistoryReq) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *GetMsgModifyHistoryReq) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *GetMsgModifyHistoryReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type GetMsgModifyHistoryResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the latest edits, the oldest first
+	Records []*MsgModifyRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+}
+
+func (x *GetMsgModifyHistoryResp) Reset() {
+	*x = GetMsgModifyHistoryResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMsgModifyHistoryResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMsgModifyHistoryResp) ProtoMessage() {}
+
+func (x *GetMsgModifyHistoryResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMsgModifyHistoryResp.ProtoReflect.Descriptor instead.
+func (*GetMsgModifyHistoryResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetMsgModifyHistoryResp) GetRecords() []*MsgModifyRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+type MessageReaction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReactionKey string   `protobuf:"bytes,1,opt,name=reactionKey,proto3" json:"reactionKey"`
+	UserIDs     []string `protobuf:"bytes,2,rep,name=userIDs,proto3" json:"userIDs"`
+	Count       int64    `protobuf:"varint,3,opt,name=count,proto3" json:"count"`
+}
+
+func (x *MessageReaction) Reset() {
+	*x = MessageReaction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessageReaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageReaction) ProtoMessage() {}
+
+func (x *MessageReaction) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageReaction.ProtoReflect.Descriptor instead.
+func (*MessageReaction) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MessageReaction) GetReactionKey() string {
+	if x != nil {
+		return x.ReactionKey
+	}
+	return ""
+}
+
+func (x *MessageReaction) GetUserIDs() []string {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+func (x *MessageReaction) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type MessageReactions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seq         int64              `protobuf:"varint,1,opt,name=seq,proto3" json:"seq"`
+	ClientMsgID string             `protobuf:"bytes,2,opt,name=clientMsgID,proto3" json:"clientMsgID"`
+	Reactions   []*MessageReaction `protobuf:"bytes,3,rep,name=reactions,proto3" json:"reactions"`
+}
+
+func (x *MessageReactions) Reset() {
+	*x = MessageReactions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessageReactions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageReactions) ProtoMessage() {}
+
+func (x *MessageReactions) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageReactions.ProtoReflect.Descriptor instead.
+func (*MessageReactions) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *MessageReactions) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *MessageReactions) GetClientMsgID() string {
+	if x != nil {
+		return x.ClientMsgID
+	}
+	return ""
+}
+
+func (x *MessageReactions) GetReactions() []*MessageReaction {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
+type AddMessageReactionReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConversationID string `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID"`
+	Seq            int64  `protobuf:"varint,2,opt,name=seq,proto3" json:"seq"`
+	UserID         string `protobuf:"bytes,3,opt,name=userID,proto3" json:"userID"`
+	ReactionKey    string `protobuf:"bytes,4,opt,name=reactionKey,proto3" json:"reactionKey"`
+}
+
+func (x *AddMessageReactionReq) Reset() {
+	*x = AddMessageReactionReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddMessageReactionReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddMessageReactionReq) ProtoMessage() {}
+
+func (x *AddMessageReactionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddMessageReactionReq.ProtoReflect.Descriptor instead.
+func (*AddMessageReactionReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AddMessageReactionReq) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *AddMessageReactionReq) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *AddMessageReactionReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *AddMessageReactionReq) GetReactionKey() string {
+	if x != nil {
+		return x.ReactionKey
+	}
+	return ""
+}
+
+type AddMessageReactionResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reaction *MessageReaction `protobuf:"bytes,1,opt,name=reaction,proto3" json:"reaction"`
+}
+
+func (x *AddMessageReactionResp) Reset() {
+	*x = AddMessageReactionResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddMessageReactionResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddMessageReactionResp) ProtoMessage() {}
+
+func (x *AddMessageReactionResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddMessageReactionResp.ProtoReflect.Descriptor instead.
+func (*AddMessageReactionResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AddMessageReactionResp) GetReaction() *MessageReaction {
+	if x != nil {
+		return x.Reaction
+	}
+	return nil
+}
+
+type RemoveMessageReactionReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConversationID string `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID"`
+	Seq            int64  `protobuf:"varint,2,opt,name=seq,proto3" json:"seq"`
+	UserID         string `protobuf:"bytes,3,opt,name=userID,proto3" json:"userID"`
+	ReactionKey    string `protobuf:"bytes,4,opt,name=reactionKey,proto3" json:"reactionKey"`
+}
+
+func (x *RemoveMessageReactionReq) Reset() {
+	*x = RemoveMessageReactionReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveMessageReactionReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveMessageReactionReq) ProtoMessage() {}
+
+func (x *RemoveMessageReactionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveMessageReactionReq.ProtoReflect.Descriptor instead.
+func (*RemoveMessageReactionReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RemoveMessageReactionReq) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *RemoveMessageReactionReq) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *RemoveMessageReactionReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *RemoveMessageReactionReq) GetReactionKey() string {
+	if x != nil {
+		return x.ReactionKey
+	}
+	return ""
+}
+
+type RemoveMessageReactionResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reaction *MessageReaction `protobuf:"bytes,1,opt,name=reaction,proto3" json:"reaction"`
+}
+
+func (x *RemoveMessageReactionResp) Reset() {
+	*x = RemoveMessageReactionResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveMessageReactionResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveMessageReactionResp) ProtoMessage() {}
+
+func (x *RemoveMessageReactionResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveMessageReactionResp.ProtoReflect.Descriptor instead.
+func (*RemoveMessageReactionResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RemoveMessageReactionResp) GetReaction() *MessageReaction {
+	if x != nil {
+		return x.Reaction
+	}
+	return nil
+}
+
+type GetMessagesReactionReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConversationID string  `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID"`
+	Seqs           []int64 `protobuf:"varint,2,rep,packed,name=seqs,proto3" json:"seqs"`
+	UserID         string  `protobuf:"bytes,3,opt,name=userID,proto3" json:"userID"`
+}
+
+func (x *GetMessagesReactionReq) Reset() {
+	*x = GetMessagesReactionReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMessagesReactionReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMessagesReactionReq) ProtoMessage() {}
+
+func (x *GetMessagesReactionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMessagesReactionReq.ProtoReflect.Descriptor instead.
+func (*GetMessagesReactionReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetMessagesReactionReq) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *GetMessagesReactionReq) GetSeqs() []int64 {
+	if x != nil {
+		return x.Seqs
+	}
+	return nil
+}
+
+func (x *GetMessagesReactionReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type GetMessagesReactionResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MsgReactions []*MessageReactions `protobuf:"bytes,1,rep,name=msgReactions,proto3" json:"msgReactions"`
+}
+
+func (x *GetMessagesReactionResp) Reset() {
+	*x = GetMessagesReactionResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMessagesReactionResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMessagesReactionResp) ProtoMessage() {}
+
+func (x *GetMessagesReactionResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMessagesReactionResp.ProtoReflect.Descriptor instead.
+func (*GetMessagesReactionResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetMessagesReactionResp) GetMsgReactions() []*MessageReactions {
+	if x != nil {
+		return x.MsgReactions
+	}
+	return nil
+}
+
+type MsgReactionChangedTips struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OpUserID       string           `protobuf:"bytes,1,opt,name=opUserID,proto3" json:"opUserID"`
+	ConversationID string           `protobuf:"bytes,2,opt,name=conversationID,proto3" json:"conversationID"`
+	Seq            int64            `protobuf:"varint,3,opt,name=seq,proto3" json:"seq"`
+	ClientMsgID    string           `protobuf:"bytes,4,opt,name=clientMsgID,proto3" json:"clientMsgID"`
+	SessionType    int32            `protobuf:"varint,5,opt,name=sessionType,proto3" json:"sessionType"`
+	IsAdd          bool             `protobuf:"varint,6,opt,name=isAdd,proto3" json:"isAdd"`
+	Reaction       *MessageReaction `protobuf:"bytes,7,opt,name=reaction,proto3" json:"reaction"`
+}
+
+func (x *MsgReactionChangedTips) Reset() {
+	*x = MsgReactionChangedTips{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgReactionChangedTips) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgReactionChangedTips) ProtoMessage() {}
+
+func (x *MsgReactionChangedTips) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsgReactionChangedTips.ProtoReflect.Descriptor instead.
+func (*MsgReactionChangedTips) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *MsgReactionChangedTips) GetOpUserID() string {
+	if x != nil {
+		return x.OpUserID
+	}
+	return ""
+}
+
+func (x *MsgReactionChangedTips) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *MsgReactionChangedTips) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *MsgReactionChangedTips) GetClientMsgID() string {
+	if x != nil {
+		return x.ClientMsgID
+	}
+	return ""
+}
+
+func (x *MsgReactionChangedTips) GetSessionType() int32 {
+	if x != nil {
+		return x.SessionType
+	}
+	return 0
+}
+
+func (x *MsgReactionChangedTips) GetIsAdd() bool {
+	if x != nil {
+		return x.IsAdd
+	}
+	return false
+}
+
+func (x *MsgReactionChangedTips) GetReaction() *MessageReaction {
+	if x != nil {
+		return x.Reaction
+	}
+	return nil
+}
+
+type ThreadReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ThreadSeq int64          `protobuf:"varint,1,opt,name=threadSeq,proto3" json:"threadSeq"`
+	Msg       *sdkws.MsgData `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg"`
+}
+
+func (x *ThreadReply) Reset() {
+	*x = ThreadReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ThreadReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThreadReply) ProtoMessage() {}
+
+func (x *ThreadReply) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThreadReply.ProtoReflect.Descriptor instead.
+func (*ThreadReply) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ThreadReply) GetThreadSeq() int64 {
+	if x != nil {
+		return x.ThreadSeq
+	}
+	return 0
+}
+
+func (x *ThreadReply) GetMsg() *sdkws.MsgData {
+	if x != nil {
+		return x.Msg
+	}
+	return nil
+}
+
+type GetThreadRepliesReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConversationID  string                   `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID"`
+	RootServerMsgID string                   `protobuf:"bytes,2,opt,name=rootServerMsgID,proto3" json:"rootServerMsgID"`
+	UserID          string                   `protobuf:"bytes,3,opt,name=userID,proto3" json:"userID"`
+	Pagination      *sdkws.RequestPagination `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination"`
+}
+
+func (x *GetThreadRepliesReq) Reset() {
+	*x = GetThreadRepliesReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetThreadRepliesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetThreadRepliesReq) ProtoMessage() {}
+
+func (x *GetThreadRepliesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetThreadRepliesReq.ProtoReflect.Descriptor instead.
+func (*GetThreadRepliesReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetThreadRepliesReq) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *GetThreadRepliesReq) GetRootServerMsgID() string {
+	if x != nil {
+		return x.RootServerMsgID
+	}
+	return ""
+}
+
+func (x *GetThreadRepliesReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *GetThreadRepliesReq) GetPagination() *sdkws.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type GetThreadRepliesResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Replies          []*ThreadReply `protobuf:"bytes,1,rep,name=replies,proto3" json:"replies"`
+	MaxThreadSeq     int64          `protobuf:"varint,2,opt,name=maxThreadSeq,proto3" json:"maxThreadSeq"`
+	HasReadThreadSeq int64          `protobuf:"varint,3,opt,name=hasReadThreadSeq,proto3" json:"hasReadThreadSeq"`
+}
+
+func (x *GetThreadRepliesResp) Reset() {
+	*x = GetThreadRepliesResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetThreadRepliesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetThreadRepliesResp) ProtoMessage() {}
+
+func (x *GetThreadRepliesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetThreadRepliesResp.ProtoReflect.Descriptor instead.
+func (*GetThreadRepliesResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetThreadRepliesResp) GetReplies() []*ThreadReply {
+	if x != nil {
+		return x.Replies
+	}
+	return nil
+}
+
+func (x *GetThreadRepliesResp) GetMaxThreadSeq() int64 {
+	if x != nil {
+		return x.MaxThreadSeq
+	}
+	return 0
+}
+
+func (x *GetThreadRepliesResp) GetHasReadThreadSeq() int64 {
+	if x != nil {
+		return x.HasReadThreadSeq
+	}
+	return 0
+}
+
+type MarkThreadAsReadReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConversationID  string `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID"`
+	RootServerMsgID string `protobuf:"bytes,2,opt,name=rootServerMsgID,proto3" json:"rootServerMsgID"`
+	UserID          string `protobuf:"bytes,3,opt,name=userID,proto3" json:"userID"`
+	// mark all replies as read when hasReadThreadSeq is 0
+	HasReadThreadSeq int64 `protobuf:"varint,4,opt,name=hasReadThreadSeq,proto3" json:"hasReadThreadSeq"`
+}
+
+func (x *MarkThreadAsReadReq) Reset() {
+	*x = MarkThreadAsReadReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarkThreadAsReadReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkThreadAsReadReq) ProtoMessage() {}
+
+func (x *MarkThreadAsReadReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkThreadAsReadReq.ProtoReflect.Descriptor instead.
+func (*MarkThreadAsReadReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *MarkThreadAsReadReq) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *MarkThreadAsReadReq) GetRootServerMsgID() string {
+	if x != nil {
+		return x.RootServerMsgID
+	}
+	return ""
+}
+
+func (x *MarkThreadAsReadReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *MarkThreadAsReadReq) GetHasReadThreadSeq() int64 {
+	if x != nil {
+		return x.HasReadThreadSeq
+	}
+	return 0
+}
+
+type MarkThreadAsReadResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HasReadThreadSeq int64 `protobuf:"varint,1,opt,name=hasReadThreadSeq,proto3" json:"hasReadThreadSeq"`
+}
+
+func (x *MarkThreadAsReadResp) Reset() {
+	*x = MarkThreadAsReadResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarkThreadAsReadResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkThreadAsReadResp) ProtoMessage() {}
+
+func (x *MarkThreadAsReadResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkThreadAsReadResp.ProtoReflect.Descriptor instead.
+func (*MarkThreadAsReadResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *MarkThreadAsReadResp) GetHasReadThreadSeq() int64 {
+	if x != nil {
+		return x.HasReadThreadSeq
+	}
+	return 0
+}
+
+type ThreadUnread struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the number of the followed threads having unread replies
+	UnreadThreadCount int64 `protobuf:"varint,1,opt,name=unreadThreadCount,proto3" json:"unreadThreadCount"`
+	// the number of the unread replies of all followed threads
+	UnreadReplyCount int64 `protobuf:"varint,2,opt,name=unreadReplyCount,proto3" json:"unreadReplyCount"`
+}
+
+func (x *ThreadUnread) Reset() {
+	*x = ThreadUnread{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ThreadUnread) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThreadUnread) ProtoMessage() {}
+
+func (x *ThreadUnread) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThreadUnread.ProtoReflect.Descriptor instead.
+func (*ThreadUnread) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ThreadUnread) GetUnreadThreadCount() int64 {
+	if x != nil {
+		return x.UnreadThreadCount
+	}
+	return 0
+}
+
+func (x *ThreadUnread) GetUnreadReplyCount() int64 {
+	if x != nil {
+		return x.UnreadReplyCount
+	}
+	return 0
+}
+
+// GetConversationsHasReadAndMaxSeqWithThreadResp is the resp of openim.msg.GetConversationsHasReadAndMaxSeq with the
+// unread replies of the threads, the conversations without followed threads are not in threadUnreads.
+type GetConversationsHasReadAndMaxSeqWithThreadResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seqs          map[string]*msg.Seqs     `protobuf:"bytes,1,rep,name=seqs,proto3" json:"seqs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ThreadUnreads map[string]*ThreadUnread `protobuf:"bytes,2,rep,name=threadUnreads,proto3" json:"threadUnreads,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *GetConversationsHasReadAndMaxSeqWithThreadResp) Reset() {
+	*x = GetConversationsHasReadAndMaxSeqWithThreadResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetConversationsHasReadAndMaxSeqWithThreadResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConversationsHasReadAndMaxSeqWithThreadResp) ProtoMessage() {}
+
+func (x *GetConversationsHasReadAndMaxSeqWithThreadResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConversationsHasReadAndMaxSeqWithThreadResp.ProtoReflect.Descriptor instead.
+func (*GetConversationsHasReadAndMaxSeqWithThreadResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetConversationsHasReadAndMaxSeqWithThreadResp) GetSeqs() map[string]*msg.Seqs {
+	if x != nil {
+		return x.Seqs
+	}
+	return nil
+}
+
+func (x *GetConversationsHasReadAndMaxSeqWithThreadResp) GetThreadUnreads() map[string]*ThreadUnread {
+	if x != nil {
+		return x.ThreadUnreads
+	}
+	return nil
+}
+
+type ThreadHasReadTips struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID           string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	ConversationID   string `protobuf:"bytes,2,opt,name=conversationID,proto3" json:"conversationID"`
+	RootServerMsgID  string `protobuf:"bytes,3,opt,name=rootServerMsgID,proto3" json:"rootServerMsgID"`
+	HasReadThreadSeq int64  `protobuf:"varint,4,opt,name=hasReadThreadSeq,proto3" json:"hasReadThreadSeq"`
+}
+
+func (x *ThreadHasReadTips) Reset() {
+	*x = ThreadHasReadTips{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ThreadHasReadTips) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThreadHasReadTips) ProtoMessage() {}
+
+func (x *ThreadHasReadTips) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThreadHasReadTips.ProtoReflect.Descriptor instead.
+func (*ThreadHasReadTips) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ThreadHasReadTips) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *ThreadHasReadTips) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *ThreadHasReadTips) GetRootServerMsgID() string {
+	if x != nil {
+		return x.RootServerMsgID
+	}
+	return ""
+}
+
+func (x *ThreadHasReadTips) GetHasReadThreadSeq() int64 {
+	if x != nil {
+		return x.HasReadThreadSeq
+	}
+	return 0
+}
+
+type ScheduledMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ScheduleID string         `protobuf:"bytes,1,opt,name=scheduleID,proto3" json:"scheduleID"`
+	MsgData    *sdkws.MsgData `protobuf:"bytes,2,opt,name=msgData,proto3" json:"msgData"`
+	// the time to send the msg, in milliseconds
+	SendTime   int64 `protobuf:"varint,3,opt,name=sendTime,proto3" json:"sendTime"`
+	Status     int32 `protobuf:"varint,4,opt,name=status,proto3" json:"status"`
+	CreateTime int64 `protobuf:"varint,5,opt,name=createTime,proto3" json:"createTime"`
+	// the serverMsgID of the sent msg
+	ServerMsgID string `protobuf:"bytes,6,opt,name=serverMsgID,proto3" json:"serverMsgID"`
+	ErrMsg      string `protobuf:"bytes,7,opt,name=errMsg,proto3" json:"errMsg"`
+}
+
+func (x *ScheduledMsg) Reset() {
+	*x = ScheduledMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduledMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledMsg) ProtoMessage() {}
+
+func (x *ScheduledMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduledMsg.ProtoReflect.Descriptor instead.
+func (*ScheduledMsg) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ScheduledMsg) GetScheduleID() string {
+	if x != nil {
+		return x.ScheduleID
+	}
+	return ""
+}
+
+func (x *ScheduledMsg) GetMsgData() *sdkws.MsgData {
+	if x != nil {
+		return x.MsgData
+	}
+	return nil
+}
+
+func (x *ScheduledMsg) GetSendTime() int64 {
+	if x != nil {
+		return x.SendTime
+	}
+	return 0
+}
+
+func (x *ScheduledMsg) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *ScheduledMsg) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *ScheduledMsg) GetServerMsgID() string {
+	if x != nil {
+		return x.ServerMsgID
+	}
+	return ""
+}
+
+func (x *ScheduledMsg) GetErrMsg() string {
+	if x != nil {
+		return x.ErrMsg
+	}
+	return ""
+}
+
+type ScheduleSendMsgReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MsgData  *sdkws.MsgData `protobuf:"bytes,1,opt,name=msgData,proto3" json:"msgData"`
+	SendTime int64          `protobuf:"varint,2,opt,name=sendTime,proto3" json:"sendTime"`
+}
+
+func (x *ScheduleSendMsgReq) Reset() {
+	*x = ScheduleSendMsgReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduleSendMsgReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleSendMsgReq) ProtoMessage() {}
+
+func (x *ScheduleSendMsgReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleSendMsgReq.ProtoReflect.Descriptor instead.
+func (*ScheduleSendMsgReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ScheduleSendMsgReq) GetMsgData() *sdkws.MsgData {
+	if x != nil {
+		return x.MsgData
+	}
+	return nil
+}
+
+func (x *ScheduleSendMsgReq) GetSendTime() int64 {
+	if x != nil {
+		return x.SendTime
+	}
+	return 0
+}
+
+type ScheduleSendMsgResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ScheduleID string `protobuf:"bytes,1,opt,name=scheduleID,proto3" json:"scheduleID"`
+}
+
+func (x *ScheduleSendMsgResp) Reset() {
+	*x = ScheduleSendMsgResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduleSendMsgResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleSendMsgResp) ProtoMessage() {}
+
+func (x *ScheduleSendMsgResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleSendMsgResp.ProtoReflect.Descriptor instead.
+func (*ScheduleSendMsgResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ScheduleSendMsgResp) GetScheduleID() string {
+	if x != nil {
+		return x.ScheduleID
+	}
+	return ""
+}
+
+type CancelScheduledMsgReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ScheduleID string `protobuf:"bytes,1,opt,name=scheduleID,proto3" json:"scheduleID"`
+}
+
+func (x *CancelScheduledMsgReq) Reset() {
+	*x = CancelScheduledMsgReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelScheduledMsgReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledMsgReq) ProtoMessage() {}
+
+func (x *CancelScheduledMsgReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduledMsgReq.ProtoReflect.Descriptor instead.
+func (*CancelScheduledMsgReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CancelScheduledMsgReq) GetScheduleID() string {
+	if x != nil {
+		return x.ScheduleID
+	}
+	return ""
+}
+
+type CancelScheduledMsgResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CancelScheduledMsgResp) Reset() {
+	*x = CancelScheduledMsgResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelScheduledMsgResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledMsgResp) ProtoMessage() {}
+
+func (x *CancelScheduledMsgResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduledMsgResp.ProtoReflect.Descriptor instead.
+func (*CancelScheduledMsgResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{27}
+}
+
+type GetScheduledMsgsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// all senders when sendID is empty
+	SendID string `protobuf:"bytes,1,opt,name=sendID,proto3" json:"sendID"`
+	// all status when status is 0
+	Status     int32                    `protobuf:"varint,2,opt,name=status,proto3" json:"status"`
+	Pagination *sdkws.RequestPagination `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination"`
+}
+
+func (x *GetScheduledMsgsReq) Reset() {
+	*x = GetScheduledMsgsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetScheduledMsgsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScheduledMsgsReq) ProtoMessage() {}
+
+func (x *GetScheduledMsgsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScheduledMsgsReq.ProtoReflect.Descriptor instead.
+func (*GetScheduledMsgsReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetScheduledMsgsReq) GetSendID() string {
+	if x != nil {
+		return x.SendID
+	}
+	return ""
+}
+
+func (x *GetScheduledMsgsReq) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *GetScheduledMsgsReq) GetPagination() *sdkws.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type GetScheduledMsgsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total         int64           `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	ScheduledMsgs []*ScheduledMsg `protobuf:"bytes,2,rep,name=scheduledMsgs,proto3" json:"scheduledMsgs"`
+}
+
+func (x *GetScheduledMsgsResp) Reset() {
+	*x = GetScheduledMsgsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetScheduledMsgsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScheduledMsgsResp) ProtoMessage() {}
+
+func (x *GetScheduledMsgsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScheduledMsgsResp.ProtoReflect.Descriptor instead.
+func (*GetScheduledMsgsResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetScheduledMsgsResp) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetScheduledMsgsResp) GetScheduledMsgs() []*ScheduledMsg {
+	if x != nil {
+		return x.ScheduledMsgs
+	}
+	return nil
+}
+
+type ClaimDueScheduledMsgsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count"`
+}
+
+func (x *ClaimDueScheduledMsgsReq) Reset() {
+	*x = ClaimDueScheduledMsgsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClaimDueScheduledMsgsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimDueScheduledMsgsReq) ProtoMessage() {}
+
+func (x *ClaimDueScheduledMsgsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimDueScheduledMsgsReq.ProtoReflect.Descriptor instead.
+func (*ClaimDueScheduledMsgsReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ClaimDueScheduledMsgsReq) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ClaimDueScheduledMsgsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// claimID must be carried back when sending the claimed msgs
+	ClaimID       string          `protobuf:"bytes,1,opt,name=claimID,proto3" json:"claimID"`
+	ScheduledMsgs []*ScheduledMsg `protobuf:"bytes,2,rep,name=scheduledMsgs,proto3" json:"scheduledMsgs"`
+}
+
+func (x *ClaimDueScheduledMsgsResp) Reset() {
+	*x = ClaimDueScheduledMsgsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClaimDueScheduledMsgsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimDueScheduledMsgsResp) ProtoMessage() {}
+
+func (x *ClaimDueScheduledMsgsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimDueScheduledMsgsResp.ProtoReflect.Descriptor instead.
+func (*ClaimDueScheduledMsgsResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ClaimDueScheduledMsgsResp) GetClaimID() string {
+	if x != nil {
+		return x.ClaimID
+	}
+	return ""
+}
+
+func (x *ClaimDueScheduledMsgsResp) GetScheduledMsgs() []*ScheduledMsg {
+	if x != nil {
+		return x.ScheduledMsgs
+	}
+	return nil
+}
+
+type SendScheduledMsgReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ScheduleID string `protobuf:"bytes,1,opt,name=scheduleID,proto3" json:"scheduleID"`
+	ClaimID    string `protobuf:"bytes,2,opt,name=claimID,proto3" json:"claimID"`
+}
+
+func (x *SendScheduledMsgReq) Reset() {
+	*x = SendScheduledMsgReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendScheduledMsgReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendScheduledMsgReq) ProtoMessage() {}
+
+func (x *SendScheduledMsgReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendScheduledMsgReq.ProtoReflect.Descriptor instead.
+func (*SendScheduledMsgReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SendScheduledMsgReq) GetScheduleID() string {
+	if x != nil {
+		return x.ScheduleID
+	}
+	return ""
+}
+
+func (x *SendScheduledMsgReq) GetClaimID() string {
+	if x != nil {
+		return x.ClaimID
+	}
+	return ""
+}
+
+type SendScheduledMsgResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServerMsgID string `protobuf:"bytes,1,opt,name=serverMsgID,proto3" json:"serverMsgID"`
+}
+
+func (x *SendScheduledMsgResp) Reset() {
+	*x = SendScheduledMsgResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendScheduledMsgResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendScheduledMsgResp) ProtoMessage() {}
+
+func (x *SendScheduledMsgResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendScheduledMsgResp.ProtoReflect.Descriptor instead.
+func (*SendScheduledMsgResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SendScheduledMsgResp) GetServerMsgID() string {
+	if x != nil {
+		return x.ServerMsgID
+	}
+	return ""
+}
+
+type IndexMsgsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConversationID string           `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID"`
+	Msgs           []*sdkws.MsgData `protobuf:"bytes,2,rep,name=msgs,proto3" json:"msgs"`
+	// set by the msg rpc instance forwarding the msgs to the instance holding the index, which must not forward them again
+	Forwarded bool `protobuf:"varint,3,opt,name=forwarded,proto3" json:"forwarded"`
+}
+
+func (x *IndexMsgsReq) Reset() {
+	*x = IndexMsgsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IndexMsgsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IndexMsgsReq) ProtoMessage() {}
+
+func (x *IndexMsgsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IndexMsgsReq.ProtoReflect.Descriptor instead.
+func (*IndexMsgsReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *IndexMsgsReq) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *IndexMsgsReq) GetMsgs() []*sdkws.MsgData {
+	if x != nil {
+		return x.Msgs
+	}
+	return nil
+}
+
+func (x *IndexMsgsReq) GetForwarded() bool {
+	if x != nil {
+		return x.Forwarded
+	}
+	return false
+}
+
+type IndexMsgsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *IndexMsgsResp) Reset() {
+	*x = IndexMsgsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IndexMsgsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IndexMsgsResp) ProtoMessage() {}
+
+func (x *IndexMsgsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IndexMsgsResp.ProtoReflect.Descriptor instead.
+func (*IndexMsgsResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{35}
+}
+
+type DeleteIndexedMsgsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConversationID string  `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID"`
+	Seqs           []int64 `protobuf:"varint,2,rep,packed,name=seqs,proto3" json:"seqs"`
+}
+
+func (x *DeleteIndexedMsgsReq) Reset() {
+	*x = DeleteIndexedMsgsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteIndexedMsgsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteIndexedMsgsReq) ProtoMessage() {}
+
+func (x *DeleteIndexedMsgsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteIndexedMsgsReq.ProtoReflect.Descriptor instead.
+func (*DeleteIndexedMsgsReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *DeleteIndexedMsgsReq) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *DeleteIndexedMsgsReq) GetSeqs() []int64 {
+	if x != nil {
+		return x.Seqs
+	}
+	return nil
+}
+
+type DeleteIndexedMsgsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteIndexedMsgsResp) Reset() {
+	*x = DeleteIndexedMsgsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteIndexedMsgsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteIndexedMsgsResp) ProtoMessage() {}
+
+func (x *DeleteIndexedMsgsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteIndexedMsgsResp.ProtoReflect.Descriptor instead.
+func (*DeleteIndexedMsgsResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{37}
+}
+
+type MsgSearchFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Keyword         string   `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword"`
+	SendID          string   `protobuf:"bytes,2,opt,name=sendID,proto3" json:"sendID"`
+	RecvID          string   `protobuf:"bytes,3,opt,name=recvID,proto3" json:"recvID"`
+	ConversationIDs []string `protobuf:"bytes,4,rep,name=conversationIDs,proto3" json:"conversationIDs"`
+	ContentTypes    []int32  `protobuf:"varint,5,rep,packed,name=contentTypes,proto3" json:"contentTypes"`
+	SessionType     int32    `protobuf:"varint,6,opt,name=sessionType,proto3" json:"sessionType"`
+	// startTime is inclusive and endTime is exclusive, in milliseconds
+	StartTime int64 `protobuf:"varint,7,opt,name=startTime,proto3" json:"startTime"`
+	EndTime   int64 `protobuf:"varint,8,opt,name=endTime,proto3" json:"endTime"`
+}
+
+func (x *MsgSearchFilter) Reset() {
+	*x = MsgSearchFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgSearchFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSearchFilter) ProtoMessage() {}
+
+func (x *MsgSearchFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsgSearchFilter.ProtoReflect.Descriptor instead.
+func (*MsgSearchFilter) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *MsgSearchFilter) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *MsgSearchFilter) GetSendID() string {
+	if x != nil {
+		return x.SendID
+	}
+	return ""
+}
+
+func (x *MsgSearchFilter) GetRecvID() string {
+	if x != nil {
+		return x.RecvID
+	}
+	return ""
+}
+
+func (x *MsgSearchFilter) GetConversationIDs() []string {
+	if x != nil {
+		return x.ConversationIDs
+	}
+	return nil
+}
+
+func (x *MsgSearchFilter) GetContentTypes() []int32 {
+	if x != nil {
+		return x.ContentTypes
+	}
+	return nil
+}
+
+func (x *MsgSearchFilter) GetSessionType() int32 {
+	if x != nil {
+		return x.SessionType
+	}
+	return 0
+}
+
+func (x *MsgSearchFilter) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *MsgSearchFilter) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+type MsgSearchHit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConversationID string `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID"`
+	Seq            int64  `protobuf:"varint,2,opt,name=seq,proto3" json:"seq"`
+}
+
+func (x *MsgSearchHit) Reset() {
+	*x = MsgSearchHit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgSearchHit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSearchHit) ProtoMessage() {}
+
+func (x *MsgSearchHit) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsgSearchHit.ProtoReflect.Descriptor instead.
+func (*MsgSearchHit) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *MsgSearchHit) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *MsgSearchHit) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+type SearchMsgIndexReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter     *MsgSearchFilter         `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter"`
+	Pagination *sdkws.RequestPagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination"`
+}
+
+func (x *SearchMsgIndexReq) Reset() {
+	*x = SearchMsgIndexReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchMsgIndexReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchMsgIndexReq) ProtoMessage() {}
+
+func (x *SearchMsgIndexReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchMsgIndexReq.ProtoReflect.Descriptor instead.
+func (*SearchMsgIndexReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *SearchMsgIndexReq) GetFilter() *MsgSearchFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *SearchMsgIndexReq) GetPagination() *sdkws.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type SearchMsgIndexResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total int64           `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Hits  []*MsgSearchHit `protobuf:"bytes,2,rep,name=hits,proto3" json:"hits"`
+}
+
+func (x *SearchMsgIndexResp) Reset() {
+	*x = SearchMsgIndexResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchMsgIndexResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchMsgIndexResp) ProtoMessage() {}
+
+func (x *SearchMsgIndexResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchMsgIndexResp.ProtoReflect.Descriptor instead.
+func (*SearchMsgIndexResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *SearchMsgIndexResp) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SearchMsgIndexResp) GetHits() []*MsgSearchHit {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+type SearchMsgReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the search is limited to the conversations of the user, all conversations when userID is empty
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	// matched against the text of the msg content
+	Keyword         string   `protobuf:"bytes,2,opt,name=keyword,proto3" json:"keyword"`
+	SendID          string   `protobuf:"bytes,3,opt,name=sendID,proto3" json:"sendID"`
+	RecvID          string   `protobuf:"bytes,4,opt,name=recvID,proto3" json:"recvID"`
+	ConversationIDs []string `protobuf:"bytes,5,rep,name=conversationIDs,proto3" json:"conversationIDs"`
+	ContentTypes    []int32  `protobuf:"varint,6,rep,packed,name=contentTypes,proto3" json:"contentTypes"`
+	SessionType     int32    `protobuf:"varint,7,opt,name=sessionType,proto3" json:"sessionType"`
+	// the range of the send time, in milliseconds, no limit when it is 0
+	StartTime  int64                    `protobuf:"varint,8,opt,name=startTime,proto3" json:"startTime"`
+	EndTime    int64                    `protobuf:"varint,9,opt,name=endTime,proto3" json:"endTime"`
+	Pagination *sdkws.RequestPagination `protobuf:"bytes,10,opt,name=pagination,proto3" json:"pagination"`
+	// the filters of SearchMessage, they are kept for the compatibility of /msg/search_msg
+	ContentType int32 `protobuf:"varint,11,opt,name=contentType,proto3" json:"contentType"`
+	// a date in the format of 2006-01-02
+	SendTime string `protobuf:"bytes,12,opt,name=sendTime,proto3" json:"sendTime"`
+}
+
+func (x *SearchMsgReq) Reset() {
+	*x = SearchMsgReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchMsgReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchMsgReq) ProtoMessage() {}
+
+func (x *SearchMsgReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchMsgReq.ProtoReflect.Descriptor instead.
+func (*SearchMsgReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *SearchMsgReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *SearchMsgReq) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *SearchMsgReq) GetSendID() string {
+	if x != nil {
+		return x.SendID
+	}
+	return ""
+}
+
+func (x *SearchMsgReq) GetRecvID() string {
+	if x != nil {
+		return x.RecvID
+	}
+	return ""
+}
+
+func (x *SearchMsgReq) GetConversationIDs() []string {
+	if x != nil {
+		return x.ConversationIDs
+	}
+	return nil
+}
+
+func (x *SearchMsgReq) GetContentTypes() []int32 {
+	if x != nil {
+		return x.ContentTypes
+	}
+	return nil
+}
+
+func (x *SearchMsgReq) GetSessionType() int32 {
+	if x != nil {
+		return x.SessionType
+	}
+	return 0
+}
+
+func (x *SearchMsgReq) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *SearchMsgReq) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *SearchMsgReq) GetPagination() *sdkws.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *SearchMsgReq) GetContentType() int32 {
+	if x != nil {
+		return x.ContentType
+	}
+	return 0
+}
+
+func (x *SearchMsgReq) GetSendTime() string {
+	if x != nil {
+		return x.SendTime
+	}
+	return ""
+}
+
+type SearchMsgResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatLogs    []*msg.ChatLog `protobuf:"bytes,1,rep,name=chatLogs,proto3" json:"chatLogs"`
+	ChatLogsNum int32          `protobuf:"varint,2,opt,name=chatLogsNum,proto3" json:"chatLogsNum"`
+}
+
+func (x *SearchMsgResp) Reset() {
+	*x = SearchMsgResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchMsgResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchMsgResp) ProtoMessage() {}
+
+func (x *SearchMsgResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchMsgResp.ProtoReflect.Descriptor instead.
+func (*SearchMsgResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *SearchMsgResp) GetChatLogs() []*msg.ChatLog {
+	if x != nil {
+		return x.ChatLogs
+	}
+	return nil
+}
+
+func (x *SearchMsgResp) GetChatLogsNum() int32 {
+	if x != nil {
+		return x.ChatLogsNum
+	}
+	return 0
+}
+
+type SensitiveWord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Word       string `protobuf:"bytes,1,opt,name=word,proto3" json:"word"`
+	CreateTime int64  `protobuf:"varint,2,opt,name=createTime,proto3" json:"createTime"`
+}
+
+func (x *SensitiveWord) Reset() {
+	*x = SensitiveWord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SensitiveWord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SensitiveWord) ProtoMessage() {}
+
+func (x *SensitiveWord) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SensitiveWord.ProtoReflect.Descriptor instead.
+func (*SensitiveWord) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *SensitiveWord) GetWord() string {
+	if x != nil {
+		return x.Word
+	}
+	return ""
+}
+
+func (x *SensitiveWord) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+type AddSensitiveWordsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Words []string `protobuf:"bytes,1,rep,name=words,proto3" json:"words"`
+}
+
+func (x *AddSensitiveWordsReq) Reset() {
+	*x = AddSensitiveWordsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddSensitiveWordsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddSensitiveWordsReq) ProtoMessage() {}
+
+func (x *AddSensitiveWordsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddSensitiveWordsReq.ProtoReflect.Descriptor instead.
+func (*AddSensitiveWordsReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *AddSensitiveWordsReq) GetWords() []string {
+	if x != nil {
+		return x.Words
+	}
+	return nil
+}
+
+type AddSensitiveWordsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AddSensitiveWordsResp) Reset() {
+	*x = AddSensitiveWordsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddSensitiveWordsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddSensitiveWordsResp) ProtoMessage() {}
+
+func (x *AddSensitiveWordsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddSensitiveWordsResp.ProtoReflect.Descriptor instead.
+func (*AddSensitiveWordsResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{46}
+}
+
+type DeleteSensitiveWordsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Words []string `protobuf:"bytes,1,rep,name=words,proto3" json:"words"`
+}
+
+func (x *DeleteSensitiveWordsReq) Reset() {
+	*x = DeleteSensitiveWordsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteSensitiveWordsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSensitiveWordsReq) ProtoMessage() {}
+
+func (x *DeleteSensitiveWordsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSensitiveWordsReq.ProtoReflect.Descriptor instead.
+func (*DeleteSensitiveWordsReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *DeleteSensitiveWordsReq) GetWords() []string {
+	if x != nil {
+		return x.Words
+	}
+	return nil
+}
+
+type DeleteSensitiveWordsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteSensitiveWordsResp) Reset() {
+	*x = DeleteSensitiveWordsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteSensitiveWordsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSensitiveWordsResp) ProtoMessage() {}
+
+func (x *DeleteSensitiveWordsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSensitiveWordsResp.ProtoReflect.Descriptor instead.
+func (*DeleteSensitiveWordsResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{48}
+}
+
+type SearchSensitiveWordsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Keyword    string                   `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword"`
+	Pagination *sdkws.RequestPagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination"`
+}
+
+func (x *SearchSensitiveWordsReq) Reset() {
+	*x = SearchSensitiveWordsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchSensitiveWordsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchSensitiveWordsReq) ProtoMessage() {}
+
+func (x *SearchSensitiveWordsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchSensitiveWordsReq.ProtoReflect.Descriptor instead.
+func (*SearchSensitiveWordsReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *SearchSensitiveWordsReq) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *SearchSensitiveWordsReq) GetPagination() *sdkws.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type SearchSensitiveWordsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total int64            `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Words []*SensitiveWord `protobuf:"bytes,2,rep,name=words,proto3" json:"words"`
+}
+
+func (x *SearchSensitiveWordsResp) Reset() {
+	*x = SearchSensitiveWordsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchSensitiveWordsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchSensitiveWordsResp) ProtoMessage() {}
+
+func (x *SearchSensitiveWordsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchSensitiveWordsResp.ProtoReflect.Descriptor instead.
+func (*SearchSensitiveWordsResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *SearchSensitiveWordsResp) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SearchSensitiveWordsResp) GetWords() []*SensitiveWord {
+	if x != nil {
+		return x.Words
+	}
+	return nil
+}
+
+type ReloadSensitiveWordsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ReloadSensitiveWordsReq) Reset() {
+	*x = ReloadSensitiveWordsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReloadSensitiveWordsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReloadSensitiveWordsReq) ProtoMessage() {}
+
+func (x *ReloadSensitiveWordsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReloadSensitiveWordsReq.ProtoReflect.Descriptor instead.
+func (*ReloadSensitiveWordsReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{51}
+}
+
+type ReloadSensitiveWordsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ReloadSensitiveWordsResp) Reset() {
+	*x = ReloadSensitiveWordsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReloadSensitiveWordsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReloadSensitiveWordsResp) ProtoMessage() {}
+
+func (x *ReloadSensitiveWordsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReloadSensitiveWordsResp.ProtoReflect.Descriptor instead.
+func (*ReloadSensitiveWordsResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{52}
+}
+
+type SetGroupReadReceiptReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupID string `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID"`
+	// the owner or an admin of the group
+	UserID string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID"`
+	Enable bool   `protobuf:"varint,3,opt,name=enable,proto3" json:"enable"`
+}
+
+func (x *SetGroupReadReceiptReq) Reset() {
+	*x = SetGroupReadReceiptReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetGroupReadReceiptReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGroupReadReceiptReq) ProtoMessage() {}
+
+func (x *SetGroupReadReceiptReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGroupReadReceiptReq.ProtoReflect.Descriptor instead.
+func (*SetGroupReadReceiptReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *SetGroupReadReceiptReq) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+func (x *SetGroupReadReceiptReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *SetGroupReadReceiptReq) GetEnable() bool {
+	if x != nil {
+		return x.Enable
+	}
+	return false
+}
+
+type SetGroupReadReceiptResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetGroupReadReceiptResp) Reset() {
+	*x = SetGroupReadReceiptResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetGroupReadReceiptResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGroupReadReceiptResp) ProtoMessage() {}
+
+func (x *SetGroupReadReceiptResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGroupReadReceiptResp.ProtoReflect.Descriptor instead.
+func (*SetGroupReadReceiptResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{54}
+}
+
+type GetGroupReadReceiptReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupID string `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID"`
+	// a member of the group
+	UserID string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID"`
+}
+
+func (x *GetGroupReadReceiptReq) Reset() {
+	*x = GetGroupReadReceiptReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGroupReadReceiptReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupReadReceiptReq) ProtoMessage() {}
+
+func (x *GetGroupReadReceiptReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupReadReceiptReq.ProtoReflect.Descriptor instead.
+func (*GetGroupReadReceiptReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GetGroupReadReceiptReq) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+func (x *GetGroupReadReceiptReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type GetGroupReadReceiptResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enable bool `protobuf:"varint,1,opt,name=enable,proto3" json:"enable"`
+}
+
+func (x *GetGroupReadReceiptResp) Reset() {
+	*x = GetGroupReadReceiptResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGroupReadReceiptResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupReadReceiptResp) ProtoMessage() {}
+
+func (x *GetGroupReadReceiptResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupReadReceiptResp.ProtoReflect.Descriptor instead.
+func (*GetGroupReadReceiptResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetGroupReadReceiptResp) GetEnable() bool {
+	if x != nil {
+		return x.Enable
+	}
+	return false
+}
+
+type GetGroupMsgReadMembersReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConversationID string `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID"`
+	Seq            int64  `protobuf:"varint,2,opt,name=seq,proto3" json:"seq"`
+	// the member querying the read receipts
+	UserID string `protobuf:"bytes,3,opt,name=userID,proto3" json:"userID"`
+	// applied to the read and the unread members separately, showNumber 0 returns only the totals
+	Pagination *sdkws.RequestPagination `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination"`
+}
+
+func (x *GetGroupMsgReadMembersReq) Reset() {
+	*x = GetGroupMsgReadMembersReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGroupMsgReadMembersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupMsgReadMembersReq) ProtoMessage() {}
+
+func (x *GetGroupMsgReadMembersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupMsgReadMembersReq.ProtoReflect.Descriptor instead.
+func (*GetGroupMsgReadMembersReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetGroupMsgReadMembersReq) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *GetGroupMsgReadMembersReq) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *GetGroupMsgReadMembersReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *GetGroupMsgReadMembersReq) GetPagination() *sdkws.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type GetGroupMsgReadMembersResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the members except the sender who joined before the msg was sent and have or have not read it
+	ReadUserIDs   []string `protobuf:"bytes,1,rep,name=readUserIDs,proto3" json:"readUserIDs"`
+	UnreadUserIDs []string `protobuf:"bytes,2,rep,name=unreadUserIDs,proto3" json:"unreadUserIDs"`
+	ReadTotal     int32    `protobuf:"varint,3,opt,name=readTotal,proto3" json:"readTotal"`
+	UnreadTotal   int32    `protobuf:"varint,4,opt,name=unreadTotal,proto3" json:"unreadTotal"`
+}
+
+func (x *GetGroupMsgReadMembersResp) Reset() {
+	*x = GetGroupMsgReadMembersResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGroupMsgReadMembersResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupMsgReadMembersResp) ProtoMessage() {}
+
+func (x *GetGroupMsgReadMembersResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupMsgReadMembersResp.ProtoReflect.Descriptor instead.
+func (*GetGroupMsgReadMembersResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetGroupMsgReadMembersResp) GetReadUserIDs() []string {
+	if x != nil {
+		return x.ReadUserIDs
+	}
+	return nil
+}
+
+func (x *GetGroupMsgReadMembersResp) GetUnreadUserIDs() []string {
+	if x != nil {
+		return x.UnreadUserIDs
+	}
+	return nil
+}
+
+func (x *GetGroupMsgReadMembersResp) GetReadTotal() int32 {
+	if x != nil {
+		return x.ReadTotal
+	}
+	return 0
+}
+
+func (x *GetGroupMsgReadMembersResp) GetUnreadTotal() int32 {
+	if x != nil {
+		return x.UnreadTotal
+	}
+	return 0
+}
+
+type PinnedMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConversationID string `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID"`
+	Seq            int64  `protobuf:"varint,2,opt,name=seq,proto3" json:"seq"`
+	ClientMsgID    string `protobuf:"bytes,3,opt,name=clientMsgID,proto3" json:"clientMsgID"`
+	PinnerUserID   string `protobuf:"bytes,4,opt,name=pinnerUserID,proto3" json:"pinnerUserID"`
+	// in milliseconds
+	PinTime int64 `protobuf:"varint,5,opt,name=pinTime,proto3" json:"pinTime"`
+	// the pinned msg, only filled by GetPinnedMsgs
+	Msg *sdkws.MsgData `protobuf:"bytes,6,opt,name=msg,proto3" json:"msg"`
+}
+
+func (x *PinnedMsg) Reset() {
+	*x = PinnedMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PinnedMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinnedMsg) ProtoMessage() {}
+
+func (x *PinnedMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinnedMsg.ProtoReflect.Descriptor instead.
+func (*PinnedMsg) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *PinnedMsg) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *PinnedMsg) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *PinnedMsg) GetClientMsgID() string {
+	if x != nil {
+		return x.ClientMsgID
+	}
+	return ""
+}
+
+func (x *PinnedMsg) GetPinnerUserID() string {
+	if x != nil {
+		return x.PinnerUserID
+	}
+	return ""
+}
+
+func (x *PinnedMsg) GetPinTime() int64 {
+	if x != nil {
+		return x.PinTime
+	}
+	return 0
+}
+
+func (x *PinnedMsg) GetMsg() *sdkws.MsgData {
+	if x != nil {
+		return x.Msg
+	}
+	return nil
+}
+
+type PinnedMsgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PinnedMsgs []*PinnedMsg `protobuf:"bytes,1,rep,name=pinnedMsgs,proto3" json:"pinnedMsgs"`
+}
+
+func (x *PinnedMsgs) Reset() {
+	*x = PinnedMsgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PinnedMsgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinnedMsgs) ProtoMessage() {}
+
+func (x *PinnedMsgs) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinnedMsgs.ProtoReflect.Descriptor instead.
+func (*PinnedMsgs) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *PinnedMsgs) GetPinnedMsgs() []*PinnedMsg {
+	if x != nil {
+		return x.PinnedMsgs
+	}
+	return nil
+}
+
+type PinMsgReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConversationID string `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID"`
+	Seq            int64  `protobuf:"varint,2,opt,name=seq,proto3" json:"seq"`
+	UserID         string `protobuf:"bytes,3,opt,name=userID,proto3" json:"userID"`
+}
+
+func (x *PinMsgReq) Reset() {
+	*x = PinMsgReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PinMsgReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinMsgReq) ProtoMessage() {}
+
+func (x *PinMsgReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinMsgReq.ProtoReflect.Descriptor instead.
+func (*PinMsgReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *PinMsgReq) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *PinMsgReq) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *PinMsgReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type PinMsgResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PinnedMsg *PinnedMsg `protobuf:"bytes,1,opt,name=pinnedMsg,proto3" json:"pinnedMsg"`
+}
+
+func (x *PinMsgResp) Reset() {
+	*x = PinMsgResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PinMsgResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinMsgResp) ProtoMessage() {}
+
+func (x *PinMsgResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinMsgResp.ProtoReflect.Descriptor instead.
+func (*PinMsgResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *PinMsgResp) GetPinnedMsg() *PinnedMsg {
+	if x != nil {
+		return x.PinnedMsg
+	}
+	return nil
+}
+
+type UnpinMsgReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConversationID string `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID"`
+	Seq            int64  `protobuf:"varint,2,opt,name=seq,proto3" json:"seq"`
+	UserID         string `protobuf:"bytes,3,opt,name=userID,proto3" json:"userID"`
+}
+
+func (x *UnpinMsgReq) Reset() {
+	*x = UnpinMsgReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnpinMsgReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpinMsgReq) ProtoMessage() {}
+
+func (x *UnpinMsgReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpinMsgReq.ProtoReflect.Descriptor instead.
+func (*UnpinMsgReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *UnpinMsgReq) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *UnpinMsgReq) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *UnpinMsgReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type UnpinMsgResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UnpinMsgResp) Reset() {
+	*x = UnpinMsgResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnpinMsgResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpinMsgResp) ProtoMessage() {}
+
+func (x *UnpinMsgResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpinMsgResp.ProtoReflect.Descriptor instead.
+func (*UnpinMsgResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{64}
+}
+
+type GetPinnedMsgsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConversationID string `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID"`
+	UserID         string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID"`
+}
+
+func (x *GetPinnedMsgsReq) Reset() {
+	*x = GetPinnedMsgsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPinnedMsgsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPinnedMsgsReq) ProtoMessage() {}
+
+func (x *GetPinnedMsgsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPinnedMsgsReq.ProtoReflect.Descriptor instead.
+func (*GetPinnedMsgsReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetPinnedMsgsReq) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *GetPinnedMsgsReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type GetPinnedMsgsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PinnedMsgs []*PinnedMsg `protobuf:"bytes,1,rep,name=pinnedMsgs,proto3" json:"pinnedMsgs"`
+}
+
+func (x *GetPinnedMsgsResp) Reset() {
+	*x = GetPinnedMsgsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPinnedMsgsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPinnedMsgsResp) ProtoMessage() {}
+
+func (x *GetPinnedMsgsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPinnedMsgsResp.ProtoReflect.Descriptor instead.
+func (*GetPinnedMsgsResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *GetPinnedMsgsResp) GetPinnedMsgs() []*PinnedMsg {
+	if x != nil {
+		return x.PinnedMsgs
+	}
+	return nil
+}
+
+type GetConversationsPinnedMsgsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID          string   `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	ConversationIDs []string `protobuf:"bytes,2,rep,name=conversationIDs,proto3" json:"conversationIDs"`
+}
+
+func (x *GetConversationsPinnedMsgsReq) Reset() {
+	*x = GetConversationsPinnedMsgsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetConversationsPinnedMsgsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConversationsPinnedMsgsReq) ProtoMessage() {}
+
+func (x *GetConversationsPinnedMsgsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConversationsPinnedMsgsReq.ProtoReflect.Descriptor instead.
+func (*GetConversationsPinnedMsgsReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *GetConversationsPinnedMsgsReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *GetConversationsPinnedMsgsReq) GetConversationIDs() []string {
+	if x != nil {
+		return x.ConversationIDs
+	}
+	return nil
+}
+
+type GetConversationsPinnedMsgsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the conversations having no pinned msg are omitted
+	PinnedMsgs map[string]*PinnedMsgs `protobuf:"bytes,1,rep,name=pinnedMsgs,proto3" json:"pinnedMsgs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *GetConversationsPinnedMsgsResp) Reset() {
+	*x = GetConversationsPinnedMsgsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetConversationsPinnedMsgsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConversationsPinnedMsgsResp) ProtoMessage() {}
+
+func (x *GetConversationsPinnedMsgsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConversationsPinnedMsgsResp.ProtoReflect.Descriptor instead.
+func (*GetConversationsPinnedMsgsResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *GetConversationsPinnedMsgsResp) GetPinnedMsgs() map[string]*PinnedMsgs {
+	if x != nil {
+		return x.PinnedMsgs
+	}
+	return nil
+}
+
+type MsgPinChangedTips struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OpUserID       string `protobuf:"bytes,1,opt,name=opUserID,proto3" json:"opUserID"`
+	ConversationID string `protobuf:"bytes,2,opt,name=conversationID,proto3" json:"conversationID"`
+	Seq            int64  `protobuf:"varint,3,opt,name=seq,proto3" json:"seq"`
+	ClientMsgID    string `protobuf:"bytes,4,opt,name=clientMsgID,proto3" json:"clientMsgID"`
+	SessionType    int32  `protobuf:"varint,5,opt,name=sessionType,proto3" json:"sessionType"`
+	IsPinned       bool   `protobuf:"varint,6,opt,name=isPinned,proto3" json:"isPinned"`
+	// in milliseconds
+	PinTime int64 `protobuf:"varint,7,opt,name=pinTime,proto3" json:"pinTime"`
+}
+
+func (x *MsgPinChangedTips) Reset() {
+	*x = MsgPinChangedTips{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgPinChangedTips) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgPinChangedTips) ProtoMessage() {}
+
+func (x *MsgPinChangedTips) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsgPinChangedTips.ProtoReflect.Descriptor instead.
+func (*MsgPinChangedTips) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *MsgPinChangedTips) GetOpUserID() string {
+	if x != nil {
+		return x.OpUserID
+	}
+	return ""
+}
+
+func (x *MsgPinChangedTips) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *MsgPinChangedTips) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *MsgPinChangedTips) GetClientMsgID() string {
+	if x != nil {
+		return x.ClientMsgID
+	}
+	return ""
+}
+
+func (x *MsgPinChangedTips) GetSessionType() int32 {
+	if x != nil {
+		return x.SessionType
+	}
+	return 0
+}
+
+func (x *MsgPinChangedTips) GetIsPinned() bool {
+	if x != nil {
+		return x.IsPinned
+	}
+	return false
+}
+
+func (x *MsgPinChangedTips) GetPinTime() int64 {
+	if x != nil {
+		return x.PinTime
+	}
+	return 0
+}
+
+var File_msgext_msgext_proto protoreflect.FileDescriptor
+
+var file_msgext_msgext_proto_rawDesc = []byte{
+	0x0a, 0x13, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2f, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73,
+	0x67, 0x65, 0x78, 0x74, 0x1a, 0x11, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2f, 0x73, 0x64, 0x6b, 0x77,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0d, 0x6d, 0x73, 0x67, 0x2f, 0x6d, 0x73, 0x67,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x7a, 0x0a, 0x0c, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79,
+	0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x10,
+	0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x73, 0x65, 0x71,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x22, 0x2f, 0x0a, 0x0d, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x4d, 0x73, 0x67, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x54, 0x69, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x54,
+	0x69, 0x6d, 0x65, 0x22, 0x97, 0x02, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x4d, 0x6f, 0x64, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x54, 0x69, 0x70, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x6d, 0x6f, 0x64, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12,
+	0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x4d, 0x73, 0x67, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x73, 0x67, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x6f, 0x64, 0x69, 0x66,
+	0x79, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6d, 0x6f, 0x64,
+	0x69, 0x66, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x69, 0x73, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d,
+	0x69, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x22, 0x7f, 0x0a,
+	0x0f, 0x4d, 0x73, 0x67, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1e,
+	0x0a, 0x0a, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x6a,
+	0x0a, 0x16, 0x47, 0x65, 0x74, 0x4d, 0x73, 0x67, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44,
+	0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x73,
+	0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x53, 0x0a, 0x17, 0x47, 0x65,
+	0x74, 0x4d, 0x73, 0x67, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x38, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x4d, 0x73, 0x67, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x22,
+	0x63, 0x0a, 0x0f, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x4b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0x84, 0x01, 0x0a, 0x10, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x20, 0x0a, 0x0b, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x73, 0x67, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x73, 0x67, 0x49, 0x44, 0x12, 0x3c, 0x0a,
+	0x09, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74,
+	0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x09, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x8b, 0x01, 0x0a, 0x15,
+	0x41, 0x64, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x10, 0x0a,
+	0x03, 0x73, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12,
+	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x22, 0x54, 0x0a, 0x16, 0x41, 0x64, 0x64,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x3a, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d,
+	0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x8e, 0x01, 0x0a, 0x18, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x26, 0x0a, 0x0e,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x20,
+	0x0a, 0x0b, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79,
+	0x22, 0x57, 0x0a, 0x19, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3a, 0x0a,
+	0x08, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x08, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x6c, 0x0a, 0x16, 0x47, 0x65, 0x74,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x73,
+	0x65, 0x71, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x04, 0x73, 0x65, 0x71, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x5e, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x43, 0x0a, 0x0c, 0x6d, 0x73, 0x67, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0c, 0x6d, 0x73, 0x67, 0x52, 0x65,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x84, 0x02, 0x0a, 0x16, 0x4d, 0x73, 0x67, 0x52,
+	0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x54, 0x69,
+	0x70, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x26,
+	0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x4d, 0x73, 0x67, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x73, 0x67, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x69, 0x73, 0x41, 0x64, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x69, 0x73, 0x41,
+	0x64, 0x64, 0x12, 0x3a, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73,
+	0x67, 0x65, 0x78, 0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x54,
+	0x0a, 0x0b, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1c, 0x0a,
+	0x09, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x53, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x53, 0x65, 0x71, 0x12, 0x27, 0x0a, 0x03, 0x6d,
+	0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x03, 0x6d, 0x73, 0x67, 0x22, 0xc0, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x54, 0x68, 0x72, 0x65,
+	0x61, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x26, 0x0a, 0x0e,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x0f, 0x72, 0x6f, 0x6f, 0x74, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x4d, 0x73, 0x67, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72,
+	0x6f, 0x6f, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x49, 0x44, 0x12, 0x16,
+	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x3f, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x9c, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x54,
+	0x68, 0x72, 0x65, 0x61, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x34, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78,
+	0x74, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x07, 0x72,
+	0x65, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x54, 0x68, 0x72,
+	0x65, 0x61, 0x64, 0x53, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6d, 0x61,
+	0x78, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x53, 0x65, 0x71, 0x12, 0x2a, 0x0a, 0x10, 0x68, 0x61,
+	0x73, 0x52, 0x65, 0x61, 0x64, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x53, 0x65, 0x71, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x68, 0x61, 0x73, 0x52, 0x65, 0x61, 0x64, 0x54, 0x68, 0x72,
+	0x65, 0x61, 0x64, 0x53, 0x65, 0x71, 0x22, 0xab, 0x01, 0x0a, 0x13, 0x4d, 0x61, 0x72, 0x6b, 0x54,
+	0x68, 0x72, 0x65, 0x61, 0x64, 0x41, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x12, 0x26,
+	0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x0f, 0x72, 0x6f, 0x6f, 0x74, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0f, 0x72, 0x6f, 0x6f, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x49, 0x44,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x2a, 0x0a, 0x10, 0x68, 0x61, 0x73, 0x52,
+	0x65, 0x61, 0x64, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x53, 0x65, 0x71, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x10, 0x68, 0x61, 0x73, 0x52, 0x65, 0x61, 0x64, 0x54, 0x68, 0x72, 0x65, 0x61,
+	0x64, 0x53, 0x65, 0x71, 0x22, 0x42, 0x0a, 0x14, 0x4d, 0x61, 0x72, 0x6b, 0x54, 0x68, 0x72, 0x65,
+	0x61, 0x64, 0x41, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2a, 0x0a, 0x10,
+	0x68, 0x61, 0x73, 0x52, 0x65, 0x61, 0x64, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x53, 0x65, 0x71,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x68, 0x61, 0x73, 0x52, 0x65, 0x61, 0x64, 0x54,
+	0x68, 0x72, 0x65, 0x61, 0x64, 0x53, 0x65, 0x71, 0x22, 0x68, 0x0a, 0x0c, 0x54, 0x68, 0x72, 0x65,
+	0x61, 0x64, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x12, 0x2c, 0x0a, 0x11, 0x75, 0x6e, 0x72, 0x65,
+	0x61, 0x64, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x11, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x54, 0x68, 0x72, 0x65, 0x61,
+	0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x10, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x64,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x10, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x22, 0xaf, 0x03, 0x0a, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x61, 0x73, 0x52, 0x65, 0x61, 0x64, 0x41, 0x6e,
+	0x64, 0x4d, 0x61, 0x78, 0x53, 0x65, 0x71, 0x57, 0x69, 0x74, 0x68, 0x54, 0x68, 0x72, 0x65, 0x61,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5b, 0x0a, 0x04, 0x73, 0x65, 0x71, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x47, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67,
+	0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x48, 0x61, 0x73, 0x52, 0x65, 0x61, 0x64, 0x41, 0x6e, 0x64, 0x4d, 0x61,
+	0x78, 0x53, 0x65, 0x71, 0x57, 0x69, 0x74, 0x68, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x2e, 0x53, 0x65, 0x71, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x73, 0x65,
+	0x71, 0x73, 0x12, 0x76, 0x0a, 0x0d, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x55, 0x6e, 0x72, 0x65,
+	0x61, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x50, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x61, 0x73, 0x52, 0x65, 0x61,
+	0x64, 0x41, 0x6e, 0x64, 0x4d, 0x61, 0x78, 0x53, 0x65, 0x71, 0x57, 0x69, 0x74, 0x68, 0x54, 0x68,
+	0x72, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x55,
+	0x6e, 0x72, 0x65, 0x61, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x74, 0x68, 0x72,
+	0x65, 0x61, 0x64, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x73, 0x1a, 0x49, 0x0a, 0x09, 0x53, 0x65,
+	0x71, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x26, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x53, 0x65, 0x71, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x5d, 0x0a, 0x12, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x55,
+	0x6e, 0x72, 0x65, 0x61, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x31, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x54, 0x68, 0x72,
+	0x65, 0x61, 0x64, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0xa9, 0x01, 0x0a, 0x11, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x48,
+	0x61, 0x73, 0x52, 0x65, 0x61, 0x64, 0x54, 0x69, 0x70, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x0f, 0x72, 0x6f,
+	0x6f, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x49, 0x44, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x6f, 0x6f, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d,
+	0x73, 0x67, 0x49, 0x44, 0x12, 0x2a, 0x0a, 0x10, 0x68, 0x61, 0x73, 0x52, 0x65, 0x61, 0x64, 0x54,
+	0x68, 0x72, 0x65, 0x61, 0x64, 0x53, 0x65, 0x71, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10,
+	0x68, 0x61, 0x73, 0x52, 0x65, 0x61, 0x64, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x53, 0x65, 0x71,
+	0x22, 0xed, 0x01, 0x0a, 0x0c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x73,
+	0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x49,
+	0x44, 0x12, 0x2f, 0x0a, 0x07, 0x6d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x64, 0x6b, 0x77,
+	0x73, 0x2e, 0x4d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x52, 0x07, 0x6d, 0x73, 0x67, 0x44, 0x61,
+	0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x4d, 0x73, 0x67, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x4d,
+	0x73, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67,
+	0x22, 0x61, 0x0a, 0x12, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x65, 0x6e, 0x64,
+	0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x12, 0x2f, 0x0a, 0x07, 0x6d, 0x73, 0x67, 0x44, 0x61, 0x74,
+	0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x52, 0x07,
+	0x6d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x54,
+	0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x54,
+	0x69, 0x6d, 0x65, 0x22, 0x35, 0x0a, 0x13, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53,
+	0x65, 0x6e, 0x64, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x44, 0x22, 0x37, 0x0a, 0x15, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x73, 0x67,
+	0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x49, 0x44, 0x22, 0x18, 0x0a, 0x16, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x22, 0x86, 0x01,
+	0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x73,
+	0x67, 0x73, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x49, 0x44, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3f, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x6f, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x12, 0x41, 0x0a, 0x0d, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x64, 0x4d, 0x73, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x52, 0x0d, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x73, 0x22, 0x30, 0x0a, 0x18, 0x43, 0x6c, 0x61, 0x69, 0x6d,
+	0x44, 0x75, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x73,
+	0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x78, 0x0a, 0x19, 0x43, 0x6c, 0x61,
+	0x69, 0x6d, 0x44, 0x75, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x73,
+	0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x49, 0x44,
+	0x12, 0x41, 0x0a, 0x0d, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x73, 0x67,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x64, 0x4d, 0x73, 0x67, 0x52, 0x0d, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d,
+	0x73, 0x67, 0x73, 0x22, 0x4f, 0x0a, 0x13, 0x53, 0x65, 0x6e, 0x64, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6c,
+	0x61, 0x69, 0x6d, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x61,
+	0x69, 0x6d, 0x49, 0x44, 0x22, 0x38, 0x0a, 0x14, 0x53, 0x65, 0x6e, 0x64, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x20, 0x0a, 0x0b,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x49, 0x44, 0x22, 0x7f,
+	0x0a, 0x0c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x4d, 0x73, 0x67, 0x73, 0x52, 0x65, 0x71, 0x12, 0x26,
+	0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x29, 0x0a, 0x04, 0x6d, 0x73, 0x67, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x64,
+	0x6b, 0x77, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x6d, 0x73, 0x67,
+	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x22,
+	0x0f, 0x0a, 0x0d, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x4d, 0x73, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x22, 0x52, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65,
+	0x64, 0x4d, 0x73, 0x67, 0x73, 0x52, 0x65, 0x71, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44,
+	0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x71, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x04,
+	0x73, 0x65, 0x71, 0x73, 0x22, 0x17, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x83, 0x02,
+	0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x65, 0x6e, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e,
+	0x64, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x63, 0x76, 0x49, 0x44, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x63, 0x76, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x0f, 0x63,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0c, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54,
+	0x69, 0x6d, 0x65, 0x22, 0x48, 0x0a, 0x0c, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x48, 0x69, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x73,
+	0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x73, 0x65, 0x71, 0x22, 0x8c, 0x01,
+	0x0a, 0x11, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4d, 0x73, 0x67, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x52, 0x65, 0x71, 0x12, 0x36, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67,
+	0x65, 0x78, 0x74, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x3f, 0x0a, 0x0a, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x5b, 0x0a, 0x12,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4d, 0x73, 0x67, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x2f, 0x0a, 0x04, 0x68, 0x69, 0x74, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x48, 0x69, 0x74, 0x52, 0x04, 0x68, 0x69, 0x74, 0x73, 0x22, 0x97, 0x03, 0x0a, 0x0c, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x65, 0x6e, 0x64, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65,
+	0x6e, 0x64, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x63, 0x76, 0x49, 0x44, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x63, 0x76, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x0f,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0c, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e,
+	0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x3f, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x54,
+	0x69, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x54,
+	0x69, 0x6d, 0x65, 0x22, 0x62, 0x0a, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4d, 0x73, 0x67,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x2f, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x74, 0x4c, 0x6f, 0x67, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x6d, 0x73, 0x67, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x08, 0x63, 0x68, 0x61,
+	0x74, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x74, 0x4c, 0x6f, 0x67,
+	0x73, 0x4e, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x74,
+	0x4c, 0x6f, 0x67, 0x73, 0x4e, 0x75, 0x6d, 0x22, 0x43, 0x0a, 0x0d, 0x53, 0x65, 0x6e, 0x73, 0x69,
+	0x74, 0x69, 0x76, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x6f, 0x72, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1e, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x2c, 0x0a, 0x14,
+	0x41, 0x64, 0x64, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x57, 0x6f, 0x72, 0x64,
+	0x73, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x05, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x17, 0x0a, 0x15, 0x41, 0x64,
+	0x64, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x22, 0x2f, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x6e,
+	0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x12, 0x14,
+	0x0a, 0x05, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x77,
+	0x6f, 0x72, 0x64, 0x73, 0x22, 0x1a, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65,
+	0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x22, 0x74, 0x0a, 0x17, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74,
+	0x69, 0x76, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x6b,
+	0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65,
+	0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x3f, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x64, 0x0a, 0x18, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x32, 0x0a, 0x05, 0x77, 0x6f, 0x72, 0x64,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76,
+	0x65, 0x57, 0x6f, 0x72, 0x64, 0x52, 0x05, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x19, 0x0a, 0x17,
+	0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x57,
+	0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x22, 0x1a, 0x0a, 0x18, 0x52, 0x65, 0x6c, 0x6f, 0x61,
+	0x64, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x22, 0x62, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52,
+	0x65, 0x61, 0x64, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a,
+	0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12,
+	0x16, 0x0a, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x19, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x22, 0x4a, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65,
+	0x61, 0x64, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x31,
+	0x0a, 0x17, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65,
+	0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x22, 0xae, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x73,
+	0x67, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x12,
+	0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x12, 0x3f, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73,
+	0x64, 0x6b, 0x77, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0xa4, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d,
+	0x73, 0x67, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x61, 0x64, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x61, 0x64, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x75, 0x6e, 0x72, 0x65,
+	0x61, 0x64, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x61,
+	0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x72, 0x65,
+	0x61, 0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x75, 0x6e, 0x72, 0x65, 0x61,
+	0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x75, 0x6e,
+	0x72, 0x65, 0x61, 0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0xce, 0x01, 0x0a, 0x09, 0x50, 0x69,
+	0x6e, 0x6e, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12,
+	0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x73, 0x65,
+	0x71, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x73, 0x67, 0x49, 0x44,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x73,
+	0x67, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x69, 0x6e, 0x6e, 0x65,
+	0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x69, 0x6e, 0x54, 0x69,
+	0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x70, 0x69, 0x6e, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x27, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x4d, 0x73,
+	0x67, 0x44, 0x61, 0x74, 0x61, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x46, 0x0a, 0x0a, 0x50, 0x69,
+	0x6e, 0x6e, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x73, 0x12, 0x38, 0x0a, 0x0a, 0x70, 0x69, 0x6e, 0x6e,
+	0x65, 0x64, 0x4d, 0x73, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x50, 0x69, 0x6e,
+	0x6e, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x52, 0x0a, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x4d, 0x73,
+	0x67, 0x73, 0x22, 0x5d, 0x0a, 0x09, 0x50, 0x69, 0x6e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x12,
+	0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x22, 0x44, 0x0a, 0x0a, 0x50, 0x69, 0x6e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x36, 0x0a, 0x09, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65,
+	0x78, 0x74, 0x2e, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x52, 0x09, 0x70, 0x69,
+	0x6e, 0x6e, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x22, 0x5f, 0x0a, 0x0b, 0x55, 0x6e, 0x70, 0x69, 0x6e,
+	0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x10,
+	0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x73, 0x65, 0x71,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x0e, 0x0a, 0x0c, 0x55, 0x6e, 0x70, 0x69,
+	0x6e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x22, 0x52, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50,
+	0x69, 0x6e, 0x6e, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x73, 0x52, 0x65, 0x71, 0x12, 0x26, 0x0a, 0x0e,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x4d, 0x0a, 0x11,
+	0x47, 0x65, 0x74, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x38, 0x0a, 0x0a, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d,
+	0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x52,
+	0x0a, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x73, 0x22, 0x61, 0x0a, 0x1d, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x50,
+	0x69, 0x6e, 0x6e, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x73, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x63,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x22, 0xd9,
+	0x01, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x5d, 0x0a, 0x0a, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d,
+	0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x2e, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x73,
+	0x1a, 0x58, 0x0a, 0x0f, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73,
+	0x67, 0x65, 0x78, 0x74, 0x2e, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x73, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xe3, 0x01, 0x0a, 0x11, 0x4d,
+	0x73, 0x67, 0x50, 0x69, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x54, 0x69, 0x70, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x4d, 0x73, 0x67, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x4d, 0x73, 0x67, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x73,
+	0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73,
+	0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x69, 0x6e, 0x54, 0x69, 0x6d,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x70, 0x69, 0x6e, 0x54, 0x69, 0x6d, 0x65,
+	0x32, 0x97, 0x15, 0x0a, 0x06, 0x6d, 0x73, 0x67, 0x45, 0x78, 0x74, 0x12, 0x46, 0x0a, 0x09, 0x4d,
+	0x6f, 0x64, 0x69, 0x66, 0x79, 0x4d, 0x73, 0x67, 0x12, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x4d,
+	0x73, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d,
+	0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x4d, 0x73, 0x67, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x64, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4d, 0x73, 0x67, 0x4d, 0x6f, 0x64,
+	0x69, 0x66, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x25, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x73,
+	0x67, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x1a, 0x26, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78,
+	0x74, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x73, 0x67, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x61, 0x0a, 0x12, 0x41, 0x64, 0x64,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x24, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e,
+	0x41, 0x64, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x25, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d,
+	0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x41, 0x64, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x6a, 0x0a, 0x15,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d,
+	0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x28,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x64, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x25, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e,
+	0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x26, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5b,
+	0x0a, 0x10, 0x47, 0x65, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x69,
+	0x65, 0x73, 0x12, 0x22, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65,
+	0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x52, 0x65, 0x70, 0x6c,
+	0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64,
+	0x52, 0x65, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5b, 0x0a, 0x10, 0x4d,
+	0x61, 0x72, 0x6b, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x41, 0x73, 0x52, 0x65, 0x61, 0x64, 0x12,
+	0x22, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e,
+	0x4d, 0x61, 0x72, 0x6b, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x41, 0x73, 0x52, 0x65, 0x61, 0x64,
+	0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67,
+	0x65, 0x78, 0x74, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x41, 0x73,
+	0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x9c, 0x01, 0x0a, 0x2a, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x61, 0x73,
+	0x52, 0x65, 0x61, 0x64, 0x41, 0x6e, 0x64, 0x4d, 0x61, 0x78, 0x53, 0x65, 0x71, 0x57, 0x69, 0x74,
+	0x68, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x12, 0x2f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x61, 0x73, 0x52, 0x65, 0x61, 0x64, 0x41, 0x6e, 0x64, 0x4d,
+	0x61, 0x78, 0x53, 0x65, 0x71, 0x52, 0x65, 0x71, 0x1a, 0x3d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x61, 0x73, 0x52, 0x65, 0x61, 0x64,
+	0x41, 0x6e, 0x64, 0x4d, 0x61, 0x78, 0x53, 0x65, 0x71, 0x57, 0x69, 0x74, 0x68, 0x54, 0x68, 0x72,
+	0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x58, 0x0a, 0x0f, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x73, 0x67, 0x12, 0x21, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x61, 0x0a, 0x12, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x12, 0x24, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x25, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x73, 0x67,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x5b, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x73, 0x12, 0x22, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x6a, 0x0a, 0x15, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x44, 0x75, 0x65, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x73, 0x12, 0x27, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d,
+	0x44, 0x75, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x73,
+	0x52, 0x65, 0x71, 0x1a, 0x28, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67,
+	0x65, 0x78, 0x74, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x44, 0x75, 0x65, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5b, 0x0a,
+	0x10, 0x53, 0x65, 0x6e, 0x64, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x73,
+	0x67, 0x12, 0x22, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78,
+	0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d,
+	0x73, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d,
+	0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x46, 0x0a, 0x09, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x4d, 0x73, 0x67, 0x73, 0x12, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x4d, 0x73, 0x67,
+	0x73, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73,
+	0x67, 0x65, 0x78, 0x74, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x4d, 0x73, 0x67, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x46, 0x0a, 0x09, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4d, 0x73, 0x67, 0x12,
+	0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5e, 0x0a, 0x11, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x73, 0x12,
+	0x23, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x4d, 0x73, 0x67,
+	0x73, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73,
+	0x67, 0x65, 0x78, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x65, 0x64, 0x4d, 0x73, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x55, 0x0a, 0x0e, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x4d, 0x73, 0x67, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x20, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x4d, 0x73, 0x67, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x71, 0x1a, 0x21,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x4d, 0x73, 0x67, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x5e, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76,
+	0x65, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x23, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x41, 0x64, 0x64, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74,
+	0x69, 0x76, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x41, 0x64, 0x64, 0x53,
+	0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x67, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x6e, 0x73, 0x69,
+	0x74, 0x69, 0x76, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x26, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65,
+	0x71, 0x1a, 0x27, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78,
+	0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76,
+	0x65, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x67, 0x0a, 0x14, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x57, 0x6f, 0x72,
+	0x64, 0x73, 0x12, 0x26, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65,
+	0x78, 0x74, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69,
+	0x76, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x67, 0x0a, 0x14, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x65, 0x6e,
+	0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x26, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x52, 0x65, 0x6c, 0x6f,
+	0x61, 0x64, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x73,
+	0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67,
+	0x65, 0x78, 0x74, 0x2e, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74,
+	0x69, 0x76, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x64, 0x0a, 0x13,
+	0x53, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x63, 0x65,
+	0x69, 0x70, 0x74, 0x12, 0x25, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67,
+	0x65, 0x78, 0x74, 0x2e, 0x53, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x61, 0x64,
+	0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x26, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x53, 0x65, 0x74, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x64, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65,
+	0x61, 0x64, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x25, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x71,
+	0x1a, 0x26, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74,
+	0x2e, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x63,
+	0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x6d, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x73, 0x12, 0x28, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65,
+	0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x73, 0x67, 0x52, 0x65,
+	0x61, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x29, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3d, 0x0a, 0x06, 0x50, 0x69, 0x6e, 0x4d, 0x73,
+	0x67, 0x12, 0x18, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78,
+	0x74, 0x2e, 0x50, 0x69, 0x6e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x50, 0x69, 0x6e, 0x4d,
+	0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x43, 0x0a, 0x08, 0x55, 0x6e, 0x70, 0x69, 0x6e, 0x4d,
+	0x73, 0x67, 0x12, 0x1a, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65,
+	0x78, 0x74, 0x2e, 0x55, 0x6e, 0x70, 0x69, 0x6e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x1b,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x55,
+	0x6e, 0x70, 0x69, 0x6e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x52, 0x0a, 0x0d, 0x47,
+	0x65, 0x74, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x73, 0x12, 0x1f, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74,
+	0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65,
+	0x74, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x79, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x73, 0x12, 0x2c, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x50, 0x69,
+	0x6e, 0x6e, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x2d, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x43,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x50, 0x69, 0x6e, 0x6e,
+	0x65, 0x64, 0x4d, 0x73, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x42, 0x3c, 0x5a, 0x3a, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x73,
+	0x64, 0x6b, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x2d, 0x69, 0x6d, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x2f, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_msgext_msgext_proto_rawDescOnce sync.Once
+	file_msgext_msgext_proto_rawDescData = file_msgext_msgext_proto_rawDesc
+)
+
+func file_msgext_msgext_proto_rawDescGZIP() []byte {
+	file_msgext_msgext_proto_rawDescOnce.Do(func() {
+		file_msgext_msgext_proto_rawDescData = protoimpl.X.CompressGZIP(file_msgext_msgext_proto_rawDescData)
+	})
+	return file_msgext_msgext_proto_rawDescData
+}
+
+var file_msgext_msgext_proto_msgTypes = make([]protoimpl.MessageInfo, 73)
+var file_msgext_msgext_proto_goTypes = []interface{}{
+	(*ModifyMsgReq)(nil),                                   // 0: openim.msgext.ModifyMsgReq
+	(*ModifyMsgResp)(nil),                                  // 1: openim.msgext.ModifyMsgResp
+	(*MsgModifiedTips)(nil),                                // 2: openim.msgext.MsgModifiedTips
+	(*MsgModifyRecord)(nil),                                // 3: openim.msgext.MsgModifyRecord
+	(*GetMsgModifyHistoryReq)(nil),                         // 4: openim.msgext.GetMsgModifyHistoryReq
+	(*GetMsgModifyHistoryResp)(nil),                        // 5: openim.msgext.GetMsgModifyHistoryResp
+	(*MessageReaction)(nil),                                // 6: openim.msgext.MessageReaction
+	(*MessageReactions)(nil),                               // 7: openim.msgext.MessageReactions
+	(*AddMessageReactionReq)(nil),                          // 8: openim.msgext.AddMessageReactionReq
+	(*AddMessageReactionResp)(nil),                         // 9: openim.msgext.AddMessageReactionResp
+	(*RemoveMessageReactionReq)(nil),                       // 10: openim.msgext.RemoveMessageReactionReq
+	(*RemoveMessageReactionResp)(nil),                      // 11: openim.msgext.RemoveMessageReactionResp
+	(*GetMessagesReactionReq)(nil),                         // 12: openim.msgext.GetMessagesReactionReq
+	(*GetMessagesReactionResp)(nil),                        // 13: openim.msgext.GetMessagesReactionResp
+	(*MsgReactionChangedTips)(nil),                         // 14: openim.msgext.MsgReactionChangedTips
+	(*ThreadReply)(nil),                                    // 15: openim.msgext.ThreadReply
+	(*GetThreadRepliesReq)(nil),                            // 16: openim.msgext.GetThreadRepliesReq
+	(*GetThreadRepliesResp)(nil),                           // 17: openim.msgext.GetThreadRepliesResp
+	(*MarkThreadAsReadReq)(nil),                            // 18: openim.msgext.MarkThreadAsReadReq
+	(*MarkThreadAsReadResp)(nil),                           // 19: openim.msgext.MarkThreadAsReadResp
+	(*ThreadUnread)(nil),                                   // 20: openim.msgext.ThreadUnread
+	(*GetConversationsHasReadAndMaxSeqWithThreadResp)(nil), // 21: openim.msgext.GetConversationsHasReadAndMaxSeqWithThreadResp
+	(*ThreadHasReadTips)(nil),                              // 22: openim.msgext.ThreadHasReadTips
+	(*ScheduledMsg)(nil),                                   // 23: openim.msgext.ScheduledMsg
+	(*ScheduleSendMsgReq)(nil),                             // 24: openim.msgext.ScheduleSendMsgReq
+	(*ScheduleSendMsgResp)(nil),                            // 25: openim.msgext.ScheduleSendMsgResp
+	(*CancelScheduledMsgReq)(nil),                          // 26: openim.msgext.CancelScheduledMsgReq
+	(*CancelScheduledMsgResp)(nil),                         // 27: openim.msgext.CancelScheduledMsgResp
+	(*GetScheduledMsgsReq)(nil),                            // 28: openim.msgext.GetScheduledMsgsReq
+	(*GetScheduledMsgsResp)(nil),                           // 29: openim.msgext.GetScheduledMsgsResp
+	(*ClaimDueScheduledMsgsReq)(nil),                       // 30: openim.msgext.ClaimDueScheduledMsgsReq
+	(*ClaimDueScheduledMsgsResp)(nil),                      // 31: openim.msgext.ClaimDueScheduledMsgsResp
+	(*SendScheduledMsgReq)(nil),                            // 32: openim.msgext.SendScheduledMsgReq
+	(*SendScheduledMsgResp)(nil),                           // 33: openim.msgext.SendScheduledMsgResp
+	(*IndexMsgsReq)(nil),                                   // 34: openim.msgext.IndexMsgsReq
+	(*IndexMsgsResp)(nil),                                  // 35: openim.msgext.IndexMsgsResp
+	(*DeleteIndexedMsgsReq)(nil),                           // 36: openim.msgext.DeleteIndexedMsgsReq
+	(*DeleteIndexedMsgsResp)(nil),                          // 37: openim.msgext.DeleteIndexedMsgsResp
+	(*MsgSearchFilter)(nil),                                // 38: openim.msgext.MsgSearchFilter
+	(*MsgSearchHit)(nil),                                   // 39: openim.msgext.MsgSearchHit
+	(*SearchMsgIndexReq)(nil),                              // 40: openim.msgext.SearchMsgIndexReq
+	(*SearchMsgIndexResp)(nil),                             // 41: openim.msgext.SearchMsgIndexResp
+	(*SearchMsgReq)(nil),                                   // 42: openim.msgext.SearchMsgReq
+	(*SearchMsgResp)(nil),                                  // 43: openim.msgext.SearchMsgResp
+	(*SensitiveWord)(nil),                                  // 44: openim.msgext.SensitiveWord
+	(*AddSensitiveWordsReq)(nil),                           // 45: openim.msgext.AddSensitiveWordsReq
+	(*AddSensitiveWordsResp)(nil),                          // 46: openim.msgext.AddSensitiveWordsResp
+	(*DeleteSensitiveWordsReq)(nil),                        // 47: openim.msgext.DeleteSensitiveWordsReq
+	(*DeleteSensitiveWordsResp)(nil),                       // 48: openim.msgext.DeleteSensitiveWordsResp
+	(*SearchSensitiveWordsReq)(nil),                        // 49: openim.msgext.SearchSensitiveWordsReq
+	(*SearchSensitiveWordsResp)(nil),                       // 50: openim.msgext.SearchSensitiveWordsResp
+	(*ReloadSensitiveWordsReq)(nil),                        // 51: openim.msgext.ReloadSensitiveWordsReq
+	(*ReloadSensitiveWordsResp)(nil),                       // 52: openim.msgext.ReloadSensitiveWordsResp
+	(*SetGroupReadReceiptReq)(nil),                         // 53: openim.msgext.SetGroupReadReceiptReq
+	(*SetGroupReadReceiptResp)(nil),                        // 54: openim.msgext.SetGroupReadReceiptResp
+	(*GetGroupReadReceiptReq)(nil),                         // 55: openim.msgext.GetGroupReadReceiptReq
+	(*GetGroupReadReceiptResp)(nil),                        // 56: openim.msgext.GetGroupReadReceiptResp
+	(*GetGroupMsgReadMembersReq)(nil),                      // 57: openim.msgext.GetGroupMsgReadMembersReq
+	(*GetGroupMsgReadMembersResp)(nil),                     // 58: openim.msgext.GetGroupMsgReadMembersResp
+	(*PinnedMsg)(nil),                                      // 59: openim.msgext.PinnedMsg
+	(*PinnedMsgs)(nil),                                     // 60: openim.msgext.PinnedMsgs
+	(*PinMsgReq)(nil),                                      // 61: openim.msgext.PinMsgReq
+	(*PinMsgResp)(nil),                                     // 62: openim.msgext.PinMsgResp
+	(*UnpinMsgReq)(nil),                                    // 63: openim.msgext.UnpinMsgReq
+	(*UnpinMsgResp)(nil),                                   // 64: openim.msgext.UnpinMsgResp
+	(*GetPinnedMsgsReq)(nil),                               // 65: openim.msgext.GetPinnedMsgsReq
+	(*GetPinnedMsgsResp)(nil),                              // 66: openim.msgext.GetPinnedMsgsResp
+	(*GetConversationsPinnedMsgsReq)(nil),                  // 67: openim.msgext.GetConversationsPinnedMsgsReq
+	(*GetConversationsPinnedMsgsResp)(nil),                 // 68: openim.msgext.GetConversationsPinnedMsgsResp
+	(*MsgPinChangedTips)(nil),                              // 69: openim.msgext.MsgPinChangedTips
+	nil,                                                    // 70: openim.msgext.GetConversationsHasReadAndMaxSeqWithThreadResp.SeqsEntry
+	nil,                                                    // 71: openim.msgext.GetConversationsHasReadAndMaxSeqWithThreadResp.ThreadUnreadsEntry
+	nil,                                                    // 72: openim.msgext.GetConversationsPinnedMsgsResp.PinnedMsgsEntry
+	(*sdkws.MsgData)(nil),                                  // 73: openim.sdkws.MsgData
+	(*sdkws.RequestPagination)(nil),                        // 74: openim.sdkws.RequestPagination
+	(*msg.ChatLog)(nil),                                    // 75: openim.msg.ChatLog
+	(*msg.Seqs)(nil),                                       // 76: openim.msg.Seqs
+	(*msg.GetConversationsHasReadAndMaxSeqReq)(nil),        // 77: openim.msg.GetConversationsHasReadAndMaxSeqReq
+}
+var file_msgext_msgext_proto_depIdxs = []int32{
+	3,  // 0: openim.msgext.GetMsgModifyHistoryResp.records:type_name -> openim.msgext.MsgModifyRecord
+	6,  // 1: openim.msgext.MessageReactions.reactions:type_name -> openim.msgext.MessageReaction
+	6,  // 2: openim.msgext.AddMessageReactionResp.reaction:type_name -> openim.msgext.MessageReaction
+	6,  // 3: openim.msgext.RemoveMessageReactionResp.reaction:type_name -> openim.msgext.MessageReaction
+	7,  // 4: openim.msgext.GetMessagesReactionResp.msgReactions:type_name -> openim.msgext.MessageReactions
+	6,  // 5: openim.msgext.MsgReactionChangedTips.reaction:type_name -> openim.msgext.MessageReaction
+	73, // 6: openim.msgext.ThreadReply.msg:type_name -> openim.sdkws.MsgData
+	74, // 7: openim.msgext.GetThreadRepliesReq.pagination:type_name -> openim.sdkws.RequestPagination
+	15, // 8: openim.msgext.GetThreadRepliesResp.replies:type_name -> openim.msgext.ThreadReply
+	70, // 9: openim.msgext.GetConversationsHasReadAndMaxSeqWithThreadResp.seqs:type_name -> openim.msgext.GetConversationsHasReadAndMaxSeqWithThreadResp.SeqsEntry
+	71, // 10: openim.msgext.GetConversationsHasReadAndMaxSeqWithThreadResp.threadUnreads:type_name -> openim.msgext.GetConversationsHasReadAndMaxSeqWithThreadResp.ThreadUnreadsEntry
+	73, // 11: openim.msgext.ScheduledMsg.msgData:type_name -> openim.sdkws.MsgData
+	73, // 12: openim.msgext.ScheduleSendMsgReq.msgData:type_name -> openim.sdkws.MsgData
+	74, // 13: openim.msgext.GetScheduledMsgsReq.pagination:type_name -> openim.sdkws.RequestPagination
+	23, // 14: openim.msgext.GetScheduledMsgsResp.scheduledMsgs:type_name -> openim.msgext.ScheduledMsg
+	23, // 15: openim.msgext.ClaimDueScheduledMsgsResp.scheduledMsgs:type_name -> openim.msgext.ScheduledMsg
+	73, // 16: openim.msgext.IndexMsgsReq.msgs:type_name -> openim.sdkws.MsgData
+	38, // 17: openim.msgext.SearchMsgIndexReq.filter:type_name -> openim.msgext.MsgSearchFilter
+	74, // 18: openim.msgext.SearchMsgIndexReq.pagination:type_name -> openim.sdkws.RequestPagination
+	39, // 19: openim.msgext.SearchMsgIndexResp.hits:type_name -> openim.msgext.MsgSearchHit
+	74, // 20: openim.msgext.SearchMsgReq.pagination:type_name -> openim.sdkws.RequestPagination
+	75, // 21: openim.msgext.SearchMsgResp.chatLogs:type_name -> openim.msg.ChatLog
+	74, // 22: openim.msgext.SearchSensitiveWordsReq.pagination:type_name -> openim.sdkws.RequestPagination
+	44, // 23: openim.msgext.SearchSensitiveWordsResp.words:type_name -> openim.msgext.SensitiveWord
+	74, // 24: openim.msgext.GetGroupMsgReadMembersReq.pagination:type_name -> openim.sdkws.RequestPagination
+	73, // 25: openim.msgext.PinnedMsg.msg:type_name -> openim.sdkws.MsgData
+	59, // 26: openim.msgext.PinnedMsgs.pinnedMsgs:type_name -> openim.msgext.PinnedMsg
+	59, // 27: openim.msgext.PinMsgResp.pinnedMsg:type_name -> openim.msgext.PinnedMsg
+	59, // 28: openim.msgext.GetPinnedMsgsResp.pinnedMsgs:type_name -> openim.msgext.PinnedMsg
+	72, // 29: openim.msgext.GetConversationsPinnedMsgsResp.pinnedMsgs:type_name -> openim.msgext.GetConversationsPinnedMsgsResp.PinnedMsgsEntry
+	76, // 30: openim.msgext.GetConversationsHasReadAndMaxSeqWithThreadResp.SeqsEntry.value:type_name -> openim.msg.Seqs
+	20, // 31: openim.msgext.GetConversationsHasReadAndMaxSeqWithThreadResp.ThreadUnreadsEntry.value:type_name -> openim.msgext.ThreadUnread
+	60, // 32: openim.msgext.GetConversationsPinnedMsgsResp.PinnedMsgsEntry.value:type_name -> openim.msgext.PinnedMsgs
+	0,  // 33: openim.msgext.msgExt.ModifyMsg:input_type -> openim.msgext.ModifyMsgReq
+	4,  // 34: openim.msgext.msgExt.GetMsgModifyHistory:input_type -> openim.msgext.GetMsgModifyHistoryReq
+	8,  // 35: openim.msgext.msgExt.AddMessageReaction:input_type -> openim.msgext.AddMessageReactionReq
+	10, // 36: openim.msgext.msgExt.RemoveMessageReaction:input_type -> openim.msgext.RemoveMessageReactionReq
+	12, // 37: openim.msgext.msgExt.GetMessagesReaction:input_type -> openim.msgext.GetMessagesReactionReq
+	16, // 38: openim.msgext.msgExt.GetThreadReplies:input_type -> openim.msgext.GetThreadRepliesReq
+	18, // 39: openim.msgext.msgExt.MarkThreadAsRead:input_type -> openim.msgext.MarkThreadAsReadReq
+	77, // 40: openim.msgext.msgExt.GetConversationsHasReadAndMaxSeqWithThread:input_type -> openim.msg.GetConversationsHasReadAndMaxSeqReq
+	24, // 41: openim.msgext.msgExt.ScheduleSendMsg:input_type -> openim.msgext.ScheduleSendMsgReq
+	26, // 42: openim.msgext.msgExt.CancelScheduledMsg:input_type -> openim.msgext.CancelScheduledMsgReq
+	28, // 43: openim.msgext.msgExt.GetScheduledMsgs:input_type -> openim.msgext.GetScheduledMsgsReq
+	30, // 44: openim.msgext.msgExt.ClaimDueScheduledMsgs:input_type -> openim.msgext.ClaimDueScheduledMsgsReq
+	32, // 45: openim.msgext.msgExt.SendScheduledMsg:input_type -> openim.msgext.SendScheduledMsgReq
+	34, // 46: openim.msgext.msgExt.IndexMsgs:input_type -> openim.msgext.IndexMsgsReq
+	42, // 47: openim.msgext.msgExt.SearchMsg:input_type -> openim.msgext.SearchMsgReq
+	36, // 48: openim.msgext.msgExt.DeleteIndexedMsgs:input_type -> openim.msgext.DeleteIndexedMsgsReq
+	40, // 49: openim.msgext.msgExt.SearchMsgIndex:input_type -> openim.msgext.SearchMsgIndexReq
+	45, // 50: openim.msgext.msgExt.AddSensitiveWords:input_type -> openim.msgext.AddSensitiveWordsReq
+	47, // 51: openim.msgext.msgExt.DeleteSensitiveWords:input_type -> openim.msgext.DeleteSensitiveWordsReq
+	49, // 52: openim.msgext.msgExt.SearchSensitiveWords:input_type -> openim.msgext.SearchSensitiveWordsReq
+	51, // 53: openim.msgext.msgExt.ReloadSensitiveWords:input_type -> openim.msgext.ReloadSensitiveWordsReq
+	53, // 54: openim.msgext.msgExt.SetGroupReadReceipt:input_type -> openim.msgext.SetGroupReadReceiptReq
+	55, // 55: openim.msgext.msgExt.GetGroupReadReceipt:input_type -> openim.msgext.GetGroupReadReceiptReq
+	57, // 56: openim.msgext.msgExt.GetGroupMsgReadMembers:input_type -> openim.msgext.GetGroupMsgReadMembersReq
+	61, // 57: openim.msgext.msgExt.PinMsg:input_type -> openim.msgext.PinMsgReq
+	63, // 58: openim.msgext.msgExt.UnpinMsg:input_type -> openim.msgext.UnpinMsgReq
+	65, // 59: openim.msgext.msgExt.GetPinnedMsgs:input_type -> openim.msgext.GetPinnedMsgsReq
+	67, // 60: openim.msgext.msgExt.GetConversationsPinnedMsgs:input_type -> openim.msgext.GetConversationsPinnedMsgsReq
+	1,  // 61: openim.msgext.msgExt.ModifyMsg:output_type -> openim.msgext.ModifyMsgResp
+	5,  // 62: openim.msgext.msgExt.GetMsgModifyHistory:output_type -> openim.msgext.GetMsgModifyHistoryResp
+	9,  // 63: openim.msgext.msgExt.AddMessageReaction:output_type -> openim.msgext.AddMessageReactionResp
+	11, // 64: openim.msgext.msgExt.RemoveMessageReaction:output_type -> openim.msgext.RemoveMessageReactionResp
+	13, // 65: openim.msgext.msgExt.GetMessagesReaction:output_type -> openim.msgext.GetMessagesReactionResp
+	17, // 66: openim.msgext.msgExt.GetThreadReplies:output_type -> openim.msgext.GetThreadRepliesResp
+	19, // 67: openim.msgext.msgExt.MarkThreadAsRead:output_type -> openim.msgext.MarkThreadAsReadResp
+	21, // 68: openim.msgext.msgExt.GetConversationsHasReadAndMaxSeqWithThread:output_type -> openim.msgext.GetConversationsHasReadAndMaxSeqWithThreadResp
+	25, // 69: openim.msgext.msgExt.ScheduleSendMsg:output_type -> openim.msgext.ScheduleSendMsgResp
+	27, // 70: openim.msgext.msgExt.CancelScheduledMsg:output_type -> openim.msgext.CancelScheduledMsgResp
+	29, // 71: openim.msgext.msgExt.GetScheduledMsgs:output_type -> openim.msgext.GetScheduledMsgsResp
+	31, // 72: openim.msgext.msgExt.ClaimDueScheduledMsgs:output_type -> openim.msgext.ClaimDueScheduledMsgsResp
+	33, // 73: openim.msgext.msgExt.SendScheduledMsg:output_type -> openim.msgext.SendScheduledMsgResp
+	35, // 74: openim.msgext.msgExt.IndexMsgs:output_type -> openim.msgext.IndexMsgsResp
+	43, // 75: openim.msgext.msgExt.SearchMsg:output_type -> openim.msgext.SearchMsgResp
+	37, // 76: openim.msgext.msgExt.DeleteIndexedMsgs:output_type -> openim.msgext.DeleteIndexedMsgsResp
+	41, // 77: openim.msgext.msgExt.SearchMsgIndex:output_type -> openim.msgext.SearchMsgIndexResp
+	46, // 78: openim.msgext.msgExt.AddSensitiveWords:output_type -> openim.msgext.AddSensitiveWordsResp
+	48, // 79: openim.msgext.msgExt.DeleteSensitiveWords:output_type -> openim.msgext.DeleteSensitiveWordsResp
+	50, // 80: openim.msgext.msgExt.SearchSensitiveWords:output_type -> openim.msgext.SearchSensitiveWordsResp
+	52, // 81: openim.msgext.msgExt.ReloadSensitiveWords:output_type -> openim.msgext.ReloadSensitiveWordsResp
+	54, // 82: openim.msgext.msgExt.SetGroupReadReceipt:output_type -> openim.msgext.SetGroupReadReceiptResp
+	56, // 83: openim.msgext.msgExt.GetGroupReadReceipt:output_type -> openim.msgext.GetGroupReadReceiptResp
+	58, // 84: openim.msgext.msgExt.GetGroupMsgReadMembers:output_type -> openim.msgext.GetGroupMsgReadMembersResp
+	62, // 85: openim.msgext.msgExt.PinMsg:output_type -> openim.msgext.PinMsgResp
+	64, // 86: openim.msgext.msgExt.UnpinMsg:output_type -> openim.msgext.UnpinMsgResp
+	66, // 87: openim.msgext.msgExt.GetPinnedMsgs:output_type -> openim.msgext.GetPinnedMsgsResp
+	68, // 88: openim.msgext.msgExt.GetConversationsPinnedMsgs:output_type -> openim.msgext.GetConversationsPinnedMsgsResp
+	61, // [61:89] is the sub-list for method output_type
+	33, // [33:61] is the sub-list for method input_type
+	33, // [33:33] is the sub-list for extension type_name
+	33, // [33:33] is the sub-list for extension extendee
+	0,  // [0:33] is the sub-list for field type_name
+}
+
+func init() { file_msgext_msgext_proto_init() }
+func file_msgext_msgext_proto_init() {
+	if File_msgext_msgext_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_msgext_msgext_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModifyMsgReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModifyMsgResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgModifiedTips); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgModifyRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMsgModifyHistoryReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMsgModifyHistoryResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MessageReaction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MessageReactions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddMessageReactionReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddMessageReactionResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveMessageReactionReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveMessageReactionResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMessagesReactionReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMessagesReactionResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgReactionChangedTips); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ThreadReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetThreadRepliesReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetThreadRepliesResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarkThreadAsReadReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarkThreadAsReadResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ThreadUnread); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetConversationsHasReadAndMaxSeqWithThreadResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ThreadHasReadTips); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduledMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduleSendMsgReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduleSendMsgResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelScheduledMsgReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelScheduledMsgResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetScheduledMsgsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetScheduledMsgsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClaimDueScheduledMsgsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClaimDueScheduledMsgsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendScheduledMsgReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendScheduledMsgResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IndexMsgsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IndexMsgsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteIndexedMsgsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteIndexedMsgsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSearchFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSearchHit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchMsgIndexReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchMsgIndexResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchMsgReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchMsgResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SensitiveWord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddSensitiveWordsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddSensitiveWordsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteSensitiveWordsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteSensitiveWordsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchSensitiveWordsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchSensitiveWordsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReloadSensitiveWordsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReloadSensitiveWordsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetGroupReadReceiptReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetGroupReadReceiptResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGroupReadReceiptReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGroupReadReceiptResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGroupMsgReadMembersReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGroupMsgReadMembersResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PinnedMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PinnedMsgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PinMsgReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PinMsgResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnpinMsgReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnpinMsgResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPinnedMsgsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPinnedMsgsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetConversationsPinnedMsgsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetConversationsPinnedMsgsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgPinChangedTips); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_msgext_msgext_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   73,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_msgext_msgext_proto_goTypes,
+		DependencyIndexes: file_msgext_msgext_proto_depIdxs,
+		MessageInfos:      file_msgext_msgext_proto_msgTypes,
+	}.Build()
+	File_msgext_msgext_proto = out.File
+	file_msgext_msgext_proto_rawDesc = nil
+	file_msgext_msgext_proto_goTypes = nil
+	file_msgext_msgext_proto_depIdxs = nil
+}
@@ -0,0 +1,1139 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: msgext/msgext.proto
+
+package msgext
+
+import (
+	context "context"
+	msg "github.com/openimsdk/protocol/msg"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	MsgExt_ModifyMsg_FullMethodName                                  = "/openim.msgext.msgExt/ModifyMsg"
+	MsgExt_GetMsgModifyHistory_FullMethodName                        = "/openim.msgext.msgExt/GetMsgModifyHistory"
+	MsgExt_AddMessageReaction_FullMethodName                         = "/openim.msgext.msgExt/AddMessageReaction"
+	MsgExt_RemoveMessageReaction_FullMethodName                      = "/openim.msgext.msgExt/RemoveMessageReaction"
+	MsgExt_GetMessagesReaction_FullMethodName                        = "/openim.msgext.msgExt/GetMessagesReaction"
+	MsgExt_GetThreadReplies_FullMethodName                           = "/openim.msgext.msgExt/GetThreadReplies"
+	MsgExt_MarkThreadAsRead_FullMethodName                           = "/openim.msgext.msgExt/MarkThreadAsRead"
+	MsgExt_GetConversationsHasReadAndMaxSeqWithThread_FullMethodName = "/openim.msgext.msgExt/GetConversationsHasReadAndMaxSeqWithThread"
+	MsgExt_ScheduleSendMsg_FullMethodName                            = "/openim.msgext.msgExt/ScheduleSendMsg"
+	MsgExt_CancelScheduledMsg_FullMethodName                         = "/openim.msgext.msgExt/CancelScheduledMsg"
+	MsgExt_GetScheduledMsgs_FullMethodName                           = "/openim.msgext.msgExt/GetScheduledMsgs"
+	MsgExt_ClaimDueScheduledMsgs_FullMethodName                      = "/openim.msgext.msgExt/ClaimDueScheduledMsgs"
+	MsgExt_SendScheduledMsg_FullMethodName                           = "/openim.msgext.msgExt/SendScheduledMsg"
+	MsgExt_IndexMsgs_FullMethodName                                  = "/openim.msgext.msgExt/IndexMsgs"
+	MsgExt_SearchMsg_FullMethodName                                  = "/openim.msgext.msgExt/SearchMsg"
+	MsgExt_DeleteIndexedMsgs_FullMethodName                          = "/openim.msgext.msgExt/DeleteIndexedMsgs"
+	MsgExt_SearchMsgIndex_FullMethodName                             = "/openim.msgext.msgExt/SearchMsgIndex"
+	MsgExt_AddSensitiveWords_FullMethodName                          = "/openim.msgext.msgExt/AddSensitiveWords"
+	MsgExt_DeleteSensitiveWords_FullMethodName                       = "/openim.msgext.msgExt/DeleteSensitiveWords"
+	MsgExt_SearchSensitiveWords_FullMethodName                       = "/openim.msgext.msgExt/SearchSensitiveWords"
+	MsgExt_ReloadSensitiveWords_FullMethodName                       = "/openim.msgext.msgExt/ReloadSensitiveWords"
+	MsgExt_SetGroupReadReceipt_FullMethodName                        = "/openim.msgext.msgExt/SetGroupReadReceipt"
+	MsgExt_GetGroupReadReceipt_FullMethodName                        = "/openim.msgext.msgExt/GetGroupReadReceipt"
+	MsgExt_GetGroupMsgReadMembers_FullMethodName                     = "/openim.msgext.msgExt/GetGroupMsgReadMembers"
+	MsgExt_PinMsg_FullMethodName                                     = "/openim.msgext.msgExt/PinMsg"
+	MsgExt_UnpinMsg_FullMethodName                                   = "/openim.msgext.msgExt/UnpinMsg"
+	MsgExt_GetPinnedMsgs_FullMethodName                              = "/openim.msgext.msgExt/GetPinnedMsgs"
+	MsgExt_GetConversationsPinnedMsgs_FullMethodName                 = "/openim.msgext.msgExt/GetConversationsPinnedMsgs"
+)
+
+// MsgExtClient is the client API for MsgExt service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MsgExtClient interface {
+	// modify the content of a sent message, the previous content is kept as edit history
+	ModifyMsg(ctx context.Context, in *ModifyMsgReq, opts ...grpc.CallOption) (*ModifyMsgResp, error)
+	GetMsgModifyHistory(ctx context.Context, in *GetMsgModifyHistoryReq, opts ...grpc.CallOption) (*GetMsgModifyHistoryResp, error)
+	// message reactions, the reactions are stored on the msg document and cached in redis
+	AddMessageReaction(ctx context.Context, in *AddMessageReactionReq, opts ...grpc.CallOption) (*AddMessageReactionResp, error)
+	RemoveMessageReaction(ctx context.Context, in *RemoveMessageReactionReq, opts ...grpc.CallOption) (*RemoveMessageReactionResp, error)
+	GetMessagesReaction(ctx context.Context, in *GetMessagesReactionReq, opts ...grpc.CallOption) (*GetMessagesReactionResp, error)
+	// threads, a reply carries the serverMsgID of its root msg and gets a seq of the thread
+	GetThreadReplies(ctx context.Context, in *GetThreadRepliesReq, opts ...grpc.CallOption) (*GetThreadRepliesResp, error)
+	MarkThreadAsRead(ctx context.Context, in *MarkThreadAsReadReq, opts ...grpc.CallOption) (*MarkThreadAsReadResp, error)
+	GetConversationsHasReadAndMaxSeqWithThread(ctx context.Context, in *msg.GetConversationsHasReadAndMaxSeqReq, opts ...grpc.CallOption) (*GetConversationsHasReadAndMaxSeqWithThreadResp, error)
+	// scheduled msgs, the crontask claims the due msgs one by one and sends each by SendScheduledMsg
+	ScheduleSendMsg(ctx context.Context, in *ScheduleSendMsgReq, opts ...grpc.CallOption) (*ScheduleSendMsgResp, error)
+	CancelScheduledMsg(ctx context.Context, in *CancelScheduledMsgReq, opts ...grpc.CallOption) (*CancelScheduledMsgResp, error)
+	GetScheduledMsgs(ctx context.Context, in *GetScheduledMsgsReq, opts ...grpc.CallOption) (*GetScheduledMsgsResp, error)
+	ClaimDueScheduledMsgs(ctx context.Context, in *ClaimDueScheduledMsgsReq, opts ...grpc.CallOption) (*ClaimDueScheduledMsgsResp, error)
+	SendScheduledMsg(ctx context.Context, in *SendScheduledMsgReq, opts ...grpc.CallOption) (*SendScheduledMsgResp, error)
+	// full-text search, the msgs are indexed by msgtransfer after they are written to mongodb
+	IndexMsgs(ctx context.Context, in *IndexMsgsReq, opts ...grpc.CallOption) (*IndexMsgsResp, error)
+	SearchMsg(ctx context.Context, in *SearchMsgReq, opts ...grpc.CallOption) (*SearchMsgResp, error)
+	// served by the msg rpc instance holding the search index for the other instances, which forward their calls to it
+	DeleteIndexedMsgs(ctx context.Context, in *DeleteIndexedMsgsReq, opts ...grpc.CallOption) (*DeleteIndexedMsgsResp, error)
+	SearchMsgIndex(ctx context.Context, in *SearchMsgIndexReq, opts ...grpc.CallOption) (*SearchMsgIndexResp, error)
+	// the dictionary of the sensitive-word filter, the changes take effect after ReloadSensitiveWords
+	AddSensitiveWords(ctx context.Context, in *AddSensitiveWordsReq, opts ...grpc.CallOption) (*AddSensitiveWordsResp, error)
+	DeleteSensitiveWords(ctx context.Context, in *DeleteSensitiveWordsReq, opts ...grpc.CallOption) (*DeleteSensitiveWordsResp, error)
+	SearchSensitiveWords(ctx context.Context, in *SearchSensitiveWordsReq, opts ...grpc.CallOption) (*SearchSensitiveWordsResp, error)
+	ReloadSensitiveWords(ctx context.Context, in *ReloadSensitiveWordsReq, opts ...grpc.CallOption) (*ReloadSensitiveWordsResp, error)
+	// group read receipts, they are computed on demand from the has read seqs of the members when the group enables them
+	SetGroupReadReceipt(ctx context.Context, in *SetGroupReadReceiptReq, opts ...grpc.CallOption) (*SetGroupReadReceiptResp, error)
+	GetGroupReadReceipt(ctx context.Context, in *GetGroupReadReceiptReq, opts ...grpc.CallOption) (*GetGroupReadReceiptResp, error)
+	GetGroupMsgReadMembers(ctx context.Context, in *GetGroupMsgReadMembersReq, opts ...grpc.CallOption) (*GetGroupMsgReadMembersResp, error)
+	// pinned msgs, the pins of a conversation are shared by its members
+	PinMsg(ctx context.Context, in *PinMsgReq, opts ...grpc.CallOption) (*PinMsgResp, error)
+	UnpinMsg(ctx context.Context, in *UnpinMsgReq, opts ...grpc.CallOption) (*UnpinMsgResp, error)
+	GetPinnedMsgs(ctx context.Context, in *GetPinnedMsgsReq, opts ...grpc.CallOption) (*GetPinnedMsgsResp, error)
+	GetConversationsPinnedMsgs(ctx context.Context, in *GetConversationsPinnedMsgsReq, opts ...grpc.CallOption) (*GetConversationsPinnedMsgsResp, error)
+}
+
+type msgExtClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMsgExtClient(cc grpc.ClientConnInterface) MsgExtClient {
+	return &msgExtClient{cc}
+}
+
+func (c *msgExtClient) ModifyMsg(ctx context.Context, in *ModifyMsgReq, opts ...grpc.CallOption) (*ModifyMsgResp, error) {
+	out := new(ModifyMsgResp)
+	err := c.cc.Invoke(ctx, MsgExt_ModifyMsg_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) GetMsgModifyHistory(ctx context.Context, in *GetMsgModifyHistoryReq, opts ...grpc.CallOption) (*GetMsgModifyHistoryResp, error) {
+	out := new(GetMsgModifyHistoryResp)
+	err := c.cc.Invoke(ctx, MsgExt_GetMsgModifyHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) AddMessageReaction(ctx context.Context, in *AddMessageReactionReq, opts ...grpc.CallOption) (*AddMessageReactionResp, error) {
+	out := new(AddMessageReactionResp)
+	err := c.cc.Invoke(ctx, MsgExt_AddMessageReaction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) RemoveMessageReaction(ctx context.Context, in *RemoveMessageReactionReq, opts ...grpc.CallOption) (*RemoveMessageReactionResp, error) {
+	out := new(RemoveMessageReactionResp)
+	err := c.cc.Invoke(ctx, MsgExt_RemoveMessageReaction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) GetMessagesReaction(ctx context.Context, in *GetMessagesReactionReq, opts ...grpc.CallOption) (*GetMessagesReactionResp, error) {
+	out := new(GetMessagesReactionResp)
+	err := c.cc.Invoke(ctx, MsgExt_GetMessagesReaction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) GetThreadReplies(ctx context.Context, in *GetThreadRepliesReq, opts ...grpc.CallOption) (*GetThreadRepliesResp, error) {
+	out := new(GetThreadRepliesResp)
+	err := c.cc.Invoke(ctx, MsgExt_GetThreadReplies_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) MarkThreadAsRead(ctx context.Context, in *MarkThreadAsReadReq, opts ...grpc.CallOption) (*MarkThreadAsReadResp, error) {
+	out := new(MarkThreadAsReadResp)
+	err := c.cc.Invoke(ctx, MsgExt_MarkThreadAsRead_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) GetConversationsHasReadAndMaxSeqWithThread(ctx context.Context, in *msg.GetConversationsHasReadAndMaxSeqReq, opts ...grpc.CallOption) (*GetConversationsHasReadAndMaxSeqWithThreadResp, error) {
+	out := new(GetConversationsHasReadAndMaxSeqWithThreadResp)
+	err := c.cc.Invoke(ctx, MsgExt_GetConversationsHasReadAndMaxSeqWithThread_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) ScheduleSendMsg(ctx context.Context, in *ScheduleSendMsgReq, opts ...grpc.CallOption) (*ScheduleSendMsgResp, error) {
+	out := new(ScheduleSendMsgResp)
+	err := c.cc.Invoke(ctx, MsgExt_ScheduleSendMsg_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) CancelScheduledMsg(ctx context.Context, in *CancelScheduledMsgReq, opts ...grpc.CallOption) (*CancelScheduledMsgResp, error) {
+	out := new(CancelScheduledMsgResp)
+	err := c.cc.Invoke(ctx, MsgExt_CancelScheduledMsg_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) GetScheduledMsgs(ctx context.Context, in *GetScheduledMsgsReq, opts ...grpc.CallOption) (*GetScheduledMsgsResp, error) {
+	out := new(GetScheduledMsgsResp)
+	err := c.cc.Invoke(ctx, MsgExt_GetScheduledMsgs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) ClaimDueScheduledMsgs(ctx context.Context, in *ClaimDueScheduledMsgsReq, opts ...grpc.CallOption) (*ClaimDueScheduledMsgsResp, error) {
+	out := new(ClaimDueScheduledMsgsResp)
+	err := c.cc.Invoke(ctx, MsgExt_ClaimDueScheduledMsgs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) SendScheduledMsg(ctx context.Context, in *SendScheduledMsgReq, opts ...grpc.CallOption) (*SendScheduledMsgResp, error) {
+	out := new(SendScheduledMsgResp)
+	err := c.cc.Invoke(ctx, MsgExt_SendScheduledMsg_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) IndexMsgs(ctx context.Context, in *IndexMsgsReq, opts ...grpc.CallOption) (*IndexMsgsResp, error) {
+	out := new(IndexMsgsResp)
+	err := c.cc.Invoke(ctx, MsgExt_IndexMsgs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) SearchMsg(ctx context.Context, in *SearchMsgReq, opts ...grpc.CallOption) (*SearchMsgResp, error) {
+	out := new(SearchMsgResp)
+	err := c.cc.Invoke(ctx, MsgExt_SearchMsg_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) DeleteIndexedMsgs(ctx context.Context, in *DeleteIndexedMsgsReq, opts ...grpc.CallOption) (*DeleteIndexedMsgsResp, error) {
+	out := new(DeleteIndexedMsgsResp)
+	err := c.cc.Invoke(ctx, MsgExt_DeleteIndexedMsgs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) SearchMsgIndex(ctx context.Context, in *SearchMsgIndexReq, opts ...grpc.CallOption) (*SearchMsgIndexResp, error) {
+	out := new(SearchMsgIndexResp)
+	err := c.cc.Invoke(ctx, MsgExt_SearchMsgIndex_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) AddSensitiveWords(ctx context.Context, in *AddSensitiveWordsReq, opts ...grpc.CallOption) (*AddSensitiveWordsResp, error) {
+	out := new(AddSensitiveWordsResp)
+	err := c.cc.Invoke(ctx, MsgExt_AddSensitiveWords_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) DeleteSensitiveWords(ctx context.Context, in *DeleteSensitiveWordsReq, opts ...grpc.CallOption) (*DeleteSensitiveWordsResp, error) {
+	out := new(DeleteSensitiveWordsResp)
+	err := c.cc.Invoke(ctx, MsgExt_DeleteSensitiveWords_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) SearchSensitiveWords(ctx context.Context, in *SearchSensitiveWordsReq, opts ...grpc.CallOption) (*SearchSensitiveWordsResp, error) {
+	out := new(SearchSensitiveWordsResp)
+	err := c.cc.Invoke(ctx, MsgExt_SearchSensitiveWords_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) ReloadSensitiveWords(ctx context.Context, in *ReloadSensitiveWordsReq, opts ...grpc.CallOption) (*ReloadSensitiveWordsResp, error) {
+	out := new(ReloadSensitiveWordsResp)
+	err := c.cc.Invoke(ctx, MsgExt_ReloadSensitiveWords_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) SetGroupReadReceipt(ctx context.Context, in *SetGroupReadReceiptReq, opts ...grpc.CallOption) (*SetGroupReadReceiptResp, error) {
+	out := new(SetGroupReadReceiptResp)
+	err := c.cc.Invoke(ctx, MsgExt_SetGroupReadReceipt_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) GetGroupReadReceipt(ctx context.Context, in *GetGroupReadReceiptReq, opts ...grpc.CallOption) (*GetGroupReadReceiptResp, error) {
+	out := new(GetGroupReadReceiptResp)
+	err := c.cc.Invoke(ctx, MsgExt_GetGroupReadReceipt_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) GetGroupMsgReadMembers(ctx context.Context, in *GetGroupMsgReadMembersReq, opts ...grpc.CallOption) (*GetGroupMsgReadMembersResp, error) {
+	out := new(GetGroupMsgReadMembersResp)
+	err := c.cc.Invoke(ctx, MsgExt_GetGroupMsgReadMembers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) PinMsg(ctx context.Context, in *PinMsgReq, opts ...grpc.CallOption) (*PinMsgResp, error) {
+	out := new(PinMsgResp)
+	err := c.cc.Invoke(ctx, MsgExt_PinMsg_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) UnpinMsg(ctx context.Context, in *UnpinMsgReq, opts ...grpc.CallOption) (*UnpinMsgResp, error) {
+	out := new(UnpinMsgResp)
+	err := c.cc.Invoke(ctx, MsgExt_UnpinMsg_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) GetPinnedMsgs(ctx context.Context, in *GetPinnedMsgsReq, opts ...grpc.CallOption) (*GetPinnedMsgsResp, error) {
+	out := new(GetPinnedMsgsResp)
+	err := c.cc.Invoke(ctx, MsgExt_GetPinnedMsgs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) GetConversationsPinnedMsgs(ctx context.Context, in *GetConversationsPinnedMsgsReq, opts ...grpc.CallOption) (*GetConversationsPinnedMsgsResp, error) {
+	out := new(GetConversationsPinnedMsgsResp)
+	err := c.cc.Invoke(ctx, MsgExt_GetConversationsPinnedMsgs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgExtServer is the server API for MsgExt service.
+// All implementations should embed UnimplementedMsgExtServer
+// for forward compatibility
+type MsgExtServer interface {
+	// modify the content of a sent message, the previous content is kept as edit history
+	ModifyMsg(context.Context, *ModifyMsgReq) (*ModifyMsgResp, error)
+	GetMsgModifyHistory(context.Context, *GetMsgModifyHistoryReq) (*GetMsgModifyHistoryResp, error)
+	// message reactions, the reactions are stored on the msg document and cached in redis
+	AddMessageReaction(context.Context, *AddMessageReactionReq) (*AddMessageReactionResp, error)
+	RemoveMessageReaction(context.Context, *RemoveMessageReactionReq) (*RemoveMessageReactionResp, error)
+	GetMessagesReaction(context.Context, *GetMessagesReactionReq) (*GetMessagesReactionResp, error)
+	// threads, a reply carries the serverMsgID of its root msg and gets a seq of the thread
+	GetThreadReplies(context.Context, *GetThreadRepliesReq) (*GetThreadRepliesResp, error)
+	MarkThreadAsRead(context.Context, *MarkThreadAsReadReq) (*MarkThreadAsReadResp, error)
+	GetConversationsHasReadAndMaxSeqWithThread(context.Context, *msg.GetConversationsHasReadAndMaxSeqReq) (*GetConversationsHasReadAndMaxSeqWithThreadResp, error)
+	// scheduled msgs, the crontask claims the due msgs one by one and sends each by SendScheduledMsg
+	ScheduleSendMsg(context.Context, *ScheduleSendMsgReq) (*ScheduleSendMsgResp, error)
+	CancelScheduledMsg(context.Context, *CancelScheduledMsgReq) (*CancelScheduledMsgResp, error)
+	GetScheduledMsgs(context.Context, *GetScheduledMsgsReq) (*GetScheduledMsgsResp, error)
+	ClaimDueScheduledMsgs(context.Context, *ClaimDueScheduledMsgsReq) (*ClaimDueScheduledMsgsResp, error)
+	SendScheduledMsg(context.Context, *SendScheduledMsgReq) (*SendScheduledMsgResp, error)
+	// full-text search, the msgs are indexed by msgtransfer after they are written to mongodb
+	IndexMsgs(context.Context, *IndexMsgsReq) (*IndexMsgsResp, error)
+	SearchMsg(context.Context, *SearchMsgReq) (*SearchMsgResp, error)
+	// served by the msg rpc instance holding the search index for the other instances, which forward their calls to it
+	DeleteIndexedMsgs(context.Context, *DeleteIndexedMsgsReq) (*DeleteIndexedMsgsResp, error)
+	SearchMsgIndex(context.Context, *SearchMsgIndexReq) (*SearchMsgIndexResp, error)
+	// the dictionary of the sensitive-word filter, the changes take effect after ReloadSensitiveWords
+	AddSensitiveWords(context.Context, *AddSensitiveWordsReq) (*AddSensitiveWordsResp, error)
+	DeleteSensitiveWords(context.Context, *DeleteSensitiveWordsReq) (*DeleteSensitiveWordsResp, error)
+	SearchSensitiveWords(context.Context, *SearchSensitiveWordsReq) (*SearchSensitiveWordsResp, error)
+	ReloadSensitiveWords(context.Context, *ReloadSensitiveWordsReq) (*ReloadSensitiveWordsResp, error)
+	// group read receipts, they are computed on demand from the has read seqs of the members when the group enables them
+	SetGroupReadReceipt(context.Context, *SetGroupReadReceiptReq) (*SetGroupReadReceiptResp, error)
+	GetGroupReadReceipt(context.Context, *GetGroupReadReceiptReq) (*GetGroupReadReceiptResp, error)
+	GetGroupMsgReadMembers(context.Context, *GetGroupMsgReadMembersReq) (*GetGroupMsgReadMembersResp, error)
+	// pinned msgs, the pins of a conversation are shared by its members
+	PinMsg(context.Context, *PinMsgReq) (*PinMsgResp, error)
+	UnpinMsg(context.Context, *UnpinMsgReq) (*UnpinMsgResp, error)
+	GetPinnedMsgs(context.Context, *GetPinnedMsgsReq) (*GetPinnedMsgsResp, error)
+	GetConversationsPinnedMsgs(context.Context, *GetConversationsPinnedMsgsReq) (*GetConversationsPinnedMsgsResp, error)
+}
+
+// UnimplementedMsgExtServer should be embedded to have forward compatible implementations.
+type UnimplementedMsgExtServer struct {
+}
+
+func (UnimplementedMsgExtServer) ModifyMsg(context.Context, *ModifyMsgReq) (*ModifyMsgResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModifyMsg not implemented")
+}
+func (UnimplementedMsgExtServer) GetMsgModifyHistory(context.Context, *GetMsgModifyHistoryReq) (*GetMsgModifyHistoryResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMsgModifyHistory not implemented")
+}
+func (UnimplementedMsgExtServer) AddMessageReaction(context.Context, *AddMessageReactionReq) (*AddMessageReactionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMessageReaction not implemented")
+}
+func (UnimplementedMsgExtServer) RemoveMessageReaction(context.Context, *RemoveMessageReactionReq) (*RemoveMessageReactionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMessageReaction not implemented")
+}
+func (UnimplementedMsgExtServer) GetMessagesReaction(context.Context, *GetMessagesReactionReq) (*GetMessagesReactionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMessagesReaction not implemented")
+}
+func (UnimplementedMsgExtServer) GetThreadReplies(context.Context, *GetThreadRepliesReq) (*GetThreadRepliesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetThreadReplies not implemented")
+}
+func (UnimplementedMsgExtServer) MarkThreadAsRead(context.Context, *MarkThreadAsReadReq) (*MarkThreadAsReadResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkThreadAsRead not implemented")
+}
+func (UnimplementedMsgExtServer) GetConversationsHasReadAndMaxSeqWithThread(context.Context, *msg.GetConversationsHasReadAndMaxSeqReq) (*GetConversationsHasReadAndMaxSeqWithThreadResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConversationsHasReadAndMaxSeqWithThread not implemented")
+}
+func (UnimplementedMsgExtServer) ScheduleSendMsg(context.Context, *ScheduleSendMsgReq) (*ScheduleSendMsgResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleSendMsg not implemented")
+}
+func (UnimplementedMsgExtServer) CancelScheduledMsg(context.Context, *CancelScheduledMsgReq) (*CancelScheduledMsgResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledMsg not implemented")
+}
+func (UnimplementedMsgExtServer) GetScheduledMsgs(context.Context, *GetScheduledMsgsReq) (*GetScheduledMsgsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScheduledMsgs not implemented")
+}
+func (UnimplementedMsgExtServer) ClaimDueScheduledMsgs(context.Context, *ClaimDueScheduledMsgsReq) (*ClaimDueScheduledMsgsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimDueScheduledMsgs not implemented")
+}
+func (UnimplementedMsgExtServer) SendScheduledMsg(context.Context, *SendScheduledMsgReq) (*SendScheduledMsgResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendScheduledMsg not implemented")
+}
+func (UnimplementedMsgExtServer) IndexMsgs(context.Context, *IndexMsgsReq) (*IndexMsgsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IndexMsgs not implemented")
+}
+func (UnimplementedMsgExtServer) SearchMsg(context.Context, *SearchMsgReq) (*SearchMsgResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchMsg not implemented")
+}
+func (UnimplementedMsgExtServer) DeleteIndexedMsgs(context.Context, *DeleteIndexedMsgsReq) (*DeleteIndexedMsgsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteIndexedMsgs not implemented")
+}
+func (UnimplementedMsgExtServer) SearchMsgIndex(context.Context, *SearchMsgIndexReq) (*SearchMsgIndexResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchMsgIndex not implemented")
+}
+func (UnimplementedMsgExtServer) AddSensitiveWords(context.Context, *AddSensitiveWordsReq) (*AddSensitiveWordsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddSensitiveWords not implemented")
+}
+func (UnimplementedMsgExtServer) DeleteSensitiveWords(context.Context, *DeleteSensitiveWordsReq) (*DeleteSensitiveWordsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSensitiveWords not implemented")
+}
+func (UnimplementedMsgExtServer) SearchSensitiveWords(context.Context, *SearchSensitiveWordsReq) (*SearchSensitiveWordsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchSensitiveWords not implemented")
+}
+func (UnimplementedMsgExtServer) ReloadSensitiveWords(context.Context, *ReloadSensitiveWordsReq) (*ReloadSensitiveWordsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReloadSensitiveWords not implemented")
+}
+func (UnimplementedMsgExtServer) SetGroupReadReceipt(context.Context, *SetGroupReadReceiptReq) (*SetGroupReadReceiptResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGroupReadReceipt not implemented")
+}
+func (UnimplementedMsgExtServer) GetGroupReadReceipt(context.Context, *GetGroupReadReceiptReq) (*GetGroupReadReceiptResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupReadReceipt not implemented")
+}
+func (UnimplementedMsgExtServer) GetGroupMsgReadMembers(context.Context, *GetGroupMsgReadMembersReq) (*GetGroupMsgReadMembersResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupMsgReadMembers not implemented")
+}
+func (UnimplementedMsgExtServer) PinMsg(context.Context, *PinMsgReq) (*PinMsgResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PinMsg not implemented")
+}
+func (UnimplementedMsgExtServer) UnpinMsg(context.Context, *UnpinMsgReq) (*UnpinMsgResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpinMsg not implemented")
+}
+func (UnimplementedMsgExtServer) GetPinnedMsgs(context.Context, *GetPinnedMsgsReq) (*GetPinnedMsgsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPinnedMsgs not implemented")
+}
+func (UnimplementedMsgExtServer) GetConversationsPinnedMsgs(context.Context, *GetConversationsPinnedMsgsReq) (*GetConversationsPinnedMsgsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConversationsPinnedMsgs not implemented")
+}
+
+// UnsafeMsgExtServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MsgExtServer will
+// result in compilation errors.
+type UnsafeMsgExtServer interface {
+	mustEmbedUnimplementedMsgExtServer()
+}
+
+func RegisterMsgExtServer(s grpc.ServiceRegistrar, srv MsgExtServer) {
+	s.RegisterService(&MsgExt_ServiceDesc, srv)
+}
+
+func _MsgExt_ModifyMsg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModifyMsgReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).ModifyMsg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgExt_ModifyMsg_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).ModifyMsg(ctx, req.(*ModifyMsgReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_GetMsgModifyHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMsgModifyHistoryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).GetMsgModifyHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgExt_GetMsgModifyHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).GetMsgModifyHistory(ctx, req.(*GetMsgModifyHistoryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_AddMessageReaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddMessageReactionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).AddMessageReaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgExt_AddMessageReaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).AddMessageReaction(ctx, req.(*AddMessageReactionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_RemoveMessageReaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveMessageReactionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).RemoveMessageReaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgExt_RemoveMessageReaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).RemoveMessageReaction(ctx, req.(*RemoveMessageReactionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_GetMessagesReaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMessagesReactionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).GetMessagesReaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgExt_GetMessagesReaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).GetMessagesReaction(ctx, req.(*GetMessagesReactionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_GetThreadReplies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetThreadRepliesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).GetThreadReplies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgExt_GetThreadReplies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).GetThreadReplies(ctx, req.(*GetThreadRepliesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_MarkThreadAsRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkThreadAsReadReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).MarkThreadAsRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgExt_MarkThreadAsRead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).MarkThreadAsRead(ctx, req.(*MarkThreadAsReadReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_GetConversationsHasReadAndMaxSeqWithThread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(msg.GetConversationsHasReadAndMaxSeqReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).GetConversationsHasReadAndMaxSeqWithThread(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgExt_GetConversationsHasReadAndMaxSeqWithThread_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).GetConversationsHasReadAndMaxSeqWithThread(ctx, req.(*msg.GetConversationsHasReadAndMaxSeqReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_ScheduleSendMsg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleSendMsgReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).ScheduleSendMsg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgExt_ScheduleSendMsg_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).ScheduleSendMsg(ctx, req.(*ScheduleSendMsgReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_CancelScheduledMsg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScheduledMsgReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).CancelScheduledMsg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgExt_CancelScheduledMsg_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).CancelScheduledMsg(ctx, req.(*CancelScheduledMsgReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_GetScheduledMsgs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScheduledMsgsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).GetScheduledMsgs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgExt_GetScheduledMsgs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).GetScheduledMsgs(ctx, req.(*GetScheduledMsgsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_ClaimDueScheduledMsgs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimDueScheduledMsgsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).ClaimDueScheduledMsgs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgExt_ClaimDueScheduledMsgs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).ClaimDueScheduledMsgs(ctx, req.(*ClaimDueScheduledMsgsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_SendScheduledMsg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendScheduledMsgReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).SendScheduledMsg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgExt_SendScheduledMsg_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).SendScheduledMsg(ctx, req.(*SendScheduledMsgReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_IndexMsgs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IndexMsgsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).IndexMsgs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgExt_IndexMsgs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).IndexMsgs(ctx, req.(*IndexMsgsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_SearchMsg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchMsgReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).SearchMsg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgExt_SearchMsg_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).SearchMsg(ctx, req.(*SearchMsgReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_DeleteIndexedMsgs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteIndexedMsgsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).DeleteIndexedMsgs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgExt_DeleteIndexedMsgs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).DeleteIndexedMsgs(ctx, req.(*DeleteIndexedMsgsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_SearchMsgIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchMsgIndexReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).SearchMsgIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgExt_SearchMsgIndex_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).SearchMsgIndex(ctx, req.(*SearchMsgIndexReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_AddSensitiveWords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddSensitiveWordsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).AddSensitiveWords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgExt_AddSensitiveWords_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).AddSensitiveWords(ctx, req.(*AddSensitiveWordsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_DeleteSensitiveWords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSensitiveWordsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).DeleteSensitiveWords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgExt_DeleteSensitiveWords_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).DeleteSensitiveWords(ctx, req.(*DeleteSensitiveWordsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_SearchSensitiveWords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchSensitiveWordsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).SearchSensitiveWords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgExt_SearchSensitiveWords_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).SearchSensitiveWords(ctx, req.(*SearchSensitiveWordsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_ReloadSensitiveWords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReloadSensitiveWordsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).ReloadSensitiveWords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgExt_ReloadSensitiveWords_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).ReloadSensitiveWords(ctx, req.(*ReloadSensitiveWordsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_SetGroupReadReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGroupReadReceiptReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).SetGroupReadReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgExt_SetGroupReadReceipt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).SetGroupReadReceipt(ctx, req.(*SetGroupReadReceiptReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_GetGroupReadReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupReadReceiptReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).GetGroupReadReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgExt_GetGroupReadReceipt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).GetGroupReadReceipt(ctx, req.(*GetGroupReadReceiptReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_GetGroupMsgReadMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupMsgReadMembersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).GetGroupMsgReadMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgExt_GetGroupMsgReadMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).GetGroupMsgReadMembers(ctx, req.(*GetGroupMsgReadMembersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_PinMsg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PinMsgReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).PinMsg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgExt_PinMsg_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).PinMsg(ctx, req.(*PinMsgReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_UnpinMsg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpinMsgReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).UnpinMsg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgExt_UnpinMsg_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).UnpinMsg(ctx, req.(*UnpinMsgReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_GetPinnedMsgs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPinnedMsgsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).GetPinnedMsgs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgExt_GetPinnedMsgs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).GetPinnedMsgs(ctx, req.(*GetPinnedMsgsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_GetConversationsPinnedMsgs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConversationsPinnedMsgsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).GetConversationsPinnedMsgs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgExt_GetConversationsPinnedMsgs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).GetConversationsPinnedMsgs(ctx, req.(*GetConversationsPinnedMsgsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MsgExt_ServiceDesc is the grpc.ServiceDesc for MsgExt service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MsgExt_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "openim.msgext.msgExt",
+	HandlerType: (*MsgExtServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ModifyMsg",
+			Handler:    _MsgExt_ModifyMsg_Handler,
+		},
+		{
+			MethodName: "GetMsgModifyHistory",
+			Handler:    _MsgExt_GetMsgModifyHistory_Handler,
+		},
+		{
+			MethodName: "AddMessageReaction",
+			Handler:    _MsgExt_AddMessageReaction_Handler,
+		},
+		{
+			MethodName: "RemoveMessageReaction",
+			Handler:    _MsgExt_RemoveMessageReaction_Handler,
+		},
+		{
+			MethodName: "GetMessagesReaction",
+			Handler:    _MsgExt_GetMessagesReaction_Handler,
+		},
+		{
+			MethodName: "GetThreadReplies",
+			Handler:    _MsgExt_GetThreadReplies_Handler,
+		},
+		{
+			MethodName: "MarkThreadAsRead",
+			Handler:    _MsgExt_MarkThreadAsRead_Handler,
+		},
+		{
+			MethodName: "GetConversationsHasReadAndMaxSeqWithThread",
+			Handler:    _MsgExt_GetConversationsHasReadAndMaxSeqWithThread_Handler,
+		},
+		{
+			MethodName: "ScheduleSendMsg",
+			Handler:    _MsgExt_ScheduleSendMsg_Handler,
+		},
+		{
+			MethodName: "CancelScheduledMsg",
+			Handler:    _MsgExt_CancelScheduledMsg_Handler,
+		},
+		{
+			MethodName: "GetScheduledMsgs",
+			Handler:    _MsgExt_GetScheduledMsgs_Handler,
+		},
+		{
+			MethodName: "ClaimDueScheduledMsgs",
+			Handler:    _MsgExt_ClaimDueScheduledMsgs_Handler,
+		},
+		{
+			MethodName: "SendScheduledMsg",
+			Handler:    _MsgExt_SendScheduledMsg_Handler,
+		},
+		{
+			MethodName: "IndexMsgs",
+			Handler:    _MsgExt_IndexMsgs_Handler,
+		},
+		{
+			MethodName: "SearchMsg",
+			Handler:    _MsgExt_SearchMsg_Handler,
+		},
+		{
+			MethodName: "DeleteIndexedMsgs",
+			Handler:    _MsgExt_DeleteIndexedMsgs_Handler,
+		},
+		{
+			MethodName: "SearchMsgIndex",
+			Handler:    _MsgExt_SearchMsgIndex_Handler,
+		},
+		{
+			MethodName: "AddSensitiveWords",
+			Handler:    _MsgExt_AddSensitiveWords_Handler,
+		},
+		{
+			MethodName: "DeleteSensitiveWords",
+			Handler:    _MsgExt_DeleteSensitiveWords_Handler,
+		},
+		{
+			MethodName: "SearchSensitiveWords",
+			Handler:    _MsgExt_SearchSensitiveWords_Handler,
+		},
+		{
+			MethodName: "ReloadSensitiveWords",
+			Handler:    _MsgExt_ReloadSensitiveWords_Handler,
+		},
+		{
+			MethodName: "SetGroupReadReceipt",
+			Handler:    _MsgExt_SetGroupReadReceipt_Handler,
+		},
+		{
+			MethodName: "GetGroupReadReceipt",
+			Handler:    _MsgExt_GetGroupReadReceipt_Handler,
+		},
+		{
+			MethodName: "GetGroupMsgReadMembers",
+			Handler:    _MsgExt_GetGroupMsgReadMembers_Handler,
+		},
+		{
+			MethodName: "PinMsg",
+			Handler:    _MsgExt_PinMsg_Handler,
+		},
+		{
+			MethodName: "UnpinMsg",
+			Handler:    _MsgExt_UnpinMsg_Handler,
+		},
+		{
+			MethodName: "GetPinnedMsgs",
+			Handler:    _MsgExt_GetPinnedMsgs_Handler,
+		},
+		{
+			MethodName: "GetConversationsPinnedMsgs",
+			Handler:    _MsgExt_GetConversationsPinnedMsgs_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "msgext/msgext.proto",
+}
@@ -0,0 +1,47 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pushext
+
+import (
+	"errors"
+
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+// MaxReplayTaskIDs is the max number of the dead letters replayed at a time.
+const MaxReplayTaskIDs = 100
+
+func (x *GetOfflinePushDeadLettersReq) Check() error {
+	if x.Pagination == nil {
+		return errors.New("pagination is empty")
+	}
+	if x.Pagination.PageNumber < 1 {
+		return errors.New("pageNumber is invalid")
+	}
+	return nil
+}
+
+func (x *ReplayOfflinePushDeadLettersReq) Check() error {
+	if len(x.TaskIDs) == 0 {
+		return errors.New("taskIDs is empty")
+	}
+	if len(x.TaskIDs) > MaxReplayTaskIDs {
+		return errors.New("too many taskIDs")
+	}
+	if datautil.Duplicate(x.TaskIDs) {
+		return errors.New("taskIDs is duplicate")
+	}
+	return nil
+}
@@ -0,0 +1,545 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        (unknown)
+// source: pushext/pushext.proto
+
+package pushext
+
+import (
+	sdkws "github.com/openimsdk/protocol/sdkws"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// OfflinePushDeadLetter is an offline push which still failed after the max attempts of the retries.
+type OfflinePushDeadLetter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TaskID  string   `protobuf:"bytes,1,opt,name=taskID,proto3" json:"taskID"`
+	UserIDs []string `protobuf:"bytes,2,rep,name=userIDs,proto3" json:"userIDs"`
+	// empty for the summary pushes of the stale msgs
+	MsgData  *sdkws.MsgData `protobuf:"bytes,3,opt,name=msgData,proto3" json:"msgData"`
+	Attempts int32          `protobuf:"varint,4,opt,name=attempts,proto3" json:"attempts"`
+	// the error of the last attempt
+	ErrMsg string `protobuf:"bytes,5,opt,name=errMsg,proto3" json:"errMsg"`
+	// the time of the first attempt, in milliseconds
+	CreateTime int64 `protobuf:"varint,6,opt,name=createTime,proto3" json:"createTime"`
+	// the time of the last attempt, in milliseconds
+	DeadTime int64 `protobuf:"varint,7,opt,name=deadTime,proto3" json:"deadTime"`
+	// the number of the stale msgs of a summary push
+	Summary int64 `protobuf:"varint,8,opt,name=summary,proto3" json:"summary"`
+}
+
+func (x *OfflinePushDeadLetter) Reset() {
+	*x = OfflinePushDeadLetter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pushext_pushext_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OfflinePushDeadLetter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OfflinePushDeadLetter) ProtoMessage() {}
+
+func (x *OfflinePushDeadLetter) ProtoReflect() protoreflect.Message {
+	mi := &file_pushext_pushext_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OfflinePushDeadLetter.ProtoReflect.Descriptor instead.
+func (*OfflinePushDeadLetter) Descriptor() ([]byte, []int) {
+	return file_pushext_pushext_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *OfflinePushDeadLetter) GetTaskID() string {
+	if x != nil {
+		return x.TaskID
+	}
+	return ""
+}
+
+func (x *OfflinePushDeadLetter) GetUserIDs() []string {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+func (x *OfflinePushDeadLetter) GetMsgData() *sdkws.MsgData {
+	if x != nil {
+		return x.MsgData
+	}
+	return nil
+}
+
+func (x *OfflinePushDeadLetter) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *OfflinePushDeadLetter) GetErrMsg() string {
+	if x != nil {
+		return x.ErrMsg
+	}
+	return ""
+}
+
+func (x *OfflinePushDeadLetter) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *OfflinePushDeadLetter) GetDeadTime() int64 {
+	if x != nil {
+		return x.DeadTime
+	}
+	return 0
+}
+
+func (x *OfflinePushDeadLetter) GetSummary() int64 {
+	if x != nil {
+		return x.Summary
+	}
+	return 0
+}
+
+type GetOfflinePushDeadLettersReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// all users when userID is empty
+	UserID     string                   `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	Pagination *sdkws.RequestPagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination"`
+}
+
+func (x *GetOfflinePushDeadLettersReq) Reset() {
+	*x = GetOfflinePushDeadLettersReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pushext_pushext_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetOfflinePushDeadLettersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOfflinePushDeadLettersReq) ProtoMessage() {}
+
+func (x *GetOfflinePushDeadLettersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_pushext_pushext_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOfflinePushDeadLettersReq.ProtoReflect.Descriptor instead.
+func (*GetOfflinePushDeadLettersReq) Descriptor() ([]byte, []int) {
+	return file_pushext_pushext_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetOfflinePushDeadLettersReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *GetOfflinePushDeadLettersReq) GetPagination() *sdkws.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type GetOfflinePushDeadLettersResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total       int64                    `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	DeadLetters []*OfflinePushDeadLetter `protobuf:"bytes,2,rep,name=deadLetters,proto3" json:"deadLetters"`
+}
+
+func (x *GetOfflinePushDeadLettersResp) Reset() {
+	*x = GetOfflinePushDeadLettersResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pushext_pushext_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetOfflinePushDeadLettersResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOfflinePushDeadLettersResp) ProtoMessage() {}
+
+func (x *GetOfflinePushDeadLettersResp) ProtoReflect() protoreflect.Message {
+	mi := &file_pushext_pushext_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOfflinePushDeadLettersResp.ProtoReflect.Descriptor instead.
+func (*GetOfflinePushDeadLettersResp) Descriptor() ([]byte, []int) {
+	return file_pushext_pushext_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetOfflinePushDeadLettersResp) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetOfflinePushDeadLettersResp) GetDeadLetters() []*OfflinePushDeadLetter {
+	if x != nil {
+		return x.DeadLetters
+	}
+	return nil
+}
+
+type ReplayOfflinePushDeadLettersReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TaskIDs []string `protobuf:"bytes,1,rep,name=taskIDs,proto3" json:"taskIDs"`
+}
+
+func (x *ReplayOfflinePushDeadLettersReq) Reset() {
+	*x = ReplayOfflinePushDeadLettersReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pushext_pushext_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplayOfflinePushDeadLettersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayOfflinePushDeadLettersReq) ProtoMessage() {}
+
+func (x *ReplayOfflinePushDeadLettersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_pushext_pushext_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayOfflinePushDeadLettersReq.ProtoReflect.Descriptor instead.
+func (*ReplayOfflinePushDeadLettersReq) Descriptor() ([]byte, []int) {
+	return file_pushext_pushext_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ReplayOfflinePushDeadLettersReq) GetTaskIDs() []string {
+	if x != nil {
+		return x.TaskIDs
+	}
+	return nil
+}
+
+type ReplayOfflinePushDeadLettersResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the dead letters found and moved back to the retry queue
+	TaskIDs []string `protobuf:"bytes,1,rep,name=taskIDs,proto3" json:"taskIDs"`
+}
+
+func (x *ReplayOfflinePushDeadLettersResp) Reset() {
+	*x = ReplayOfflinePushDeadLettersResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pushext_pushext_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplayOfflinePushDeadLettersResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayOfflinePushDeadLettersResp) ProtoMessage() {}
+
+func (x *ReplayOfflinePushDeadLettersResp) ProtoReflect() protoreflect.Message {
+	mi := &file_pushext_pushext_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayOfflinePushDeadLettersResp.ProtoReflect.Descriptor instead.
+func (*ReplayOfflinePushDeadLettersResp) Descriptor() ([]byte, []int) {
+	return file_pushext_pushext_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ReplayOfflinePushDeadLettersResp) GetTaskIDs() []string {
+	if x != nil {
+		return x.TaskIDs
+	}
+	return nil
+}
+
+var File_pushext_pushext_proto protoreflect.FileDescriptor
+
+var file_pushext_pushext_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x70, 0x75, 0x73, 0x68, 0x65, 0x78, 0x74, 0x2f, 0x70, 0x75, 0x73, 0x68, 0x65, 0x78,
+	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x70, 0x75, 0x73, 0x68, 0x65, 0x78, 0x74, 0x1a, 0x11, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2f, 0x73,
+	0x64, 0x6b, 0x77, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x84, 0x02, 0x0a, 0x15, 0x4f,
+	0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x75, 0x73, 0x68, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65,
+	0x74, 0x74, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x12, 0x2f, 0x0a, 0x07, 0x6d, 0x73, 0x67, 0x44, 0x61, 0x74,
+	0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x52, 0x07,
+	0x6d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64,
+	0x65, 0x61, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64,
+	0x65, 0x61, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x79, 0x22, 0x77, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x50,
+	0x75, 0x73, 0x68, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x3f, 0x0a, 0x0a, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x7e, 0x0a, 0x1d, 0x47, 0x65,
+	0x74, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x75, 0x73, 0x68, 0x44, 0x65, 0x61, 0x64,
+	0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x12, 0x47, 0x0a, 0x0b, 0x64, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x70, 0x75, 0x73, 0x68, 0x65, 0x78, 0x74, 0x2e, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x50,
+	0x75, 0x73, 0x68, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x52, 0x0b, 0x64,
+	0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x22, 0x3b, 0x0a, 0x1f, 0x52, 0x65,
+	0x70, 0x6c, 0x61, 0x79, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x75, 0x73, 0x68, 0x44,
+	0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a,
+	0x07, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07,
+	0x74, 0x61, 0x73, 0x6b, 0x49, 0x44, 0x73, 0x22, 0x3c, 0x0a, 0x20, 0x52, 0x65, 0x70, 0x6c, 0x61,
+	0x79, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x75, 0x73, 0x68, 0x44, 0x65, 0x61, 0x64,
+	0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x74,
+	0x61, 0x73, 0x6b, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x74, 0x61,
+	0x73, 0x6b, 0x49, 0x44, 0x73, 0x32, 0x87, 0x02, 0x0a, 0x07, 0x50, 0x75, 0x73, 0x68, 0x45, 0x78,
+	0x74, 0x12, 0x78, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x50,
+	0x75, 0x73, 0x68, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x12, 0x2c,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x65, 0x78, 0x74, 0x2e,
+	0x47, 0x65, 0x74, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x75, 0x73, 0x68, 0x44, 0x65,
+	0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x2d, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65,
+	0x74, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x75, 0x73, 0x68, 0x44, 0x65, 0x61, 0x64,
+	0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x81, 0x01, 0x0a, 0x1c,
+	0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x75, 0x73,
+	0x68, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x12, 0x2f, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x65, 0x78, 0x74, 0x2e, 0x52, 0x65,
+	0x70, 0x6c, 0x61, 0x79, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x75, 0x73, 0x68, 0x44,
+	0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x30, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x65, 0x78, 0x74, 0x2e, 0x52,
+	0x65, 0x70, 0x6c, 0x61, 0x79, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x75, 0x73, 0x68,
+	0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x42,
+	0x3d, 0x5a, 0x3b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x6d, 0x73, 0x64, 0x6b, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x2d, 0x69, 0x6d, 0x2d,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x70, 0x75, 0x73, 0x68, 0x65, 0x78, 0x74, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_pushext_pushext_proto_rawDescOnce sync.Once
+	file_pushext_pushext_proto_rawDescData = file_pushext_pushext_proto_rawDesc
+)
+
+func file_pushext_pushext_proto_rawDescGZIP() []byte {
+	file_pushext_pushext_proto_rawDescOnce.Do(func() {
+		file_pushext_pushext_proto_rawDescData = protoimpl.X.CompressGZIP(file_pushext_pushext_proto_rawDescData)
+	})
+	return file_pushext_pushext_proto_rawDescData
+}
+
+var file_pushext_pushext_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_pushext_pushext_proto_goTypes = []interface{}{
+	(*OfflinePushDeadLetter)(nil),            // 0: openim.pushext.OfflinePushDeadLetter
+	(*GetOfflinePushDeadLettersReq)(nil),     // 1: openim.pushext.GetOfflinePushDeadLettersReq
+	(*GetOfflinePushDeadLettersResp)(nil),    // 2: openim.pushext.GetOfflinePushDeadLettersResp
+	(*ReplayOfflinePushDeadLettersReq)(nil),  // 3: openim.pushext.ReplayOfflinePushDeadLettersReq
+	(*ReplayOfflinePushDeadLettersResp)(nil), // 4: openim.pushext.ReplayOfflinePushDeadLettersResp
+	(*sdkws.MsgData)(nil),                    // 5: openim.sdkws.MsgData
+	(*sdkws.RequestPagination)(nil),          // 6: openim.sdkws.RequestPagination
+}
+var file_pushext_pushext_proto_depIdxs = []int32{
+	5, // 0: openim.pushext.OfflinePushDeadLetter.msgData:type_name -> openim.sdkws.MsgData
+	6, // 1: openim.pushext.GetOfflinePushDeadLettersReq.pagination:type_name -> openim.sdkws.RequestPagination
+	0, // 2: openim.pushext.GetOfflinePushDeadLettersResp.deadLetters:type_name -> openim.pushext.OfflinePushDeadLetter
+	1, // 3: openim.pushext.PushExt.GetOfflinePushDeadLetters:input_type -> openim.pushext.GetOfflinePushDeadLettersReq
+	3, // 4: openim.pushext.PushExt.ReplayOfflinePushDeadLetters:input_type -> openim.pushext.ReplayOfflinePushDeadLettersReq
+	2, // 5: openim.pushext.PushExt.GetOfflinePushDeadLetters:output_type -> openim.pushext.GetOfflinePushDeadLettersResp
+	4, // 6: openim.pushext.PushExt.ReplayOfflinePushDeadLetters:output_type -> openim.pushext.ReplayOfflinePushDeadLettersResp
+	5, // [5:7] is the sub-list for method output_type
+	3, // [3:5] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_pushext_pushext_proto_init() }
+func file_pushext_pushext_proto_init() {
+	if File_pushext_pushext_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_pushext_pushext_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OfflinePushDeadLetter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pushext_pushext_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetOfflinePushDeadLettersReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pushext_pushext_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetOfflinePushDeadLettersResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pushext_pushext_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplayOfflinePushDeadLettersReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pushext_pushext_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplayOfflinePushDeadLettersResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_pushext_pushext_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_pushext_pushext_proto_goTypes,
+		DependencyIndexes: file_pushext_pushext_proto_depIdxs,
+		MessageInfos:      file_pushext_pushext_proto_msgTypes,
+	}.Build()
+	File_pushext_pushext_proto = out.File
+	file_pushext_pushext_proto_rawDesc = nil
+	file_pushext_pushext_proto_goTypes = nil
+	file_pushext_pushext_proto_depIdxs = nil
+}
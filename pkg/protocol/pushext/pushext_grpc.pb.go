@@ -0,0 +1,158 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: pushext/pushext.proto
+
+package pushext
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	PushExt_GetOfflinePushDeadLetters_FullMethodName    = "/openim.pushext.PushExt/GetOfflinePushDeadLetters"
+	PushExt_ReplayOfflinePushDeadLetters_FullMethodName = "/openim.pushext.PushExt/ReplayOfflinePushDeadLetters"
+)
+
+// PushExtClient is the client API for PushExt service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PushExtClient interface {
+	GetOfflinePushDeadLetters(ctx context.Context, in *GetOfflinePushDeadLettersReq, opts ...grpc.CallOption) (*GetOfflinePushDeadLettersResp, error)
+	ReplayOfflinePushDeadLetters(ctx context.Context, in *ReplayOfflinePushDeadLettersReq, opts ...grpc.CallOption) (*ReplayOfflinePushDeadLettersResp, error)
+}
+
+type pushExtClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPushExtClient(cc grpc.ClientConnInterface) PushExtClient {
+	return &pushExtClient{cc}
+}
+
+func (c *pushExtClient) GetOfflinePushDeadLetters(ctx context.Context, in *GetOfflinePushDeadLettersReq, opts ...grpc.CallOption) (*GetOfflinePushDeadLettersResp, error) {
+	out := new(GetOfflinePushDeadLettersResp)
+	err := c.cc.Invoke(ctx, PushExt_GetOfflinePushDeadLetters_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pushExtClient) ReplayOfflinePushDeadLetters(ctx context.Context, in *ReplayOfflinePushDeadLettersReq, opts ...grpc.CallOption) (*ReplayOfflinePushDeadLettersResp, error) {
+	out := new(ReplayOfflinePushDeadLettersResp)
+	err := c.cc.Invoke(ctx, PushExt_ReplayOfflinePushDeadLetters_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PushExtServer is the server API for PushExt service.
+// All implementations should embed UnimplementedPushExtServer
+// for forward compatibility
+type PushExtServer interface {
+	GetOfflinePushDeadLetters(context.Context, *GetOfflinePushDeadLettersReq) (*GetOfflinePushDeadLettersResp, error)
+	ReplayOfflinePushDeadLetters(context.Context, *ReplayOfflinePushDeadLettersReq) (*ReplayOfflinePushDeadLettersResp, error)
+}
+
+// UnimplementedPushExtServer should be embedded to have forward compatible implementations.
+type UnimplementedPushExtServer struct {
+}
+
+func (UnimplementedPushExtServer) GetOfflinePushDeadLetters(context.Context, *GetOfflinePushDeadLettersReq) (*GetOfflinePushDeadLettersResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOfflinePushDeadLetters not implemented")
+}
+func (UnimplementedPushExtServer) ReplayOfflinePushDeadLetters(context.Context, *ReplayOfflinePushDeadLettersReq) (*ReplayOfflinePushDeadLettersResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayOfflinePushDeadLetters not implemented")
+}
+
+// UnsafePushExtServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PushExtServer will
+// result in compilation errors.
+type UnsafePushExtServer interface {
+	mustEmbedUnimplementedPushExtServer()
+}
+
+func RegisterPushExtServer(s grpc.ServiceRegistrar, srv PushExtServer) {
+	s.RegisterService(&PushExt_ServiceDesc, srv)
+}
+
+func _PushExt_GetOfflinePushDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOfflinePushDeadLettersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PushExtServer).GetOfflinePushDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PushExt_GetOfflinePushDeadLetters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PushExtServer).GetOfflinePushDeadLetters(ctx, req.(*GetOfflinePushDeadLettersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PushExt_ReplayOfflinePushDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayOfflinePushDeadLettersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PushExtServer).ReplayOfflinePushDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PushExt_ReplayOfflinePushDeadLetters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PushExtServer).ReplayOfflinePushDeadLetters(ctx, req.(*ReplayOfflinePushDeadLettersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PushExt_ServiceDesc is the grpc.ServiceDesc for PushExt service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PushExt_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "openim.pushext.PushExt",
+	HandlerType: (*PushExtServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetOfflinePushDeadLetters",
+			Handler:    _PushExt_GetOfflinePushDeadLetters_Handler,
+		},
+		{
+			MethodName: "ReplayOfflinePushDeadLetters",
+			Handler:    _PushExt_ReplayOfflinePushDeadLetters_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pushext/pushext.proto",
+}
@@ -0,0 +1,81 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package thirdext
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+// MaxTokenLen is the max length of a device token.
+const MaxTokenLen = 512
+
+// MaxVendorLen is the max length of a vendor.
+const MaxVendorLen = 32
+
+// Vendors are the push vendors a device token can be registered for.
+var Vendors = []string{"apns", "fcm", "geTui", "jpush"}
+
+// OEMVendors are the android OEM push channels, they have no pusher yet and can not be registered for.
+var OEMVendors = []string{"huawei", "xiaomi", "oppo", "vivo"}
+
+func checkDevice(userID string, platformID int32, vendor string) error {
+	if userID == "" {
+		return errors.New("userID is empty")
+	}
+	if constant.PlatformIDToName(int(platformID)) == "" {
+		return errors.New("platformID is invalid")
+	}
+	if vendor == "" {
+		return errors.New("vendor is empty")
+	}
+	if len(vendor) > MaxVendorLen {
+		return errors.New("vendor is too long")
+	}
+	return nil
+}
+
+func (x *UpdateDeviceTokenReq) Check() error {
+	if err := checkDevice(x.UserID, x.PlatformID, x.Vendor); err != nil {
+		return err
+	}
+	if !datautil.Contain(x.Vendor, Vendors...) {
+		if datautil.Contain(x.Vendor, OEMVendors...) {
+			return fmt.Errorf("vendor %s is not supported, the devices of the OEM channels must register the tokens of fcm, geTui or jpush", x.Vendor)
+		}
+		return fmt.Errorf("vendor %s is unknown, it must be one of %v", x.Vendor, Vendors)
+	}
+	if x.Token == "" || len(x.Token) > MaxTokenLen {
+		return errors.New("token is invalid")
+	}
+	if x.ExpireTime <= 0 {
+		return errors.New("expireTime is invalid")
+	}
+	return nil
+}
+
+func (x *DeleteDeviceTokenReq) Check() error {
+	return checkDevice(x.UserID, x.PlatformID, x.Vendor)
+}
+
+func (x *GetDeviceTokensReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	return nil
+}
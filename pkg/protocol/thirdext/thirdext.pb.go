@@ -0,0 +1,626 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        (unknown)
+// source: thirdext/thirdext.proto
+
+package thirdext
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// DeviceToken is the push token of a device of the user registered for a push vendor.
+type DeviceToken struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlatformID int32 `protobuf:"varint,1,opt,name=platformID,proto3" json:"platformID"`
+	// vendor is the offline pusher of the token, e.g. apns, fcm, jpush or geTui.
+	Vendor string `protobuf:"bytes,2,opt,name=vendor,proto3" json:"vendor"`
+	Token  string `protobuf:"bytes,3,opt,name=token,proto3" json:"token"`
+}
+
+func (x *DeviceToken) Reset() {
+	*x = DeviceToken{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_thirdext_thirdext_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeviceToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeviceToken) ProtoMessage() {}
+
+func (x *DeviceToken) ProtoReflect() protoreflect.Message {
+	mi := &file_thirdext_thirdext_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeviceToken.ProtoReflect.Descriptor instead.
+func (*DeviceToken) Descriptor() ([]byte, []int) {
+	return file_thirdext_thirdext_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DeviceToken) GetPlatformID() int32 {
+	if x != nil {
+		return x.PlatformID
+	}
+	return 0
+}
+
+func (x *DeviceToken) GetVendor() string {
+	if x != nil {
+		return x.Vendor
+	}
+	return ""
+}
+
+func (x *DeviceToken) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type UpdateDeviceTokenReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID     string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	PlatformID int32  `protobuf:"varint,2,opt,name=platformID,proto3" json:"platformID"`
+	Vendor     string `protobuf:"bytes,3,opt,name=vendor,proto3" json:"vendor"`
+	Token      string `protobuf:"bytes,4,opt,name=token,proto3" json:"token"`
+	// expireTime is the number of seconds the token is kept since its last update.
+	ExpireTime int64 `protobuf:"varint,5,opt,name=expireTime,proto3" json:"expireTime"`
+}
+
+func (x *UpdateDeviceTokenReq) Reset() {
+	*x = UpdateDeviceTokenReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_thirdext_thirdext_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateDeviceTokenReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDeviceTokenReq) ProtoMessage() {}
+
+func (x *UpdateDeviceTokenReq) ProtoReflect() protoreflect.Message {
+	mi := &file_thirdext_thirdext_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDeviceTokenReq.ProtoReflect.Descriptor instead.
+func (*UpdateDeviceTokenReq) Descriptor() ([]byte, []int) {
+	return file_thirdext_thirdext_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UpdateDeviceTokenReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *UpdateDeviceTokenReq) GetPlatformID() int32 {
+	if x != nil {
+		return x.PlatformID
+	}
+	return 0
+}
+
+func (x *UpdateDeviceTokenReq) GetVendor() string {
+	if x != nil {
+		return x.Vendor
+	}
+	return ""
+}
+
+func (x *UpdateDeviceTokenReq) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *UpdateDeviceTokenReq) GetExpireTime() int64 {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return 0
+}
+
+type UpdateDeviceTokenResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdateDeviceTokenResp) Reset() {
+	*x = UpdateDeviceTokenResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_thirdext_thirdext_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateDeviceTokenResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDeviceTokenResp) ProtoMessage() {}
+
+func (x *UpdateDeviceTokenResp) ProtoReflect() protoreflect.Message {
+	mi := &file_thirdext_thirdext_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDeviceTokenResp.ProtoReflect.Descriptor instead.
+func (*UpdateDeviceTokenResp) Descriptor() ([]byte, []int) {
+	return file_thirdext_thirdext_proto_rawDescGZIP(), []int{2}
+}
+
+type DeleteDeviceTokenReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID     string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	PlatformID int32  `protobuf:"varint,2,opt,name=platformID,proto3" json:"platformID"`
+	Vendor     string `protobuf:"bytes,3,opt,name=vendor,proto3" json:"vendor"`
+}
+
+func (x *DeleteDeviceTokenReq) Reset() {
+	*x = DeleteDeviceTokenReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_thirdext_thirdext_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteDeviceTokenReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDeviceTokenReq) ProtoMessage() {}
+
+func (x *DeleteDeviceTokenReq) ProtoReflect() protoreflect.Message {
+	mi := &file_thirdext_thirdext_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDeviceTokenReq.ProtoReflect.Descriptor instead.
+func (*DeleteDeviceTokenReq) Descriptor() ([]byte, []int) {
+	return file_thirdext_thirdext_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DeleteDeviceTokenReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *DeleteDeviceTokenReq) GetPlatformID() int32 {
+	if x != nil {
+		return x.PlatformID
+	}
+	return 0
+}
+
+func (x *DeleteDeviceTokenReq) GetVendor() string {
+	if x != nil {
+		return x.Vendor
+	}
+	return ""
+}
+
+type DeleteDeviceTokenResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteDeviceTokenResp) Reset() {
+	*x = DeleteDeviceTokenResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_thirdext_thirdext_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteDeviceTokenResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDeviceTokenResp) ProtoMessage() {}
+
+func (x *DeleteDeviceTokenResp) ProtoReflect() protoreflect.Message {
+	mi := &file_thirdext_thirdext_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDeviceTokenResp.ProtoReflect.Descriptor instead.
+func (*DeleteDeviceTokenResp) Descriptor() ([]byte, []int) {
+	return file_thirdext_thirdext_proto_rawDescGZIP(), []int{4}
+}
+
+type GetDeviceTokensReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+}
+
+func (x *GetDeviceTokensReq) Reset() {
+	*x = GetDeviceTokensReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_thirdext_thirdext_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDeviceTokensReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeviceTokensReq) ProtoMessage() {}
+
+func (x *GetDeviceTokensReq) ProtoReflect() protoreflect.Message {
+	mi := &file_thirdext_thirdext_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeviceTokensReq.ProtoReflect.Descriptor instead.
+func (*GetDeviceTokensReq) Descriptor() ([]byte, []int) {
+	return file_thirdext_thirdext_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetDeviceTokensReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type GetDeviceTokensResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tokens []*DeviceToken `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens"`
+}
+
+func (x *GetDeviceTokensResp) Reset() {
+	*x = GetDeviceTokensResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_thirdext_thirdext_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDeviceTokensResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeviceTokensResp) ProtoMessage() {}
+
+func (x *GetDeviceTokensResp) ProtoReflect() protoreflect.Message {
+	mi := &file_thirdext_thirdext_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeviceTokensResp.ProtoReflect.Descriptor instead.
+func (*GetDeviceTokensResp) Descriptor() ([]byte, []int) {
+	return file_thirdext_thirdext_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetDeviceTokensResp) GetTokens() []*DeviceToken {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+var File_thirdext_thirdext_proto protoreflect.FileDescriptor
+
+var file_thirdext_thirdext_proto_rawDesc = []byte{
+	0x0a, 0x17, 0x74, 0x68, 0x69, 0x72, 0x64, 0x65, 0x78, 0x74, 0x2f, 0x74, 0x68, 0x69, 0x72, 0x64,
+	0x65, 0x78, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x74, 0x68, 0x69, 0x72, 0x64, 0x65, 0x78, 0x74, 0x22, 0x5b, 0x0a, 0x0b, 0x44, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61,
+	0x74, 0x66, 0x6f, 0x72, 0x6d, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x70,
+	0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x65, 0x6e,
+	0x64, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f,
+	0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x9c, 0x01, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x74,
+	0x66, 0x6f, 0x72, 0x6d, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x70, 0x6c,
+	0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x65, 0x6e, 0x64,
+	0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x17, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22,
+	0x66, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12,
+	0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x49, 0x44, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x49, 0x44, 0x12,
+	0x16, 0x0a, 0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x22, 0x17, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x22, 0x2c, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x4b,
+	0x0a, 0x13, 0x47, 0x65, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x34, 0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x74,
+	0x68, 0x69, 0x72, 0x64, 0x65, 0x78, 0x74, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x32, 0xb0, 0x02, 0x0a, 0x08,
+	0x54, 0x68, 0x69, 0x72, 0x64, 0x45, 0x78, 0x74, 0x12, 0x62, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x25, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x74, 0x68, 0x69, 0x72, 0x64, 0x65, 0x78, 0x74, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x52, 0x65, 0x71, 0x1a, 0x26, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x74, 0x68,
+	0x69, 0x72, 0x64, 0x65, 0x78, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x62, 0x0a, 0x11,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x25, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x74, 0x68, 0x69, 0x72, 0x64,
+	0x65, 0x78, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x26, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x74, 0x68, 0x69, 0x72, 0x64, 0x65, 0x78, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x5c, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x73, 0x12, 0x23, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x74, 0x68, 0x69,
+	0x72, 0x64, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x74, 0x68, 0x69, 0x72, 0x64, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x42, 0x3e,
+	0x5a, 0x3c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x73, 0x64, 0x6b, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x2d, 0x69, 0x6d, 0x2d, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x74, 0x68, 0x69, 0x72, 0x64, 0x65, 0x78, 0x74, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_thirdext_thirdext_proto_rawDescOnce sync.Once
+	file_thirdext_thirdext_proto_rawDescData = file_thirdext_thirdext_proto_rawDesc
+)
+
+func file_thirdext_thirdext_proto_rawDescGZIP() []byte {
+	file_thirdext_thirdext_proto_rawDescOnce.Do(func() {
+		file_thirdext_thirdext_proto_rawDescData = protoimpl.X.CompressGZIP(file_thirdext_thirdext_proto_rawDescData)
+	})
+	return file_thirdext_thirdext_proto_rawDescData
+}
+
+var file_thirdext_thirdext_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_thirdext_thirdext_proto_goTypes = []interface{}{
+	(*DeviceToken)(nil),           // 0: openim.thirdext.DeviceToken
+	(*UpdateDeviceTokenReq)(nil),  // 1: openim.thirdext.UpdateDeviceTokenReq
+	(*UpdateDeviceTokenResp)(nil), // 2: openim.thirdext.UpdateDeviceTokenResp
+	(*DeleteDeviceTokenReq)(nil),  // 3: openim.thirdext.DeleteDeviceTokenReq
+	(*DeleteDeviceTokenResp)(nil), // 4: openim.thirdext.DeleteDeviceTokenResp
+	(*GetDeviceTokensReq)(nil),    // 5: openim.thirdext.GetDeviceTokensReq
+	(*GetDeviceTokensResp)(nil),   // 6: openim.thirdext.GetDeviceTokensResp
+}
+var file_thirdext_thirdext_proto_depIdxs = []int32{
+	0, // 0: openim.thirdext.GetDeviceTokensResp.tokens:type_name -> openim.thirdext.DeviceToken
+	1, // 1: openim.thirdext.ThirdExt.UpdateDeviceToken:input_type -> openim.thirdext.UpdateDeviceTokenReq
+	3, // 2: openim.thirdext.ThirdExt.DeleteDeviceToken:input_type -> openim.thirdext.DeleteDeviceTokenReq
+	5, // 3: openim.thirdext.ThirdExt.GetDeviceTokens:input_type -> openim.thirdext.GetDeviceTokensReq
+	2, // 4: openim.thirdext.ThirdExt.UpdateDeviceToken:output_type -> openim.thirdext.UpdateDeviceTokenResp
+	4, // 5: openim.thirdext.ThirdExt.DeleteDeviceToken:output_type -> openim.thirdext.DeleteDeviceTokenResp
+	6, // 6: openim.thirdext.ThirdExt.GetDeviceTokens:output_type -> openim.thirdext.GetDeviceTokensResp
+	4, // [4:7] is the sub-list for method output_type
+	1, // [1:4] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_thirdext_thirdext_proto_init() }
+func file_thirdext_thirdext_proto_init() {
+	if File_thirdext_thirdext_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_thirdext_thirdext_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeviceToken); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_thirdext_thirdext_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateDeviceTokenReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_thirdext_thirdext_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateDeviceTokenResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_thirdext_thirdext_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteDeviceTokenReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_thirdext_thirdext_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteDeviceTokenResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_thirdext_thirdext_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDeviceTokensReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_thirdext_thirdext_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDeviceTokensResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_thirdext_thirdext_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_thirdext_thirdext_proto_goTypes,
+		DependencyIndexes: file_thirdext_thirdext_proto_depIdxs,
+		MessageInfos:      file_thirdext_thirdext_proto_msgTypes,
+	}.Build()
+	File_thirdext_thirdext_proto = out.File
+	file_thirdext_thirdext_proto_rawDesc = nil
+	file_thirdext_thirdext_proto_goTypes = nil
+	file_thirdext_thirdext_proto_depIdxs = nil
+}
@@ -0,0 +1,195 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: thirdext/thirdext.proto
+
+package thirdext
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	ThirdExt_UpdateDeviceToken_FullMethodName = "/openim.thirdext.ThirdExt/UpdateDeviceToken"
+	ThirdExt_DeleteDeviceToken_FullMethodName = "/openim.thirdext.ThirdExt/DeleteDeviceToken"
+	ThirdExt_GetDeviceTokens_FullMethodName   = "/openim.thirdext.ThirdExt/GetDeviceTokens"
+)
+
+// ThirdExtClient is the client API for ThirdExt service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ThirdExtClient interface {
+	UpdateDeviceToken(ctx context.Context, in *UpdateDeviceTokenReq, opts ...grpc.CallOption) (*UpdateDeviceTokenResp, error)
+	DeleteDeviceToken(ctx context.Context, in *DeleteDeviceTokenReq, opts ...grpc.CallOption) (*DeleteDeviceTokenResp, error)
+	GetDeviceTokens(ctx context.Context, in *GetDeviceTokensReq, opts ...grpc.CallOption) (*GetDeviceTokensResp, error)
+}
+
+type thirdExtClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewThirdExtClient(cc grpc.ClientConnInterface) ThirdExtClient {
+	return &thirdExtClient{cc}
+}
+
+func (c *thirdExtClient) UpdateDeviceToken(ctx context.Context, in *UpdateDeviceTokenReq, opts ...grpc.CallOption) (*UpdateDeviceTokenResp, error) {
+	out := new(UpdateDeviceTokenResp)
+	err := c.cc.Invoke(ctx, ThirdExt_UpdateDeviceToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thirdExtClient) DeleteDeviceToken(ctx context.Context, in *DeleteDeviceTokenReq, opts ...grpc.CallOption) (*DeleteDeviceTokenResp, error) {
+	out := new(DeleteDeviceTokenResp)
+	err := c.cc.Invoke(ctx, ThirdExt_DeleteDeviceToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thirdExtClient) GetDeviceTokens(ctx context.Context, in *GetDeviceTokensReq, opts ...grpc.CallOption) (*GetDeviceTokensResp, error) {
+	out := new(GetDeviceTokensResp)
+	err := c.cc.Invoke(ctx, ThirdExt_GetDeviceTokens_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ThirdExtServer is the server API for ThirdExt service.
+// All implementations should embed UnimplementedThirdExtServer
+// for forward compatibility
+type ThirdExtServer interface {
+	UpdateDeviceToken(context.Context, *UpdateDeviceTokenReq) (*UpdateDeviceTokenResp, error)
+	DeleteDeviceToken(context.Context, *DeleteDeviceTokenReq) (*DeleteDeviceTokenResp, error)
+	GetDeviceTokens(context.Context, *GetDeviceTokensReq) (*GetDeviceTokensResp, error)
+}
+
+// UnimplementedThirdExtServer should be embedded to have forward compatible implementations.
+type UnimplementedThirdExtServer struct {
+}
+
+func (UnimplementedThirdExtServer) UpdateDeviceToken(context.Context, *UpdateDeviceTokenReq) (*UpdateDeviceTokenResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDeviceToken not implemented")
+}
+func (UnimplementedThirdExtServer) DeleteDeviceToken(context.Context, *DeleteDeviceTokenReq) (*DeleteDeviceTokenResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDeviceToken not implemented")
+}
+func (UnimplementedThirdExtServer) GetDeviceTokens(context.Context, *GetDeviceTokensReq) (*GetDeviceTokensResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeviceTokens not implemented")
+}
+
+// UnsafeThirdExtServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ThirdExtServer will
+// result in compilation errors.
+type UnsafeThirdExtServer interface {
+	mustEmbedUnimplementedThirdExtServer()
+}
+
+func RegisterThirdExtServer(s grpc.ServiceRegistrar, srv ThirdExtServer) {
+	s.RegisterService(&ThirdExt_ServiceDesc, srv)
+}
+
+func _ThirdExt_UpdateDeviceToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDeviceTokenReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThirdExtServer).UpdateDeviceToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThirdExt_UpdateDeviceToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThirdExtServer).UpdateDeviceToken(ctx, req.(*UpdateDeviceTokenReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThirdExt_DeleteDeviceToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDeviceTokenReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThirdExtServer).DeleteDeviceToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThirdExt_DeleteDeviceToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThirdExtServer).DeleteDeviceToken(ctx, req.(*DeleteDeviceTokenReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThirdExt_GetDeviceTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeviceTokensReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThirdExtServer).GetDeviceTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThirdExt_GetDeviceTokens_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThirdExtServer).GetDeviceTokens(ctx, req.(*GetDeviceTokensReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ThirdExt_ServiceDesc is the grpc.ServiceDesc for ThirdExt service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ThirdExt_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "openim.thirdext.ThirdExt",
+	HandlerType: (*ThirdExtServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateDeviceToken",
+			Handler:    _ThirdExt_UpdateDeviceToken_Handler,
+		},
+		{
+			MethodName: "DeleteDeviceToken",
+			Handler:    _ThirdExt_DeleteDeviceToken_Handler,
+		},
+		{
+			MethodName: "GetDeviceTokens",
+			Handler:    _ThirdExt_GetDeviceTokens_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "thirdext/thirdext.proto",
+}
@@ -0,0 +1,46 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package userext
+
+import (
+	"errors"
+	"regexp"
+)
+
+// MaxGetUserLocales is the max number of the users whose locales are got at a time.
+const MaxGetUserLocales = 1000
+
+// localeRegexp matches the BCP 47 language tags like en, zh-Hans or pt_BR.
+var localeRegexp = regexp.MustCompile(`^[a-zA-Z]{2,3}([-_][a-zA-Z0-9]{2,8}){0,3}$`)
+
+func (x *SetUserLocaleReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.Locale != "" && !localeRegexp.MatchString(x.Locale) {
+		return errors.New("locale is invalid")
+	}
+	return nil
+}
+
+func (x *GetUserLocalesReq) Check() error {
+	if len(x.UserIDs) == 0 {
+		return errors.New("userIDs is empty")
+	}
+	if len(x.UserIDs) > MaxGetUserLocales {
+		return errors.New("too many userIDs")
+	}
+	return nil
+}
@@ -0,0 +1,374 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        (unknown)
+// source: userext/userext.proto
+
+package userext
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SetUserLocaleReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	// BCP 47 language tag, e.g. en, zh-CN or pt-BR, the default locale of the server is used when it is empty
+	Locale string `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale"`
+}
+
+func (x *SetUserLocaleReq) Reset() {
+	*x = SetUserLocaleReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_userext_userext_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetUserLocaleReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserLocaleReq) ProtoMessage() {}
+
+func (x *SetUserLocaleReq) ProtoReflect() protoreflect.Message {
+	mi := &file_userext_userext_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserLocaleReq.ProtoReflect.Descriptor instead.
+func (*SetUserLocaleReq) Descriptor() ([]byte, []int) {
+	return file_userext_userext_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SetUserLocaleReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *SetUserLocaleReq) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+type SetUserLocaleResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetUserLocaleResp) Reset() {
+	*x = SetUserLocaleResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_userext_userext_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetUserLocaleResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserLocaleResp) ProtoMessage() {}
+
+func (x *SetUserLocaleResp) ProtoReflect() protoreflect.Message {
+	mi := &file_userext_userext_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserLocaleResp.ProtoReflect.Descriptor instead.
+func (*SetUserLocaleResp) Descriptor() ([]byte, []int) {
+	return file_userext_userext_proto_rawDescGZIP(), []int{1}
+}
+
+type GetUserLocalesReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserIDs []string `protobuf:"bytes,1,rep,name=userIDs,proto3" json:"userIDs"`
+}
+
+func (x *GetUserLocalesReq) Reset() {
+	*x = GetUserLocalesReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_userext_userext_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserLocalesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserLocalesReq) ProtoMessage() {}
+
+func (x *GetUserLocalesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_userext_userext_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserLocalesReq.ProtoReflect.Descriptor instead.
+func (*GetUserLocalesReq) Descriptor() ([]byte, []int) {
+	return file_userext_userext_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetUserLocalesReq) GetUserIDs() []string {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+type GetUserLocalesResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the locales of the users found, the users without locales are omitted
+	Locales map[string]string `protobuf:"bytes,1,rep,name=locales,proto3" json:"locales,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *GetUserLocalesResp) Reset() {
+	*x = GetUserLocalesResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_userext_userext_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserLocalesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserLocalesResp) ProtoMessage() {}
+
+func (x *GetUserLocalesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_userext_userext_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserLocalesResp.ProtoReflect.Descriptor instead.
+func (*GetUserLocalesResp) Descriptor() ([]byte, []int) {
+	return file_userext_userext_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetUserLocalesResp) GetLocales() map[string]string {
+	if x != nil {
+		return x.Locales
+	}
+	return nil
+}
+
+var File_userext_userext_proto protoreflect.FileDescriptor
+
+var file_userext_userext_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x75, 0x73, 0x65, 0x72, 0x65, 0x78, 0x74, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x65, 0x78,
+	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x65, 0x78, 0x74, 0x22, 0x42, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x22, 0x13, 0x0a, 0x11, 0x53,
+	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x22, 0x2d, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x6c,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22,
+	0x9b, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x6c,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x49, 0x0a, 0x07, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4c, 0x6f, 0x63, 0x61,
+	0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65,
+	0x73, 0x1a, 0x3a, 0x0a, 0x0c, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x32, 0xb8, 0x01,
+	0x0a, 0x07, 0x55, 0x73, 0x65, 0x72, 0x45, 0x78, 0x74, 0x12, 0x54, 0x0a, 0x0d, 0x53, 0x65, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x20, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x65, 0x78, 0x74, 0x2e, 0x53, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x65, 0x78, 0x74, 0x2e, 0x53, 0x65,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x57, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x65,
+	0x73, 0x12, 0x21, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x65,
+	0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x63,
+	0x61, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x42, 0x3d, 0x5a, 0x3b, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x73, 0x64, 0x6b,
+	0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x2d, 0x69, 0x6d, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f,
+	0x76, 0x33, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f,
+	0x75, 0x73, 0x65, 0x72, 0x65, 0x78, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_userext_userext_proto_rawDescOnce sync.Once
+	file_userext_userext_proto_rawDescData = file_userext_userext_proto_rawDesc
+)
+
+func file_userext_userext_proto_rawDescGZIP() []byte {
+	file_userext_userext_proto_rawDescOnce.Do(func() {
+		file_userext_userext_proto_rawDescData = protoimpl.X.CompressGZIP(file_userext_userext_proto_rawDescData)
+	})
+	return file_userext_userext_proto_rawDescData
+}
+
+var file_userext_userext_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_userext_userext_proto_goTypes = []interface{}{
+	(*SetUserLocaleReq)(nil),   // 0: openim.userext.SetUserLocaleReq
+	(*SetUserLocaleResp)(nil),  // 1: openim.userext.SetUserLocaleResp
+	(*GetUserLocalesReq)(nil),  // 2: openim.userext.GetUserLocalesReq
+	(*GetUserLocalesResp)(nil), // 3: openim.userext.GetUserLocalesResp
+	nil,                        // 4: openim.userext.GetUserLocalesResp.LocalesEntry
+}
+var file_userext_userext_proto_depIdxs = []int32{
+	4, // 0: openim.userext.GetUserLocalesResp.locales:type_name -> openim.userext.GetUserLocalesResp.LocalesEntry
+	0, // 1: openim.userext.UserExt.SetUserLocale:input_type -> openim.userext.SetUserLocaleReq
+	2, // 2: openim.userext.UserExt.GetUserLocales:input_type -> openim.userext.GetUserLocalesReq
+	1, // 3: openim.userext.UserExt.SetUserLocale:output_type -> openim.userext.SetUserLocaleResp
+	3, // 4: openim.userext.UserExt.GetUserLocales:output_type -> openim.userext.GetUserLocalesResp
+	3, // [3:5] is the sub-list for method output_type
+	1, // [1:3] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_userext_userext_proto_init() }
+func file_userext_userext_proto_init() {
+	if File_userext_userext_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_userext_userext_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetUserLocaleReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_userext_userext_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetUserLocaleResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_userext_userext_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserLocalesReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_userext_userext_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserLocalesResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_userext_userext_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_userext_userext_proto_goTypes,
+		DependencyIndexes: file_userext_userext_proto_depIdxs,
+		MessageInfos:      file_userext_userext_proto_msgTypes,
+	}.Build()
+	File_userext_userext_proto = out.File
+	file_userext_userext_proto_rawDesc = nil
+	file_userext_userext_proto_goTypes = nil
+	file_userext_userext_proto_depIdxs = nil
+}
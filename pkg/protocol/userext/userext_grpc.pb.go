@@ -0,0 +1,160 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: userext/userext.proto
+
+package userext
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	UserExt_SetUserLocale_FullMethodName  = "/openim.userext.UserExt/SetUserLocale"
+	UserExt_GetUserLocales_FullMethodName = "/openim.userext.UserExt/GetUserLocales"
+)
+
+// UserExtClient is the client API for UserExt service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type UserExtClient interface {
+	SetUserLocale(ctx context.Context, in *SetUserLocaleReq, opts ...grpc.CallOption) (*SetUserLocaleResp, error)
+	// only for the app managers
+	GetUserLocales(ctx context.Context, in *GetUserLocalesReq, opts ...grpc.CallOption) (*GetUserLocalesResp, error)
+}
+
+type userExtClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewUserExtClient(cc grpc.ClientConnInterface) UserExtClient {
+	return &userExtClient{cc}
+}
+
+func (c *userExtClient) SetUserLocale(ctx context.Context, in *SetUserLocaleReq, opts ...grpc.CallOption) (*SetUserLocaleResp, error) {
+	out := new(SetUserLocaleResp)
+	err := c.cc.Invoke(ctx, UserExt_SetUserLocale_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userExtClient) GetUserLocales(ctx context.Context, in *GetUserLocalesReq, opts ...grpc.CallOption) (*GetUserLocalesResp, error) {
+	out := new(GetUserLocalesResp)
+	err := c.cc.Invoke(ctx, UserExt_GetUserLocales_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UserExtServer is the server API for UserExt service.
+// All implementations should embed UnimplementedUserExtServer
+// for forward compatibility
+type UserExtServer interface {
+	SetUserLocale(context.Context, *SetUserLocaleReq) (*SetUserLocaleResp, error)
+	// only for the app managers
+	GetUserLocales(context.Context, *GetUserLocalesReq) (*GetUserLocalesResp, error)
+}
+
+// UnimplementedUserExtServer should be embedded to have forward compatible implementations.
+type UnimplementedUserExtServer struct {
+}
+
+func (UnimplementedUserExtServer) SetUserLocale(context.Context, *SetUserLocaleReq) (*SetUserLocaleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUserLocale not implemented")
+}
+func (UnimplementedUserExtServer) GetUserLocales(context.Context, *GetUserLocalesReq) (*GetUserLocalesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserLocales not implemented")
+}
+
+// UnsafeUserExtServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to UserExtServer will
+// result in compilation errors.
+type UnsafeUserExtServer interface {
+	mustEmbedUnimplementedUserExtServer()
+}
+
+func RegisterUserExtServer(s grpc.ServiceRegistrar, srv UserExtServer) {
+	s.RegisterService(&UserExt_ServiceDesc, srv)
+}
+
+func _UserExt_SetUserLocale_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserLocaleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserExtServer).SetUserLocale(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserExt_SetUserLocale_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserExtServer).SetUserLocale(ctx, req.(*SetUserLocaleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserExt_GetUserLocales_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserLocalesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserExtServer).GetUserLocales(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserExt_GetUserLocales_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserExtServer).GetUserLocales(ctx, req.(*GetUserLocalesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// UserExt_ServiceDesc is the grpc.ServiceDesc for UserExt service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var UserExt_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "openim.userext.UserExt",
+	HandlerType: (*UserExtServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetUserLocale",
+			Handler:    _UserExt_SetUserLocale_Handler,
+		},
+		{
+			MethodName: "GetUserLocales",
+			Handler:    _UserExt_GetUserLocales_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "userext/userext.proto",
+}
@@ -81,6 +81,26 @@ func (u *UserLocalCache) GetUserGlobalMsgRecvOpt(ctx context.Context, userID str
 	}))
 }
 
+// GetUserLocale returns the locale of the user, it is empty if the user has no locale.
+func (u *UserLocalCache) GetUserLocale(ctx context.Context, userID string) (val string, err error) {
+	log.ZDebug(ctx, "UserLocalCache GetUserLocale req", "userID", userID)
+	defer func() {
+		if err == nil {
+			log.ZDebug(ctx, "UserLocalCache GetUserLocale return", "value", val)
+		} else {
+			log.ZError(ctx, "UserLocalCache GetUserLocale return", err)
+		}
+	}()
+	return localcache.AnyValue[string](u.local.GetLink(ctx, cachekey.GetUserLocaleKey(userID), func(ctx context.Context) (any, error) {
+		log.ZDebug(ctx, "UserLocalCache GetUserLocale rpc", "userID", userID)
+		locales, err := u.client.GetUserLocales(ctx, []string{userID})
+		if err != nil {
+			return nil, err
+		}
+		return locales[userID], nil
+	}, cachekey.GetUserInfoKey(userID)))
+}
+
 func (u *UserLocalCache) GetUsersInfo(ctx context.Context, userIDs []string) ([]*sdkws.UserInfo, error) {
 	users := make([]*sdkws.UserInfo, 0, len(userIDs))
 	for _, userID := range userIDs {
@@ -18,6 +18,7 @@ import (
 	"context"
 	"encoding/json"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgext"
 	"github.com/openimsdk/protocol/constant"
 	"github.com/openimsdk/protocol/msg"
 	"github.com/openimsdk/protocol/sdkws"
@@ -76,9 +77,13 @@ func newContentTypeConf(conf *config.Notification) map[int32]config.Notification
 		constant.ConversationUnreadNotification:      conf.ConversationChanged,
 		constant.ConversationPrivateChatNotification: conf.ConversationSetPrivate,
 		// msg
-		constant.MsgRevokeNotification:  {IsSendMsg: false, ReliabilityLevel: constant.ReliableNotificationNoMsg},
-		constant.HasReadReceipt:         {IsSendMsg: false, ReliabilityLevel: constant.ReliableNotificationNoMsg},
-		constant.DeleteMsgsNotification: {IsSendMsg: false, ReliabilityLevel: constant.ReliableNotificationNoMsg},
+		constant.MsgRevokeNotification:        {IsSendMsg: false, ReliabilityLevel: constant.ReliableNotificationNoMsg},
+		constant.HasReadReceipt:               {IsSendMsg: false, ReliabilityLevel: constant.ReliableNotificationNoMsg},
+		constant.DeleteMsgsNotification:       {IsSendMsg: false, ReliabilityLevel: constant.ReliableNotificationNoMsg},
+		msgext.MsgModifiedNotification:        {IsSendMsg: false, ReliabilityLevel: constant.ReliableNotificationNoMsg},
+		msgext.MsgReactionChangedNotification: {IsSendMsg: false, ReliabilityLevel: constant.ReliableNotificationNoMsg},
+		msgext.ThreadHasReadNotification:      {IsSendMsg: false, ReliabilityLevel: constant.ReliableNotificationNoMsg},
+		msgext.MsgPinChangedNotification:      {IsSendMsg: false, ReliabilityLevel: constant.ReliableNotificationNoMsg},
 	}
 }
 
@@ -129,9 +134,10 @@ func newSessionTypeConf() map[int32]int32 {
 }
 
 type Message struct {
-	conn   grpc.ClientConnInterface
-	Client msg.MsgClient
-	discov discovery.SvcDiscoveryRegistry
+	conn      grpc.ClientConnInterface
+	Client    msg.MsgClient
+	ExtClient msgext.MsgExtClient
+	discov    discovery.SvcDiscoveryRegistry
 }
 
 func NewMessage(discov discovery.SvcDiscoveryRegistry, rpcRegisterName string) *Message {
@@ -140,7 +146,7 @@ func NewMessage(discov discovery.SvcDiscoveryRegistry, rpcRegisterName string) *
 		program.ExitWithError(err)
 	}
 	client := msg.NewMsgClient(conn)
-	return &Message{discov: discov, conn: conn, Client: client}
+	return &Message{discov: discov, conn: conn, Client: client, ExtClient: msgext.NewMsgExtClient(conn)}
 }
 
 type MessageRpcClient Message
@@ -159,6 +165,12 @@ func (m *MessageRpcClient) SendMsg(ctx context.Context, req *msg.SendMsgReq) (*m
 	return resp, nil
 }
 
+// IndexMsgs adds the msgs stored in mongodb to the search index of the msg rpc.
+func (m *MessageRpcClient) IndexMsgs(ctx context.Context, conversationID string, msgs []*sdkws.MsgData) error {
+	_, err := m.ExtClient.IndexMsgs(ctx, &msgext.IndexMsgsReq{ConversationID: conversationID, Msgs: msgs})
+	return err
+}
+
 // GetMaxSeq retrieves the maximum sequence number from the gRPC client.
 // Errors during the gRPC call are wrapped to provide additional context.
 func (m *MessageRpcClient) GetMaxSeq(ctx context.Context, req *sdkws.GetMaxSeqReq) (*sdkws.GetMaxSeqResp, error) {
@@ -17,6 +17,7 @@ package rpcclient
 import (
 	"context"
 
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/pushext"
 	"github.com/openimsdk/protocol/push"
 	"github.com/openimsdk/tools/discovery"
 	"github.com/openimsdk/tools/system/program"
@@ -24,9 +25,10 @@ import (
 )
 
 type Push struct {
-	conn   grpc.ClientConnInterface
-	Client push.PushMsgServiceClient
-	discov discovery.SvcDiscoveryRegistry
+	conn      grpc.ClientConnInterface
+	Client    push.PushMsgServiceClient
+	ExtClient pushext.PushExtClient
+	discov    discovery.SvcDiscoveryRegistry
 }
 
 func NewPush(discov discovery.SvcDiscoveryRegistry, rpcRegisterName string) *Push {
@@ -35,9 +37,10 @@ func NewPush(discov discovery.SvcDiscoveryRegistry, rpcRegisterName string) *Pus
 		program.ExitWithError(err)
 	}
 	return &Push{
-		discov: discov,
-		conn:   conn,
-		Client: push.NewPushMsgServiceClient(conn),
+		discov:    discov,
+		conn:      conn,
+		Client:    push.NewPushMsgServiceClient(conn),
+		ExtClient: pushext.NewPushExtClient(conn),
 	}
 }
 
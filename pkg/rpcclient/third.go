@@ -17,6 +17,7 @@ package rpcclient
 import (
 	"context"
 
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/thirdext"
 	"github.com/openimsdk/protocol/third"
 	"github.com/openimsdk/tools/discovery"
 	"github.com/openimsdk/tools/system/program"
@@ -26,6 +27,7 @@ import (
 type Third struct {
 	conn       grpc.ClientConnInterface
 	Client     third.ThirdClient
+	ExtClient  thirdext.ThirdExtClient
 	discov     discovery.SvcDiscoveryRegistry
 	GrafanaUrl string
 }
@@ -39,5 +41,5 @@ func NewThird(discov discovery.SvcDiscoveryRegistry, rpcRegisterName, grafanaUrl
 	if err != nil {
 		program.ExitWithError(err)
 	}
-	return &Third{discov: discov, Client: client, conn: conn, GrafanaUrl: grafanaUrl}
+	return &Third{discov: discov, Client: client, ExtClient: thirdext.NewThirdExtClient(conn), conn: conn, GrafanaUrl: grafanaUrl}
 }
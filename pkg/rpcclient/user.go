@@ -20,6 +20,7 @@ import (
 
 	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/userext"
 	"github.com/openimsdk/protocol/sdkws"
 	"github.com/openimsdk/protocol/user"
 	"github.com/openimsdk/tools/discovery"
@@ -32,6 +33,7 @@ import (
 type User struct {
 	conn                  grpc.ClientConnInterface
 	Client                user.UserClient
+	ExtClient             userext.UserExtClient
 	Discov                discovery.SvcDiscoveryRegistry
 	MessageGateWayRpcName string
 	imAdminUserID         []string
@@ -46,6 +48,7 @@ func NewUser(discov discovery.SvcDiscoveryRegistry, rpcRegisterName, messageGate
 	}
 	client := user.NewUserClient(conn)
 	return &User{Discov: discov, Client: client,
+		ExtClient:             userext.NewUserExtClient(conn),
 		conn:                  conn,
 		MessageGateWayRpcName: messageGateWayRpcName,
 		imAdminUserID:         imAdminUserID}
@@ -160,6 +163,15 @@ func (u *UserRpcClient) GetUserGlobalMsgRecvOpt(ctx context.Context, userID stri
 	return resp.GlobalRecvMsgOpt, nil
 }
 
+// GetUserLocales retrieves the locales of the users, the users without locales are omitted.
+func (u *UserRpcClient) GetUserLocales(ctx context.Context, userIDs []string) (map[string]string, error) {
+	resp, err := u.ExtClient.GetUserLocales(ctx, &userext.GetUserLocalesReq{UserIDs: userIDs})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Locales, nil
+}
+
 // Access verifies the access rights for the provided user ID.
 func (u *UserRpcClient) Access(ctx context.Context, ownerUserID string) error {
 	_, err := u.GetUserInfo(ctx, ownerUserID)
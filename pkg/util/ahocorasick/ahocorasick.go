@@ -0,0 +1,116 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ahocorasick finds the words of a dictionary in a text in one pass, the words are matched case-insensitively.
+package ahocorasick
+
+import "unicode"
+
+type node struct {
+	next map[rune]int
+	fail int
+	// out is the rune length of the longest word ending at this node, 0 if no word ends here.
+	out int
+}
+
+type Matcher struct {
+	nodes []node
+}
+
+// New builds a matcher of the words, empty words are ignored.
+func New(words []string) *Matcher {
+	m := &Matcher{nodes: []node{{next: make(map[rune]int)}}}
+	for _, word := range words {
+		cur, n := 0, 0
+		for _, r := range word {
+			r = unicode.ToLower(r)
+			child, ok := m.nodes[cur].next[r]
+			if !ok {
+				m.nodes = append(m.nodes, node{next: make(map[rune]int)})
+				child = len(m.nodes) - 1
+				m.nodes[cur].next[r] = child
+			}
+			cur = child
+			n++
+		}
+		if n > 0 {
+			m.nodes[cur].out = n
+		}
+	}
+	queue := make([]int, 0, len(m.nodes))
+	for _, child := range m.nodes[0].next {
+		queue = append(queue, child)
+	}
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		for r, child := range m.nodes[cur].next {
+			fail := m.step(m.nodes[cur].fail, r)
+			m.nodes[child].fail = fail
+			if m.nodes[fail].out > m.nodes[child].out {
+				m.nodes[child].out = m.nodes[fail].out
+			}
+			queue = append(queue, child)
+		}
+	}
+	return m
+}
+
+func (m *Matcher) step(cur int, r rune) int {
+	for {
+		if next, ok := m.nodes[cur].next[r]; ok {
+			return next
+		}
+		if cur == 0 {
+			return 0
+		}
+		cur = m.nodes[cur].fail
+	}
+}
+
+// Empty reports whether the matcher has no words.
+func (m *Matcher) Empty() bool {
+	return len(m.nodes) == 1
+}
+
+// Contains reports whether any word is in text.
+func (m *Matcher) Contains(text string) bool {
+	cur := 0
+	for _, r := range text {
+		cur = m.step(cur, unicode.ToLower(r))
+		if m.nodes[cur].out > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// Replace replaces every rune of the words in text with mask, it reports whether any word is replaced.
+func (m *Matcher) Replace(text string, mask rune) (string, bool) {
+	runes := []rune(text)
+	cur, replaced := 0, false
+	for i, r := range runes {
+		cur = m.step(cur, unicode.ToLower(r))
+		if n := m.nodes[cur].out; n > 0 {
+			for j := i - n + 1; j <= i; j++ {
+				runes[j] = mask
+			}
+			replaced = true
+		}
+	}
+	if !replaced {
+		return text, false
+	}
+	return string(runes), true
+}
@@ -0,0 +1,47 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ahocorasick
+
+import "testing"
+
+func TestMatcherReplace(t *testing.T) {
+	m := New([]string{"he", "she", "hers", "坏人", ""})
+	tests := []struct {
+		text     string
+		want     string
+		replaced bool
+	}{
+		{text: "ushers", want: "u*****", replaced: true},
+		{text: "USHERS", want: "U*****", replaced: true},
+		{text: "hers", want: "****", replaced: true},
+		{text: "你是坏人吗", want: "你是**吗", replaced: true},
+		{text: "hello", want: "**llo", replaced: true},
+		{text: "ushe", want: "u***", replaced: true},
+		{text: "good", want: "good", replaced: false},
+		{text: "", want: "", replaced: false},
+	}
+	for _, tt := range tests {
+		got, replaced := m.Replace(tt.text, '*')
+		if got != tt.want || replaced != tt.replaced {
+			t.Errorf("Replace(%q) = %q, %v, want %q, %v", tt.text, got, replaced, tt.want, tt.replaced)
+		}
+		if m.Contains(tt.text) != tt.replaced {
+			t.Errorf("Contains(%q) = %v, want %v", tt.text, !tt.replaced, tt.replaced)
+		}
+	}
+	if !New(nil).Empty() || m.Empty() {
+		t.Error("Empty is wrong")
+	}
+}
@@ -19,6 +19,15 @@ func GenGroupConversationID(groupID string) string {
 	return "sg_" + groupID
 }
 
+// GetGroupIDByConversationID returns the groupID of the group conversation, empty for the other conversations.
+func GetGroupIDByConversationID(conversationID string) string {
+	groupID, ok := strings.CutPrefix(conversationID, "sg_")
+	if !ok {
+		return ""
+	}
+	return groupID
+}
+
 func GenConversationUniqueKeyForSingle(sendID, recvID string) string {
 	l := []string{sendID, recvID}
 	sort.Strings(l)